@@ -1,25 +1,16 @@
 package api
 
 import (
-	"music-metadata/internal/client/music_files_client"
-	"music-metadata/internal/client/music_files_client/audio_file_client"
-	"music-metadata/internal/context"
-	"music-metadata/internal/database/repository/album_repo"
-	"music-metadata/internal/database/repository/artist_repo"
-	"music-metadata/internal/database/repository/genre_repo"
-	"music-metadata/internal/database/repository/song_repo"
 	"music-metadata/internal/handlers/album_handler"
 	"music-metadata/internal/handlers/artist_handler"
+	"music-metadata/internal/handlers/audit_handler"
 	"music-metadata/internal/handlers/cover_handler"
+	"music-metadata/internal/handlers/event_handler"
 	"music-metadata/internal/handlers/genre_handler"
+	"music-metadata/internal/handlers/scan_handler"
 	"music-metadata/internal/handlers/song_handler"
+	"music-metadata/internal/handlers/work_handler"
 	"music-metadata/internal/middleware"
-	"music-metadata/internal/service"
-	"music-metadata/internal/service/album_service"
-	"music-metadata/internal/service/artist_service"
-	"music-metadata/internal/service/cover_service"
-	"music-metadata/internal/service/genre_service"
-	"music-metadata/internal/service/song_service"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
@@ -27,7 +18,7 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetupRouter(ac *context.AppContext) (r *gin.Engine) {
+func SetupRouter(services *Services) (r *gin.Engine) {
 	log.Debug().Msg("Router setup")
 	gin.SetMode(gin.ReleaseMode)
 
@@ -35,37 +26,52 @@ func SetupRouter(ac *context.AppContext) (r *gin.Engine) {
 	r.Use(middleware.ZerologMiddleware(log.Logger))
 	r.Use(middleware.CORSMiddleware())
 
-	musicFilesClient := music_files_client.NewClient(ac.Config.HttpServer.MusicFilesAddress)
-	audioFileClient := audio_file_client.NewAudioFileClient(musicFilesClient)
-
-	albumRepo := album_repo.NewRepository()
-	artistRepo := artist_repo.NewRepository()
-	genreRepo := genre_repo.NewRepository()
-	songRepo := song_repo.NewRepository()
-	txManager := service.NewTransactionManager(*ac.Db)
-
-	albumService := album_service.NewService(albumRepo)
-	artistService := artist_service.NewService(artistRepo)
-	genreService := genre_service.NewService(genreRepo)
-	songService := song_service.NewService(songRepo, *albumService, *artistService, *genreService, audioFileClient)
-	coverService := cover_service.NewService(*songService, audioFileClient)
-
-	albumHandler := album_handler.NewHandler(*albumService, *coverService, txManager)
-	artistHandler := artist_handler.NewHandler(*artistService, *coverService, txManager)
-	genreHandler := genre_handler.NewHandler(*genreService, *coverService, txManager)
-	songHandler := song_handler.NewHandler(*songService, txManager)
-	coverHandler := cover_handler.NewHandler(*coverService, txManager)
+	albumHandler := album_handler.NewHandler(*services.AlbumService, *services.CoverService, services.TxManager)
+	artistHandler := artist_handler.NewHandler(*services.ArtistService, *services.CoverService, services.TxManager)
+	genreHandler := genre_handler.NewHandler(*services.GenreService, *services.CoverService, *services.SongService, services.TxManager)
+	songHandler := song_handler.NewHandler(*services.SongService, services.TxManager)
+	coverHandler := cover_handler.NewHandler(*services.CoverService, services.TxManager)
+	scanHandler := scan_handler.NewHandler(*services.ScanService, services.TxManager)
+	eventHandler := event_handler.NewHandler(*services.ScanService, services.TxManager)
+	auditHandler := audit_handler.NewHandler(*services.AuditService, services.TxManager)
+	workHandler := work_handler.NewHandler(*services.WorkService, services.TxManager)
 
 	api := r.Group("/api")
 	{
 		api.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-		api.POST("/scan", songHandler.Scan)
+		scan := api.Group("/scan")
+		{
+			scan.POST("", scanHandler.Start)
+			scan.GET("/jobs", scanHandler.GetAllJobs)
+			scan.GET("/jobs/:scanJobId", scanHandler.GetJob)
+			scan.GET("/jobs/:scanJobId/ambiguities", scanHandler.GetAmbiguities)
+			scan.GET("/jobs/:scanJobId/diff", scanHandler.GetDiff)
+			scan.GET("/failures", scanHandler.GetFailures)
+			scan.POST("/failures/retry", scanHandler.RetryFailures)
+			scan.POST("/rescan", scanHandler.Rescan)
+			scan.GET("/schedule", scanHandler.GetSchedule)
+			scan.DELETE("/schedule", scanHandler.StopSchedule)
+		}
+
+		events := api.Group("/events")
+		{
+			events.POST("/audio-files", eventHandler.ApplyAudioFileEvents)
+		}
 
 		songs := api.Group("/songs")
 		{
 			songs.GET("/:songId", songHandler.Get)
 			songs.GET("", songHandler.GetAll)
+			songs.PATCH("/:songId", songHandler.Update)
+			songs.PATCH("", songHandler.BulkUpdate)
+			songs.POST("/:songId/write-tags", songHandler.WriteTags)
+			songs.GET("/:songId/history", auditHandler.GetSongHistory)
+		}
+
+		changes := api.Group("/changes")
+		{
+			changes.POST("/:changeSetId/revert", auditHandler.Revert)
 		}
 
 		album := api.Group("/albums")
@@ -74,6 +80,8 @@ func SetupRouter(ac *context.AppContext) (r *gin.Engine) {
 			album.GET("", albumHandler.GetAll)
 			album.GET("/:albumId/songs", songHandler.GetByAlbumId)
 			album.GET("/:albumId/covers", coverHandler.GetAllByAlbumId)
+			album.POST("/:albumId/merge", songHandler.MergeAlbums)
+			album.POST("/:albumId/split", songHandler.SplitAlbum)
 		}
 
 		artist := api.Group("/artists")
@@ -81,7 +89,24 @@ func SetupRouter(ac *context.AppContext) (r *gin.Engine) {
 			artist.GET("/:artistId", artistHandler.Get)
 			artist.GET("", artistHandler.GetAll)
 			artist.GET("/:artistId/songs", songHandler.GetByArtistId)
+			artist.GET("/:artistId/albums", albumHandler.GetAllByArtistId)
 			artist.GET("/:artistId/covers", coverHandler.GetAllByArtistId)
+			artist.POST("/:artistId/merge", songHandler.MergeArtists)
+			artist.POST("/:artistId/split", songHandler.SplitArtist)
+		}
+
+		composer := api.Group("/composers")
+		{
+			composer.GET("", artistHandler.GetAllComposers)
+			composer.GET("/:artistId/songs", songHandler.GetByComposerId)
+			composer.GET("/:artistId/works", workHandler.GetAllByComposerId)
+		}
+
+		work := api.Group("/works")
+		{
+			work.GET("/:workId", workHandler.Get)
+			work.GET("", workHandler.GetAll)
+			work.GET("/:workId/songs", songHandler.GetByWorkId)
 		}
 
 		genre := api.Group("/genres")
@@ -89,7 +114,14 @@ func SetupRouter(ac *context.AppContext) (r *gin.Engine) {
 			genre.GET("/:genreId", genreHandler.Get)
 			genre.GET("", genreHandler.GetAll)
 			genre.GET("/:genreId/songs", songHandler.GetByGenreId)
+			genre.POST("/normalize", songHandler.NormalizeGenres)
+			genre.PUT("/:genreId/parent", genreHandler.SetParent)
+			genre.GET("/:genreId/aliases", genreHandler.GetAliases)
+			genre.POST("/:genreId/aliases", genreHandler.AddAlias)
+			genre.DELETE("/:genreId/aliases/:alias", genreHandler.RemoveAlias)
 			genre.GET("/:genreId/covers", coverHandler.GetAllByGenreId)
+			genre.POST("/:genreId/merge", songHandler.MergeGenres)
+			genre.POST("/:genreId/split", songHandler.SplitGenre)
 		}
 	}
 
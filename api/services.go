@@ -0,0 +1,99 @@
+package api
+
+import (
+	"music-metadata/internal/client/music_files_client"
+	"music-metadata/internal/client/music_files_client/audio_file_client"
+	"music-metadata/internal/context"
+	"music-metadata/internal/database/repository/album_alias_repo"
+	"music-metadata/internal/database/repository/album_repo"
+	"music-metadata/internal/database/repository/artist_alias_repo"
+	"music-metadata/internal/database/repository/artist_repo"
+	"music-metadata/internal/database/repository/audit_repo"
+	"music-metadata/internal/database/repository/genre_alias_repo"
+	"music-metadata/internal/database/repository/genre_repo"
+	"music-metadata/internal/database/repository/scan_ambiguity_repo"
+	"music-metadata/internal/database/repository/scan_diff_repo"
+	"music-metadata/internal/database/repository/scan_failure_repo"
+	"music-metadata/internal/database/repository/scan_job_repo"
+	"music-metadata/internal/database/repository/setting_repo"
+	"music-metadata/internal/database/repository/song_artist_repo"
+	"music-metadata/internal/database/repository/song_genre_repo"
+	"music-metadata/internal/database/repository/song_override_repo"
+	"music-metadata/internal/database/repository/song_repo"
+	"music-metadata/internal/database/repository/song_split_repo"
+	"music-metadata/internal/database/repository/work_repo"
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/album_service"
+	"music-metadata/internal/service/artist_service"
+	"music-metadata/internal/service/audit_service"
+	"music-metadata/internal/service/cover_service"
+	"music-metadata/internal/service/genre_service"
+	"music-metadata/internal/service/scan_service"
+	"music-metadata/internal/service/song_service"
+	"music-metadata/internal/service/work_service"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Services are the services the router and the startup jobs share
+type Services struct {
+	TxManager service.TransactionManager
+
+	AlbumService  *album_service.Service
+	ArtistService *artist_service.Service
+	GenreService  *genre_service.Service
+	WorkService   *work_service.Service
+	SongService   *song_service.Service
+	CoverService  *cover_service.Service
+	AuditService  *audit_service.Service
+	ScanService   *scan_service.Service
+}
+
+func NewServices(ac *context.AppContext) (services *Services) {
+	log.Debug().Msg("Services setup")
+
+	musicFilesClient := music_files_client.NewClient(ac.Config.HttpServer.MusicFilesAddress)
+	audioFileClient := audio_file_client.NewAudioFileClient(musicFilesClient)
+
+	albumRepo := album_repo.NewRepository()
+	albumAliasRepo := album_alias_repo.NewRepository()
+	artistRepo := artist_repo.NewRepository()
+	artistAliasRepo := artist_alias_repo.NewRepository()
+	genreRepo := genre_repo.NewRepository()
+	genreAliasRepo := genre_alias_repo.NewRepository()
+	songRepo := song_repo.NewRepository()
+	songArtistRepo := song_artist_repo.NewRepository()
+	songGenreRepo := song_genre_repo.NewRepository()
+	songSplitRepo := song_split_repo.NewRepository()
+	songOverrideRepo := song_override_repo.NewRepository()
+	settingRepo := setting_repo.NewRepository()
+	workRepo := work_repo.NewRepository()
+	scanJobRepo := scan_job_repo.NewRepository()
+	scanAmbiguityRepo := scan_ambiguity_repo.NewRepository()
+	scanDiffRepo := scan_diff_repo.NewRepository()
+	scanFailureRepo := scan_failure_repo.NewRepository()
+	auditRepo := audit_repo.NewRepository()
+	txManager := service.NewTransactionManager(*ac.Db)
+
+	artistService := artist_service.NewService(artistRepo, artistAliasRepo)
+	albumService := album_service.NewService(albumRepo, albumAliasRepo, *artistService)
+	genreService := genre_service.NewService(genreRepo, genreAliasRepo)
+	workService := work_service.NewService(workRepo, *artistService)
+	songService := song_service.NewService(songRepo, songArtistRepo, songGenreRepo, songSplitRepo, songOverrideRepo, settingRepo, *albumService, *artistService, *genreService,
+		*workService, audioFileClient, ac.Config.Scanner)
+	coverService := cover_service.NewService(*songService, audioFileClient)
+	auditService := audit_service.NewService(auditRepo, txManager)
+	scanService := scan_service.NewService(scanJobRepo, scanAmbiguityRepo, scanDiffRepo, scanFailureRepo, *songService, txManager)
+
+	return &Services{
+		TxManager:     txManager,
+		AlbumService:  albumService,
+		ArtistService: artistService,
+		GenreService:  genreService,
+		WorkService:   workService,
+		SongService:   songService,
+		CoverService:  coverService,
+		AuditService:  auditService,
+		ScanService:   scanService,
+	}
+}
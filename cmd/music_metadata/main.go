@@ -9,6 +9,7 @@ import (
 	"music-metadata/internal/config"
 	"music-metadata/internal/context"
 	"music-metadata/internal/database"
+	"music-metadata/internal/model"
 	"os"
 
 	_ "music-metadata/docs"
@@ -39,7 +40,10 @@ func main() {
 		Db:     db,
 	}
 
-	server := initializeServer(&ctx)
+	services := api.NewServices(&ctx)
+	runStartupJobs(services, cfg)
+
+	server := initializeServer(services)
 	runServer(server, cfg.HttpServer.Port)
 }
 
@@ -84,8 +88,31 @@ func initializeMigrations(db *sqlx.DB) {
 	log.Debug().Msg("Data schema actualized")
 }
 
-func initializeServer(ctx *context.AppContext) (r *gin.Engine) {
-	r = api.SetupRouter(ctx)
+// runStartupJobs aborts the scan jobs the previous run left unfinished, regenerates the sort keys if the
+// sort languages changed and starts the scan schedule and the audit log cleanup
+func runStartupJobs(services *api.Services, cfg *config.Configuration) {
+	err := services.TxManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		return services.ScanService.AbortInterrupted(tx)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to abort interrupted scan jobs")
+	}
+	err = services.TxManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		return services.SongService.UpdateSortKeys(tx)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to update sort keys")
+	}
+	err = services.ScanService.StartSchedule(cfg.Scanner.Schedule, model.ScanMode(cfg.Scanner.ScheduledMode))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to start scan schedule")
+	}
+	services.AuditService.StartCleanup(cfg.Audit.Retention)
+	log.Debug().Msg("Startup jobs finished")
+}
+
+func initializeServer(services *api.Services) (r *gin.Engine) {
+	r = api.SetupRouter(services)
 	log.Debug().Msg("Router initialized")
 	return r
 }
@@ -24,7 +24,7 @@ const docTemplate = `{
     "paths": {
         "/albums": {
             "get": {
-                "description": "Retrieves a list of all albums, including their best covers if requested.",
+                "description": "Retrieves a list of all albums, including their best covers if requested. Albums of different artists or years may share a title.",
                 "consumes": [
                     "application/json"
                 ],
@@ -41,6 +41,18 @@ const docTemplate = `{
                         "description": "Number of best covers for each album to retrieve",
                         "name": "bestCovers",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Return only albums with this title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by title, artist or year, in reverse with a leading minus, like -year. Ignored with title",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -51,7 +63,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid bestCovers format",
+                        "description": "Invalid bestCovers format or sort",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -121,6 +133,71 @@ const docTemplate = `{
                 }
             }
         },
+        "/albums/{albumId}/merge": {
+            "post": {
+                "description": "Moves the songs of the source albums to the target album. The source albums are removed and their identities become aliases of the target album, so later scans keep the merge.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Merge albums",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Album ID to merge into",
+                        "name": "albumId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Albums to merge",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.mergeAlbumsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Merged album",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.albumResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid albumId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Album not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Album merged into itself",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/albums/{albumId}/songs": {
             "get": {
                 "description": "Retrieves all songs that are part of the specified album, including detailed information about each song.",
@@ -171,6 +248,71 @@ const docTemplate = `{
                 }
             }
         },
+        "/albums/{albumId}/split": {
+            "post": {
+                "description": "Moves the songs off the album to a new album with the title and the album artist and year of the album, as when two releases share an identity. The songs stay on the new album through later scans.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Albums"
+                ],
+                "summary": "Split album",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Album ID to split",
+                        "name": "albumId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Songs to move and the new album",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.splitAlbumRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New album",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.albumResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid albumId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Album or song not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Album already exists or song does not belong to the album",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
         "/artists": {
             "get": {
                 "description": "Retrieves a list of all artists, including their best covers if requested.",
@@ -190,6 +332,12 @@ const docTemplate = `{
                         "description": "Number of best covers for each artist to retrieve",
                         "name": "bestCovers",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by name, in reverse with a leading minus: name or -name",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -200,7 +348,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid bestCovers format",
+                        "description": "Invalid bestCovers format or sort",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -270,9 +418,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{artistId}/songs": {
+        "/artists/{artistId}/albums": {
             "get": {
-                "description": "Retrieves all songs that are part of the specified artist, including detailed information about each song.",
+                "description": "Retrieves the albums where the artist is the album artist and, separately, the albums where the artist only appears on some songs.",
                 "consumes": [
                     "application/json"
                 ],
@@ -280,9 +428,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Songs"
+                    "Albums"
                 ],
-                "summary": "Retrieve songs by artist ID",
+                "summary": "Retrieve albums by artist ID",
                 "parameters": [
                     {
                         "type": "integer",
@@ -294,9 +442,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with a list of songs belonging to the requested artist",
+                        "description": "Successful response with the albums of the requested artist",
                         "schema": {
-                            "$ref": "#/definitions/song_handler.getByArtistIdResponse"
+                            "$ref": "#/definitions/album_handler.getAllByArtistIdResponse"
                         }
                     },
                     "400": {
@@ -320,9 +468,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres": {
-            "get": {
-                "description": "Retrieves a list of all genres, including their best covers if requested.",
+        "/artists/{artistId}/merge": {
+            "post": {
+                "description": "Moves the songs of the source artists to the target artist and makes the target artist the album artist of their albums, merging albums that then share an identity. The source artists are removed and their names become aliases of the target artist, so later scans keep the merge.",
                 "consumes": [
                     "application/json"
                 ],
@@ -330,26 +478,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Genres"
+                    "Artists"
                 ],
-                "summary": "Retrieve all genres",
+                "summary": "Merge artists",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Number of best covers for each genre to retrieve",
-                        "name": "bestCovers",
-                        "in": "query"
+                        "description": "Artist ID to merge into",
+                        "name": "artistId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Artists to merge",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.mergeArtistsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with a list of genres and optional best covers for each",
+                        "description": "Merged artist",
                         "schema": {
-                            "$ref": "#/definitions/genre_handler.getAllResponse"
+                            "$ref": "#/definitions/song_handler.artistResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid bestCovers format",
+                        "description": "Invalid artistId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Artist not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Artist merged into itself",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -363,9 +533,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres/{genreId}": {
+        "/artists/{artistId}/songs": {
             "get": {
-                "description": "Retrieves detailed information about a genre, including its best covers if requested.",
+                "description": "Retrieves all songs the specified artist is credited on as a main, featured or remixing artist, including detailed information about each song.",
                 "consumes": [
                     "application/json"
                 ],
@@ -373,39 +543,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Genres"
+                    "Songs"
                 ],
-                "summary": "Retrieve genre details",
+                "summary": "Retrieve songs by artist ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Genre ID",
-                        "name": "genreId",
+                        "description": "Unique identifier of the artist",
+                        "name": "artistId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of best covers to retrieve",
-                        "name": "bestCovers",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful response with a list of songs belonging to the requested artist",
                         "schema": {
-                            "$ref": "#/definitions/genre_handler.getResponse"
+                            "$ref": "#/definitions/song_handler.getByArtistIdResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid genreId or bestCovers format",
+                        "description": "Invalid artistId format",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
                     },
                     "404": {
-                        "description": "Genre not found",
+                        "description": "Artist not found",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -419,9 +583,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/genres/{genreId}/songs": {
-            "get": {
-                "description": "Retrieves all songs that are part of the specified genre, including detailed information about each song.",
+        "/artists/{artistId}/split": {
+            "post": {
+                "description": "Moves the songs off the artist to a new artist, as when two artists share a name. The songs keep crediting the new artist through later scans. Albums keep their album artist.",
                 "consumes": [
                     "application/json"
                 ],
@@ -429,33 +593,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Songs"
+                    "Artists"
                 ],
-                "summary": "Retrieve songs by genre ID",
+                "summary": "Split artist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Unique identifier of the genre",
-                        "name": "genreId",
+                        "description": "Artist ID to split",
+                        "name": "artistId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Songs to move and the new artist",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.splitArtistRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with a list of songs belonging to the requested genre",
+                        "description": "New artist",
                         "schema": {
-                            "$ref": "#/definitions/song_handler.getByGenreIdResponse"
+                            "$ref": "#/definitions/song_handler.artistResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid genreId format",
+                        "description": "Invalid artistId format or request body",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
                     },
                     "404": {
-                        "description": "Genre not found",
+                        "description": "Artist or song not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Artist already exists or song does not belong to the artist",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -469,9 +648,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/scan": {
+        "/changes/{changeSetId}/revert": {
             "post": {
-                "description": "Scans the system for any new or updated songs, updating the database accordingly.",
+                "description": "Undoes every change of the change set in a new change set, which is returned. A change set can be reverted once and only while the rows it changed were not changed again since.",
                 "consumes": [
                     "application/json"
                 ],
@@ -479,15 +658,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Scan"
+                    "Changes"
+                ],
+                "summary": "Revert change set",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Change set ID",
+                        "name": "changeSetId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Initiate a scan for new or updated songs",
                 "responses": {
                     "200": {
-                        "description": "Successfully initiated song scan"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/audit_handler.changeSetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid changeSetId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Change set not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Change set already reverted or changed again since",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error due to failure in scanning process",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -495,9 +704,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/songs": {
+        "/composers": {
             "get": {
-                "description": "Retrieves detailed information about all available songs.",
+                "description": "Retrieves the artists credited as composer on at least one song or work.",
                 "consumes": [
                     "application/json"
                 ],
@@ -505,17 +714,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Songs"
+                    "Artists"
                 ],
-                "summary": "Retrieve a list of all songs",
+                "summary": "Retrieve all composers",
                 "responses": {
                     "200": {
-                        "description": "Successful response with list of songs",
+                        "description": "Success response with a list of composers",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/song_handler.getAllResponseItem"
-                            }
+                            "$ref": "#/definitions/artist_handler.getAllComposersResponse"
                         }
                     },
                     "500": {
@@ -527,9 +733,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/songs/{songId}": {
+        "/composers/{artistId}/songs": {
             "get": {
-                "description": "Retrieves detailed information about a song specified by its unique ID.",
+                "description": "Retrieves all songs the specified artist is credited on as a composer, with the movements of each work kept together.",
                 "consumes": [
                     "application/json"
                 ],
@@ -539,31 +745,31 @@ const docTemplate = `{
                 "tags": [
                     "Songs"
                 ],
-                "summary": "Retrieve a song by its ID",
+                "summary": "Retrieve songs by composer ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Unique identifier of the song",
-                        "name": "songId",
+                        "description": "Unique identifier of the artist",
+                        "name": "artistId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with song details",
+                        "description": "Successful response with a list of songs composed by the requested artist",
                         "schema": {
-                            "$ref": "#/definitions/song_handler.getResponse"
+                            "$ref": "#/definitions/song_handler.getByComposerIdResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid songId format",
+                        "description": "Invalid artistId format",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
                     },
                     "404": {
-                        "description": "Song not found",
+                        "description": "Artist not found",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -576,155 +782,2490 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "album_handler.getAllResponse": {
-            "type": "object",
-            "properties": {
-                "albums": {
-                    "description": "Array of albums.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/album_handler.getAllResponseItem"
+        },
+        "/composers/{artistId}/works": {
+            "get": {
+                "description": "Retrieves the works the specified artist composed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Works"
+                ],
+                "summary": "Retrieve works by composer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Unique identifier of the composer",
+                        "name": "artistId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success response with a list of works of the composer",
+                        "schema": {
+                            "$ref": "#/definitions/work_handler.getAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid artistId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Artist not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/audio-files": {
+            "post": {
+                "description": "Reconciles only the songs of the audio files mentioned in the notifications with their current state in music-files. Sending the same notifications again changes nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Apply audio file change notifications",
+                "parameters": [
+                    {
+                        "description": "Change notifications",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/event_handler.applyAudioFileEventsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/event_handler.applyAudioFileEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "A scan is running",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres": {
+            "get": {
+                "description": "Retrieves a list of all genres, including their best covers if requested.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Retrieve all genres",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of best covers for each genre to retrieve",
+                        "name": "bestCovers",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success response with a list of genres and optional best covers for each",
+                        "schema": {
+                            "$ref": "#/definitions/genre_handler.getAllResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid bestCovers format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/normalize": {
+            "post": {
+                "description": "Merges genres stored with ID3v1 numbers or parenthesized references, like \"17\", \"(17)\" or \"(17)Rock\", into canonical genres such as \"Rock\". Meant to be run once for data scanned before genre names were normalized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Normalize legacy genres",
+                "responses": {
+                    "200": {
+                        "description": "Successful response with the merged genres",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.normalizeGenresResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}": {
+            "get": {
+                "description": "Retrieves detailed information about a genre, including its best covers if requested.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Retrieve genre details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of best covers to retrieve",
+                        "name": "bestCovers",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/genre_handler.getResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid genreId or bestCovers format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}/aliases": {
+            "get": {
+                "description": "Retrieves the alternative names that scanned genre names are resolved to the genre by.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Retrieve genre aliases",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/genre_handler.getAliasesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid genreId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Makes scanned genre names matching the alias (ignoring case, Unicode form and repeated whitespace) resolve to the genre. A genre already scanned under the alias name is merged into the genre, so its songs list the genre right away.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Add genre alias",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alias to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/genre_handler.addAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Alias added"
+                    },
+                    "400": {
+                        "description": "Invalid genreId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Alias already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}/aliases/{alias}": {
+            "delete": {
+                "description": "Stops resolving scanned genre names equal to the alias to the genre.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Remove genre alias",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alias to remove",
+                        "name": "alias",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Alias removed"
+                    },
+                    "400": {
+                        "description": "Invalid genreId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Alias of the genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}/merge": {
+            "post": {
+                "description": "Moves the songs of the source genres to the target genre and places their subgenres under it. The source genres are removed and their names become aliases of the target genre, so later scans keep the merge.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Merge genres",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID to merge into",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Genres to merge",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.mergeGenresRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Merged genre",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.genreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid genreId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Genre merged into itself",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}/parent": {
+            "put": {
+                "description": "Places the genre under the parent genre, or at the top of the hierarchy if parentGenreId is null. A genre cannot be placed under itself or one of its subgenres.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Set genre parent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Parent genre",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/genre_handler.setParentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Parent set"
+                    },
+                    "400": {
+                        "description": "Invalid genreId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre or parent genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Genre hierarchy would contain a cycle",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}/songs": {
+            "get": {
+                "description": "Retrieves all songs that have the specified genre among their genres, including detailed information about each song.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Retrieve songs by genre ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Unique identifier of the genre",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return the songs of all subgenres of the genre",
+                        "name": "includeSubgenres",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response with a list of songs belonging to the requested genre",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.getByGenreIdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid genreId or includeSubgenres format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{genreId}/split": {
+            "post": {
+                "description": "Moves the songs off the genre to a new genre. The songs keep listing the new genre through later scans.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Genres"
+                ],
+                "summary": "Split genre",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Genre ID to split",
+                        "name": "genreId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Songs to move and the new genre",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.splitGenreRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New genre",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.genreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid genreId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Genre or song not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Genre already exists or song does not belong to the genre",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan": {
+            "post": {
+                "description": "Starts a background job that scans the system for any new or updated songs and updates the database accordingly. The progress can be tracked by the returned scan job id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Initiate a scan for new or updated songs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Scan mode: full (default) or incremental, which skips files not updated since the previous successful scan",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only compute the changes and report them as the scan job diff without applying them",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Scan job started",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.startResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid mode or dryRun",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Another scan is already running",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/failures": {
+            "get": {
+                "description": "Retrieves the audio files skipped by scans because they could not be downloaded, parsed or saved. A file leaves the list once a scan processes it successfully or it is deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retrieve scan failures",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.getFailuresResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/failures/retry": {
+            "post": {
+                "description": "Starts a background scan job in retry mode, which processes only the audio files listed in scan failures. Files that succeed or no longer exist leave the list.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retry failed audio files",
+                "responses": {
+                    "202": {
+                        "description": "Scan job started",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.startResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Another scan is already running",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/jobs": {
+            "get": {
+                "description": "Retrieves current and past scan jobs, newest first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retrieve all scan jobs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.getAllJobsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/jobs/{scanJobId}": {
+            "get": {
+                "description": "Retrieves the phase, progress and per-phase counters of a scan job.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retrieve scan job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scan job ID",
+                        "name": "scanJobId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.getJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scanJobId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Scan job not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/jobs/{scanJobId}/ambiguities": {
+            "get": {
+                "description": "Retrieves the cases a scan job could not resolve unambiguously: several audio files with the same hash, files matching different songs by id and by hash, and songs that lost both id and hash.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retrieve scan ambiguities",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scan job ID",
+                        "name": "scanJobId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.getAmbiguitiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scanJobId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Scan job not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/jobs/{scanJobId}/diff": {
+            "get": {
+                "description": "Retrieves the songs, albums, artists and genres a dry-run scan job would create, remove, relink or re-parse. Nothing of it is applied to the database.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retrieve scan diff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scan job ID",
+                        "name": "scanJobId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.getDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid scanJobId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Scan diff not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/rescan": {
+            "post": {
+                "description": "Starts a background scan job that downloads and parses the audio files of the chosen songs again, even if their content did not change, and then removes albums, artists and genres left without songs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Rescan chosen songs",
+                "parameters": [
+                    {
+                        "description": "Songs to rescan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.rescanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Scan job started",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.startResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Song, album or artist not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Another scan is already running",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/schedule": {
+            "get": {
+                "description": "Retrieves the configured schedule of periodic scans together with the last and the next run times.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Retrieve scan schedule",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/scan_handler.getScheduleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops periodic scans until the service restarts. A scan that is already running goes on.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Scan"
+                ],
+                "summary": "Stop scan schedule",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/songs": {
+            "get": {
+                "description": "Retrieves detailed information about all available songs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Retrieve a list of all songs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sort by title, artist or album, in reverse with a leading minus, like -title",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response with list of songs",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/song_handler.getAllResponseItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sort",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Applies the same overrides to the listed songs, to all songs of an album or genre, or to the songs an artist is the main artist of, in one transaction. Running scans do not block it. Songs that fail, for example because their file cannot be downloaded, keep their previous overrides and are reported in the results.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Override metadata of several songs",
+                "parameters": [
+                    {
+                        "description": "Songs to update and fields to override",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.bulkUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Results for every selected song",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.bulkUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Song, album, artist or genre not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{songId}": {
+            "get": {
+                "description": "Retrieves detailed information about a song specified by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Retrieve a song by its ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Unique identifier of the song",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response with song details",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.getResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid songId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Sets fields of the song to values that replace its tags. Overridden artists, albums and genres are resolved like tags, and later scans keep the overrides. The song is read again from its audio file to apply them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Override song metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Unique identifier of the song",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to override, or null to drop an override",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Song with the overrides applied",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.getResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid songId format or request body",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Song not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{songId}/history": {
+            "get": {
+                "description": "Retrieves the change sets that changed the song, its artists, genres, overrides or splits, with the rows before and after each change. Songs removed since keep their history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Retrieve song history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/audit_handler.getSongHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid songId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{songId}/write-tags": {
+            "post": {
+                "description": "Writes the overridden fields of the song into the tags of its audio file (ID3v2.4 for MP3, Vorbis comments for FLAC, Ogg Vorbis and Opus, atoms for MP4) and uploads the file to music-files, so other players see them too. The song keeps its overrides and takes the hash of the new content.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Write song tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Unique identifier of the song",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Song read from the written file",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.getResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid songId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Song or audio file not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Tags of the audio file cannot be written",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/works": {
+            "get": {
+                "description": "Retrieves a list of all works, like symphonies or sonatas, whose movements are songs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Works"
+                ],
+                "summary": "Retrieve all works",
+                "responses": {
+                    "200": {
+                        "description": "Success response with a list of works",
+                        "schema": {
+                            "$ref": "#/definitions/work_handler.getAllResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/{workId}": {
+            "get": {
+                "description": "Retrieves detailed information about a work, like a symphony, whose movements are songs.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Works"
+                ],
+                "summary": "Retrieve work details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Work ID",
+                        "name": "workId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/work_handler.getResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid workId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Work not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/works/{workId}/songs": {
+            "get": {
+                "description": "Retrieves the movements of the specified work in their order, whatever album they come from.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Songs"
+                ],
+                "summary": "Retrieve songs by work ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Unique identifier of the work",
+                        "name": "workId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response with a list of movements of the requested work",
+                        "schema": {
+                            "$ref": "#/definitions/song_handler.getByWorkIdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid workId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Work not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "album_handler.getAllByArtistIdResponse": {
+            "type": "object",
+            "properties": {
+                "albums": {
+                    "description": "Albums where the artist is the album artist.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/album_handler.getAllByArtistIdResponseItem"
+                    }
+                },
+                "appearances": {
+                    "description": "Albums of other album artists, including compilations, where the artist only appears on some songs.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/album_handler.getAllByArtistIdResponseItem"
+                    }
+                }
+            }
+        },
+        "album_handler.getAllByArtistIdResponseItem": {
+            "type": "object",
+            "properties": {
+                "albumArtistId": {
+                    "description": "Identifier of the album artist, if known.",
+                    "type": "integer"
+                },
+                "albumId": {
+                    "description": "Unique identifier for the album.",
+                    "type": "integer"
+                },
+                "compilation": {
+                    "description": "Whether the album is a compilation of various artists.",
+                    "type": "boolean"
+                },
+                "label": {
+                    "description": "Record label most songs of the album carry, if known.",
+                    "type": "string"
+                },
+                "musicBrainzReleaseId": {
+                    "description": "MusicBrainz release identifier, if the files carry one.",
+                    "type": "string"
+                },
+                "releaseDate": {
+                    "description": "Release date most songs of the album carry as YYYY-MM-DD, if known.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title of the album.",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "Release year of the album, if known.",
+                    "type": "integer"
+                }
+            }
+        },
+        "album_handler.getAllResponse": {
+            "type": "object",
+            "properties": {
+                "albums": {
+                    "description": "Array of albums.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/album_handler.getAllResponseItem"
+                    }
+                }
+            }
+        },
+        "album_handler.getAllResponseItem": {
+            "type": "object",
+            "properties": {
+                "albumArtistId": {
+                    "description": "Identifier of the album artist, if known.",
+                    "type": "integer"
+                },
+                "albumId": {
+                    "description": "Unique identifier for the album.",
+                    "type": "integer"
+                },
+                "compilation": {
+                    "description": "Whether the album is a compilation of various artists.",
+                    "type": "boolean"
+                },
+                "label": {
+                    "description": "Record label most songs of the album carry, if known.",
+                    "type": "string"
+                },
+                "musicBrainzReleaseId": {
+                    "description": "MusicBrainz release identifier, if the files carry one.",
+                    "type": "string"
+                },
+                "releaseDate": {
+                    "description": "Release date most songs of the album carry as YYYY-MM-DD, if known.",
+                    "type": "string"
+                },
+                "sortTitle": {
+                    "description": "Title the album is sorted by, if the files carry one.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title of the album.",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "Release year of the album, if known.",
+                    "type": "integer"
+                }
+            }
+        },
+        "album_handler.getResponse": {
+            "type": "object",
+            "properties": {
+                "albumArtistId": {
+                    "description": "Identifier of the album artist, if known.",
+                    "type": "integer"
+                },
+                "albumId": {
+                    "description": "Unique identifier for the album.",
+                    "type": "integer"
+                },
+                "compilation": {
+                    "description": "Whether the album is a compilation of various artists.",
+                    "type": "boolean"
+                },
+                "label": {
+                    "description": "Record label most songs of the album carry, if known.",
+                    "type": "string"
+                },
+                "musicBrainzReleaseId": {
+                    "description": "MusicBrainz release identifier, if the files carry one.",
+                    "type": "string"
+                },
+                "releaseDate": {
+                    "description": "Release date most songs of the album carry as YYYY-MM-DD, if known.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title of the album.",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "Release year of the album, if known.",
+                    "type": "integer"
+                }
+            }
+        },
+        "artist_handler.getAllComposersResponse": {
+            "type": "object",
+            "properties": {
+                "composers": {
+                    "description": "Array of artists credited as composers.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/artist_handler.getAllResponseItem"
+                    }
+                }
+            }
+        },
+        "artist_handler.getAllResponse": {
+            "type": "object",
+            "properties": {
+                "artists": {
+                    "description": "Array of artists.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/artist_handler.getAllResponseItem"
+                    }
+                }
+            }
+        },
+        "artist_handler.getAllResponseItem": {
+            "type": "object",
+            "properties": {
+                "artistId": {
+                    "description": "Unique identifier for the artist.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the artist.",
+                    "type": "string"
+                },
+                "sortName": {
+                    "description": "Name the artist is sorted by, if the files carry one.",
+                    "type": "string"
+                }
+            }
+        },
+        "artist_handler.getResponse": {
+            "type": "object",
+            "properties": {
+                "artistId": {
+                    "description": "Unique identifier for the artist.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the artist.",
+                    "type": "string"
+                }
+            }
+        },
+        "audit_handler.changeSetResponse": {
+            "type": "object",
+            "properties": {
+                "changeSetId": {
+                    "description": "Unique identifier of the change set.",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "description": "Time when the change was made.",
+                    "type": "string"
+                },
+                "entries": {
+                    "description": "Rows changed by the change set.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/audit_handler.changeSetResponseEntry"
+                    }
+                },
+                "revertedChangeSetId": {
+                    "description": "Identifier of the change set undone by this one, if it is a revert.",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "Source of the change: scan, event, edit, merge, revert or unknown.",
+                    "type": "string"
+                }
+            }
+        },
+        "audit_handler.changeSetResponseEntry": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "description": "Row after the change, null for deleted rows.",
+                    "type": "object"
+                },
+                "before": {
+                    "description": "Row before the change, null for inserted rows.",
+                    "type": "object"
+                },
+                "operation": {
+                    "description": "Change made to the row: insert, update or delete.",
+                    "type": "string"
+                },
+                "rowKey": {
+                    "description": "Primary key columns of the row.",
+                    "type": "object"
+                },
+                "table": {
+                    "description": "Name of the table the row belongs to.",
+                    "type": "string"
+                }
+            }
+        },
+        "audit_handler.getSongHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "changeSets": {
+                    "description": "Change sets that changed the song, oldest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/audit_handler.changeSetResponse"
+                    }
+                }
+            }
+        },
+        "event_handler.applyAudioFileEventsRequest": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "Array of change notifications.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/event_handler.applyAudioFileEventsRequestItem"
+                    }
+                }
+            }
+        },
+        "event_handler.applyAudioFileEventsRequestItem": {
+            "type": "object",
+            "properties": {
+                "audioFileId": {
+                    "description": "Identifier of the changed audio file.",
+                    "type": "integer"
+                },
+                "previousAudioFileId": {
+                    "description": "Identifier the audio file had before the move. Required for moved.",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Kind of the change: created, updated, deleted or moved.",
+                    "type": "string"
+                }
+            }
+        },
+        "event_handler.applyAudioFileEventsResponse": {
+            "type": "object",
+            "properties": {
+                "changedCount": {
+                    "description": "Number of songs whose content was changed.",
+                    "type": "integer"
+                },
+                "createdCount": {
+                    "description": "Number of created songs.",
+                    "type": "integer"
+                },
+                "failedCount": {
+                    "description": "Number of files skipped because they could not be downloaded, parsed or saved.",
+                    "type": "integer"
+                },
+                "movedCount": {
+                    "description": "Number of songs whose audio file was moved.",
+                    "type": "integer"
+                },
+                "removedCount": {
+                    "description": "Number of removed songs.",
+                    "type": "integer"
+                },
+                "scanJobId": {
+                    "description": "Unique identifier of the scan job that applied the events.",
+                    "type": "integer"
+                }
+            }
+        },
+        "genre_handler.addAliasRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "description": "Alternative name to resolve to the genre. Matched ignoring case, Unicode form and repeated whitespace.",
+                    "type": "string"
+                }
+            }
+        },
+        "genre_handler.getAliasesResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Alternative names that scanned genres resolve to the genre by.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "genre_handler.getAllResponse": {
+            "type": "object",
+            "properties": {
+                "genres": {
+                    "description": "Array of genres.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/genre_handler.getAllResponseItem"
+                    }
+                }
+            }
+        },
+        "genre_handler.getAllResponseItem": {
+            "type": "object",
+            "properties": {
+                "genreId": {
+                    "description": "Unique identifier for the genre.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the genre.",
+                    "type": "string"
+                },
+                "parentGenreId": {
+                    "description": "Identifier of the parent genre, if the genre is a subgenre.",
+                    "type": "integer"
+                }
+            }
+        },
+        "genre_handler.getResponse": {
+            "type": "object",
+            "properties": {
+                "genreId": {
+                    "description": "Unique identifier for the genre.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name of the genre.",
+                    "type": "string"
+                },
+                "parentGenreId": {
+                    "description": "Identifier of the parent genre, if the genre is a subgenre.",
+                    "type": "integer"
+                }
+            }
+        },
+        "genre_handler.setParentRequest": {
+            "type": "object",
+            "properties": {
+                "parentGenreId": {
+                    "description": "Identifier of the parent genre, or null to make the genre a top-level one.",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.SongArtistRole": {
+            "type": "string",
+            "enum": [
+                "main",
+                "featured",
+                "remixer",
+                "composer",
+                "conductor"
+            ],
+            "x-enum-varnames": [
+                "SongArtistRoleMain",
+                "SongArtistRoleFeatured",
+                "SongArtistRoleRemixer",
+                "SongArtistRoleComposer",
+                "SongArtistRoleConductor"
+            ]
+        },
+        "model.SongOverrideField": {
+            "type": "string",
+            "enum": [
+                "title",
+                "artist",
+                "album",
+                "albumArtist",
+                "genre",
+                "year",
+                "songNumber",
+                "discNumber",
+                "lyrics"
+            ],
+            "x-enum-varnames": [
+                "SongOverrideFieldTitle",
+                "SongOverrideFieldArtist",
+                "SongOverrideFieldAlbum",
+                "SongOverrideFieldAlbumArtist",
+                "SongOverrideFieldGenre",
+                "SongOverrideFieldYear",
+                "SongOverrideFieldSongNumber",
+                "SongOverrideFieldDiscNumber",
+                "SongOverrideFieldLyrics"
+            ]
+        },
+        "response.Error": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "Human-readable error message",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Internal error description",
+                    "type": "string"
+                }
+            }
+        },
+        "scan_handler.getAllJobsResponse": {
+            "type": "object",
+            "properties": {
+                "scanJobs": {
+                    "description": "Array of scan jobs, newest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getAllJobsResponseItem"
                     }
                 }
             }
         },
-        "album_handler.getAllResponseItem": {
+        "scan_handler.getAllJobsResponseItem": {
             "type": "object",
             "properties": {
-                "albumId": {
-                    "description": "Unique identifier for the album.",
+                "changedCount": {
+                    "description": "Number of songs whose content was changed.",
+                    "type": "integer"
+                },
+                "contentHighWaterMark": {
+                    "description": "Latest content update among the audio files seen by the scan.",
+                    "type": "string"
+                },
+                "createdCount": {
+                    "description": "Number of created songs.",
+                    "type": "integer"
+                },
+                "dryRun": {
+                    "description": "Whether the scan only reported its changes without applying them.",
+                    "type": "boolean"
+                },
+                "error": {
+                    "description": "Error description if the scan job failed.",
+                    "type": "string"
+                },
+                "failedCount": {
+                    "description": "Number of files skipped because they could not be downloaded, parsed or saved.",
+                    "type": "integer"
+                },
+                "finishedAt": {
+                    "description": "Time when the scan job was finished.",
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "Mode of the scan: full, incremental, retry, event or targeted.",
+                    "type": "string"
+                },
+                "movedCount": {
+                    "description": "Number of songs whose audio file was moved.",
+                    "type": "integer"
+                },
+                "phase": {
+                    "description": "Current phase of the scan.",
+                    "type": "string"
+                },
+                "processedFiles": {
+                    "description": "Number of files already processed.",
                     "type": "integer"
                 },
-                "bestCovers": {
-                    "description": "Optional array of best cover IDs for the album.",
+                "removedCount": {
+                    "description": "Number of removed songs.",
+                    "type": "integer"
+                },
+                "scanJobId": {
+                    "description": "Unique identifier of the scan job.",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "description": "Time when the scan job was started.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status of the scan job: running, succeeded or failed.",
+                    "type": "string"
+                },
+                "totalFiles": {
+                    "description": "Number of files that need processing.",
+                    "type": "integer"
+                }
+            }
+        },
+        "scan_handler.getAmbiguitiesResponse": {
+            "type": "object",
+            "properties": {
+                "ambiguities": {
+                    "description": "Array of ambiguities found by the scan job.",
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/scan_handler.getAmbiguitiesResponseItem"
                     }
+                }
+            }
+        },
+        "scan_handler.getAmbiguitiesResponseItem": {
+            "type": "object",
+            "properties": {
+                "audioFileId": {
+                    "description": "Identifier of the audio file involved, if any.",
+                    "type": "integer"
                 },
-                "title": {
-                    "description": "Title of the album.",
+                "description": {
+                    "description": "Human-readable description of the ambiguity and how the scan resolved it.",
                     "type": "string"
+                },
+                "kind": {
+                    "description": "Kind of the ambiguity: duplicate_sha256, conflicting_match or id_and_hash_changed.",
+                    "type": "string"
+                },
+                "sha256": {
+                    "description": "SHA256 hash involved, if any.",
+                    "type": "string"
+                },
+                "songId": {
+                    "description": "Identifier of the song involved, if any.",
+                    "type": "integer"
                 }
             }
         },
-        "album_handler.getResponse": {
+        "scan_handler.getDiffResponse": {
+            "type": "object",
+            "properties": {
+                "createdAlbums": {
+                    "description": "Albums the scan would create.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseItem"
+                    }
+                },
+                "createdArtists": {
+                    "description": "Artists the scan would create.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseItem"
+                    }
+                },
+                "createdGenres": {
+                    "description": "Genres the scan would create.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseItem"
+                    }
+                },
+                "createdSongs": {
+                    "description": "Songs the scan would create.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseSong"
+                    }
+                },
+                "relinkedSongs": {
+                    "description": "Songs the scan would link to another audio file with the same content.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseSong"
+                    }
+                },
+                "removedAlbums": {
+                    "description": "Albums the scan would remove.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseItem"
+                    }
+                },
+                "removedArtists": {
+                    "description": "Artists the scan would remove.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseItem"
+                    }
+                },
+                "removedGenres": {
+                    "description": "Genres the scan would remove.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseItem"
+                    }
+                },
+                "removedSongs": {
+                    "description": "Songs the scan would remove.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseSong"
+                    }
+                },
+                "reparsedSongs": {
+                    "description": "Songs the scan would re-parse, with their new values.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scan_handler.getDiffResponseSong"
+                    }
+                }
+            }
+        },
+        "scan_handler.getDiffResponseItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Identifier of the item, provisional for created items.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Title of the album or name of the artist or genre.",
+                    "type": "string"
+                }
+            }
+        },
+        "scan_handler.getDiffResponseSong": {
             "type": "object",
             "properties": {
                 "albumId": {
-                    "description": "Unique identifier for the album.",
+                    "description": "Identifier of the album. Albums created by the scan have provisional ids.",
+                    "type": "integer"
+                },
+                "artistId": {
+                    "description": "Identifier of the artist. Artists created by the scan have provisional ids.",
+                    "type": "integer"
+                },
+                "audioFileId": {
+                    "description": "Identifier of the audio file the song would be linked to.",
+                    "type": "integer"
+                },
+                "discNumber": {
+                    "description": "Disc number of the song in the album.",
+                    "type": "integer"
+                },
+                "genreId": {
+                    "description": "Identifier of the genre. Genres created by the scan have provisional ids.",
+                    "type": "integer"
+                },
+                "lyrics": {
+                    "description": "Lyrics of the song.",
+                    "type": "string"
+                },
+                "previousAudioFileId": {
+                    "description": "Identifier of the audio file the song was linked to before, only for relinked songs.",
+                    "type": "integer"
+                },
+                "sha256": {
+                    "description": "SHA256 hash of the audio file.",
+                    "type": "string"
+                },
+                "songId": {
+                    "description": "Unique identifier of the song, absent for songs the scan would create.",
                     "type": "integer"
                 },
-                "bestCovers": {
-                    "description": "Optional array of best cover IDs.",
+                "songNumber": {
+                    "description": "Track number of the song in the album.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title of the song.",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "Year of the song release.",
+                    "type": "integer"
+                }
+            }
+        },
+        "scan_handler.getFailuresResponse": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "description": "Array of quarantined audio files, latest failures first.",
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/scan_handler.getFailuresResponseItem"
                     }
+                }
+            }
+        },
+        "scan_handler.getFailuresResponseItem": {
+            "type": "object",
+            "properties": {
+                "audioFileId": {
+                    "description": "Identifier of the audio file.",
+                    "type": "integer"
                 },
-                "title": {
-                    "description": "Title of the album.",
+                "error": {
+                    "description": "Description of the error.",
+                    "type": "string"
+                },
+                "failedAt": {
+                    "description": "Time of the failure.",
+                    "type": "string"
+                },
+                "phase": {
+                    "description": "Phase in which the audio file failed: download, parse or persist.",
                     "type": "string"
+                },
+                "scanJobId": {
+                    "description": "Identifier of the scan job in which the audio file failed last.",
+                    "type": "integer"
                 }
             }
         },
-        "artist_handler.getAllResponse": {
+        "scan_handler.getJobResponse": {
             "type": "object",
             "properties": {
-                "artists": {
-                    "description": "Array of artists.",
+                "changedCount": {
+                    "description": "Number of songs whose content was changed.",
+                    "type": "integer"
+                },
+                "contentHighWaterMark": {
+                    "description": "Latest content update among the audio files seen by the scan.",
+                    "type": "string"
+                },
+                "createdCount": {
+                    "description": "Number of created songs.",
+                    "type": "integer"
+                },
+                "dryRun": {
+                    "description": "Whether the scan only reported its changes without applying them.",
+                    "type": "boolean"
+                },
+                "error": {
+                    "description": "Error description if the scan job failed.",
+                    "type": "string"
+                },
+                "failedCount": {
+                    "description": "Number of files skipped because they could not be downloaded, parsed or saved.",
+                    "type": "integer"
+                },
+                "finishedAt": {
+                    "description": "Time when the scan job was finished.",
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "Mode of the scan: full, incremental, retry, event or targeted.",
+                    "type": "string"
+                },
+                "movedCount": {
+                    "description": "Number of songs whose audio file was moved.",
+                    "type": "integer"
+                },
+                "phase": {
+                    "description": "Current phase of the scan: preparing, creating, removing, moving, changing, cleaning or finished.",
+                    "type": "string"
+                },
+                "processedFiles": {
+                    "description": "Number of files already processed.",
+                    "type": "integer"
+                },
+                "removedCount": {
+                    "description": "Number of removed songs.",
+                    "type": "integer"
+                },
+                "scanJobId": {
+                    "description": "Unique identifier of the scan job.",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "description": "Time when the scan job was started.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status of the scan job: running, succeeded or failed.",
+                    "type": "string"
+                },
+                "totalFiles": {
+                    "description": "Number of files that need processing.",
+                    "type": "integer"
+                }
+            }
+        },
+        "scan_handler.getScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "description": "Whether periodic scans are configured.",
+                    "type": "boolean"
+                },
+                "expression": {
+                    "description": "Cron expression of the schedule.",
+                    "type": "string"
+                },
+                "lastRunAt": {
+                    "description": "Time when the schedule last fired since the service started.",
+                    "type": "string"
+                },
+                "lastRunError": {
+                    "description": "Why the last run failed to start a scan, if it failed.",
+                    "type": "string"
+                },
+                "lastRunSkipped": {
+                    "description": "Whether the last run was skipped because another scan was still running.",
+                    "type": "boolean"
+                },
+                "lastScanJobId": {
+                    "description": "Identifier of the scan job started by the last run, if it was not skipped.",
+                    "type": "integer"
+                },
+                "mode": {
+                    "description": "Mode of periodic scans: full or incremental.",
+                    "type": "string"
+                },
+                "nextRunAt": {
+                    "description": "Time of the next run.",
+                    "type": "string"
+                }
+            }
+        },
+        "scan_handler.rescanRequest": {
+            "type": "object",
+            "properties": {
+                "albumId": {
+                    "description": "Identifier of an album whose songs to rescan.",
+                    "type": "integer"
+                },
+                "artistId": {
+                    "description": "Identifier of an artist whose songs to rescan.",
+                    "type": "integer"
+                },
+                "audioFileIds": {
+                    "description": "Identifiers of audio files whose songs to rescan.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/artist_handler.getAllResponseItem"
+                        "type": "integer"
+                    }
+                },
+                "songIds": {
+                    "description": "Identifiers of songs to rescan.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
                     }
                 }
             }
         },
-        "artist_handler.getAllResponseItem": {
+        "scan_handler.startResponse": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "description": "Whether the scan only reports its changes without applying them.",
+                    "type": "boolean"
+                },
+                "mode": {
+                    "description": "Mode of the scan: full, incremental, retry, event or targeted.",
+                    "type": "string"
+                },
+                "scanJobId": {
+                    "description": "Unique identifier of the started scan job.",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "description": "Time when the scan job was started.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status of the scan job.",
+                    "type": "string"
+                }
+            }
+        },
+        "song_handler.albumResponse": {
+            "type": "object",
+            "properties": {
+                "albumArtistId": {
+                    "description": "Identifier of the album artist, if known.",
+                    "type": "integer"
+                },
+                "albumId": {
+                    "description": "Unique identifier for the album.",
+                    "type": "integer"
+                },
+                "compilation": {
+                    "description": "Whether the album is a compilation of various artists.",
+                    "type": "boolean"
+                },
+                "musicBrainzReleaseId": {
+                    "description": "MusicBrainz release identifier, if the files carry one.",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title of the album.",
+                    "type": "string"
+                },
+                "year": {
+                    "description": "Release year of the album, if known.",
+                    "type": "integer"
+                }
+            }
+        },
+        "song_handler.artistResponse": {
             "type": "object",
             "properties": {
                 "artistId": {
                     "description": "Unique identifier for the artist.",
                     "type": "integer"
                 },
-                "bestCovers": {
-                    "description": "Optional array of best cover IDs for the artist.",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
                 "name": {
                     "description": "Name of the artist.",
                     "type": "string"
                 }
             }
         },
-        "artist_handler.getResponse": {
+        "song_handler.bulkUpdateRequest": {
             "type": "object",
             "properties": {
+                "albumId": {
+                    "description": "Identifier of the album whose songs to update, instead of songIds.",
+                    "type": "integer"
+                },
                 "artistId": {
-                    "description": "Unique identifier for the artist.",
+                    "description": "Identifier of the artist whose songs to update as their main artist, instead of songIds.",
                     "type": "integer"
                 },
-                "bestCovers": {
-                    "description": "Optional array of best cover IDs.",
+                "fields": {
+                    "description": "Fields to override on every song, in the same form as in the Update API.",
+                    "type": "object"
+                },
+                "genreId": {
+                    "description": "Identifier of the genre whose songs to update, instead of songIds.",
+                    "type": "integer"
+                },
+                "songIds": {
+                    "description": "Identifiers of the songs to update.",
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
-                },
-                "name": {
-                    "description": "Name of the artist.",
-                    "type": "string"
                 }
             }
         },
-        "genre_handler.getAllResponse": {
+        "song_handler.bulkUpdateResponse": {
             "type": "object",
             "properties": {
-                "genres": {
-                    "description": "Array of genres.",
+                "results": {
+                    "description": "Results for every selected song.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/genre_handler.getAllResponseItem"
+                        "$ref": "#/definitions/song_handler.bulkUpdateResponseItem"
                     }
                 }
             }
         },
-        "genre_handler.getAllResponseItem": {
+        "song_handler.bulkUpdateResponseItem": {
             "type": "object",
             "properties": {
-                "bestCovers": {
-                    "description": "Optional array of best cover IDs for the genre.",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "reason": {
+                    "description": "Reason the song was not updated.",
+                    "type": "string"
                 },
-                "genreId": {
-                    "description": "Unique identifier for the genre.",
+                "songId": {
+                    "description": "Identifier of the song.",
                     "type": "integer"
                 },
-                "name": {
-                    "description": "Name of the genre.",
-                    "type": "string"
+                "updated": {
+                    "description": "Whether the song was updated.",
+                    "type": "boolean"
                 }
             }
         },
-        "genre_handler.getResponse": {
+        "song_handler.genreResponse": {
             "type": "object",
             "properties": {
-                "bestCovers": {
-                    "description": "Optional array of best cover IDs.",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
                 "genreId": {
                     "description": "Unique identifier for the genre.",
                     "type": "integer"
@@ -732,19 +3273,10 @@ const docTemplate = `{
                 "name": {
                     "description": "Name of the genre.",
                     "type": "string"
-                }
-            }
-        },
-        "response.Error": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "description": "Human-readable error message",
-                    "type": "string"
                 },
-                "reason": {
-                    "description": "Internal error description",
-                    "type": "string"
+                "parentGenreId": {
+                    "description": "Identifier of the parent genre, if the genre is a subgenre.",
+                    "type": "integer"
                 }
             }
         },
@@ -763,6 +3295,18 @@ const docTemplate = `{
                     "description": "AudioFileId is the identifier of the associated audio file.",
                     "type": "integer"
                 },
+                "bpm": {
+                    "description": "Bpm is the tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "CatalogNumber is the catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment is the comment of the file.",
+                    "type": "string"
+                },
                 "discNumber": {
                     "description": "DiscNumber is the disc number of the song in the album.",
                     "type": "integer"
@@ -771,10 +3315,38 @@ const docTemplate = `{
                     "description": "GenreId is the genre identifier of the song.",
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "Isrc is the International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Label is the record label that released the song.",
+                    "type": "string"
+                },
                 "lyrics": {
                     "description": "Lyrics are the lyrics of the song.",
                     "type": "string"
                 },
+                "movement": {
+                    "description": "Movement is the name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "MovementNumber is the number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "OriginalReleaseDate is the full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "OriginalYear is the year of the original release.",
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate is the full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
                 "sha256": {
                     "description": "Sha256 is the SHA256 hash of the song file.",
                     "type": "string"
@@ -787,10 +3359,18 @@ const docTemplate = `{
                     "description": "SongNumber is the track number of the song in the album.",
                     "type": "integer"
                 },
+                "sortTitle": {
+                    "description": "SortTitle is the title the song is sorted by, if the file carries one.",
+                    "type": "string"
+                },
                 "title": {
                     "description": "Title is the title of the song.",
                     "type": "string"
                 },
+                "workId": {
+                    "description": "WorkId is the identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
                 "year": {
                     "description": "Year is the release year of the song.",
                     "type": "integer"
@@ -801,15 +3381,225 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "songs": {
-                    "description": "Array of songs belonging to a specific album.",
+                    "description": "Array of songs belonging to a specific album.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/song_handler.getByAlbumIdResponseItem"
+                    }
+                }
+            }
+        },
+        "song_handler.getByAlbumIdResponseItem": {
+            "type": "object",
+            "properties": {
+                "albumId": {
+                    "description": "Identifier of the album to which the song belongs.",
+                    "type": "integer"
+                },
+                "artistId": {
+                    "description": "Identifier of the artist of the song.",
+                    "type": "integer"
+                },
+                "audioFileId": {
+                    "description": "Identifier for the associated audio file.",
+                    "type": "integer"
+                },
+                "bpm": {
+                    "description": "Tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "Catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment of the file.",
+                    "type": "string"
+                },
+                "discNumber": {
+                    "description": "Disc number of the song in the album.",
+                    "type": "integer"
+                },
+                "genreId": {
+                    "description": "Genre identifier of the song.",
+                    "type": "integer"
+                },
+                "isrc": {
+                    "description": "International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Record label that released the song.",
+                    "type": "string"
+                },
+                "lyrics": {
+                    "description": "Lyrics of the song.",
+                    "type": "string"
+                },
+                "movement": {
+                    "description": "Name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "Number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "Full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "Year of the original release.",
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "description": "Full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
+                "sha256": {
+                    "description": "SHA256 hash of the song file.",
+                    "type": "string"
+                },
+                "songId": {
+                    "description": "Unique identifier for the song.",
+                    "type": "integer"
+                },
+                "songNumber": {
+                    "description": "Track number of the song in the album.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title of the song.",
+                    "type": "string"
+                },
+                "workId": {
+                    "description": "Identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
+                "year": {
+                    "description": "Release year of the song.",
+                    "type": "integer"
+                }
+            }
+        },
+        "song_handler.getByArtistIdResponse": {
+            "type": "object",
+            "properties": {
+                "songs": {
+                    "description": "Array of songs belonging to a specific artist.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/song_handler.getByArtistIdResponseItem"
+                    }
+                }
+            }
+        },
+        "song_handler.getByArtistIdResponseItem": {
+            "type": "object",
+            "properties": {
+                "albumId": {
+                    "description": "Identifier of the album to which the song belongs.",
+                    "type": "integer"
+                },
+                "artistId": {
+                    "description": "Identifier of the main artist of the song.",
+                    "type": "integer"
+                },
+                "audioFileId": {
+                    "description": "Identifier for the associated audio file.",
+                    "type": "integer"
+                },
+                "bpm": {
+                    "description": "Tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "Catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment of the file.",
+                    "type": "string"
+                },
+                "discNumber": {
+                    "description": "Disc number of the song in the album.",
+                    "type": "integer"
+                },
+                "genreId": {
+                    "description": "Genre identifier of the song.",
+                    "type": "integer"
+                },
+                "isrc": {
+                    "description": "International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Record label that released the song.",
+                    "type": "string"
+                },
+                "lyrics": {
+                    "description": "Lyrics of the song.",
+                    "type": "string"
+                },
+                "movement": {
+                    "description": "Name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "Number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "Full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "Year of the original release.",
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "description": "Full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
+                "sha256": {
+                    "description": "SHA256 hash of the song file.",
+                    "type": "string"
+                },
+                "songId": {
+                    "description": "Unique identifier for the song.",
+                    "type": "integer"
+                },
+                "songNumber": {
+                    "description": "Track number of the song in the album.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title of the song.",
+                    "type": "string"
+                },
+                "workId": {
+                    "description": "Identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
+                "year": {
+                    "description": "Release year of the song.",
+                    "type": "integer"
+                }
+            }
+        },
+        "song_handler.getByComposerIdResponse": {
+            "type": "object",
+            "properties": {
+                "songs": {
+                    "description": "Array of songs composed by a specific artist.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/song_handler.getByAlbumIdResponseItem"
+                        "$ref": "#/definitions/song_handler.getByComposerIdResponseItem"
                     }
                 }
             }
         },
-        "song_handler.getByAlbumIdResponseItem": {
+        "song_handler.getByComposerIdResponseItem": {
             "type": "object",
             "properties": {
                 "albumId": {
@@ -817,13 +3607,25 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "artistId": {
-                    "description": "Identifier of the artist of the song.",
+                    "description": "Identifier of the main artist of the song.",
                     "type": "integer"
                 },
                 "audioFileId": {
                     "description": "Identifier for the associated audio file.",
                     "type": "integer"
                 },
+                "bpm": {
+                    "description": "Tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "Catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment of the file.",
+                    "type": "string"
+                },
                 "discNumber": {
                     "description": "Disc number of the song in the album.",
                     "type": "integer"
@@ -832,10 +3634,38 @@ const docTemplate = `{
                     "description": "Genre identifier of the song.",
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Record label that released the song.",
+                    "type": "string"
+                },
                 "lyrics": {
                     "description": "Lyrics of the song.",
                     "type": "string"
                 },
+                "movement": {
+                    "description": "Name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "Number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "Full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "Year of the original release.",
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "description": "Full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
                 "sha256": {
                     "description": "SHA256 hash of the song file.",
                     "type": "string"
@@ -852,25 +3682,29 @@ const docTemplate = `{
                     "description": "Title of the song.",
                     "type": "string"
                 },
+                "workId": {
+                    "description": "Identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
                 "year": {
                     "description": "Release year of the song.",
                     "type": "integer"
                 }
             }
         },
-        "song_handler.getByArtistIdResponse": {
+        "song_handler.getByGenreIdResponse": {
             "type": "object",
             "properties": {
                 "songs": {
                     "description": "Array of songs belonging to a specific artist.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/song_handler.getByArtistIdResponseItem"
+                        "$ref": "#/definitions/song_handler.getByGenreIdResponseItem"
                     }
                 }
             }
         },
-        "song_handler.getByArtistIdResponseItem": {
+        "song_handler.getByGenreIdResponseItem": {
             "type": "object",
             "properties": {
                 "albumId": {
@@ -885,18 +3719,58 @@ const docTemplate = `{
                     "description": "Identifier for the associated audio file.",
                     "type": "integer"
                 },
+                "bpm": {
+                    "description": "Tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "Catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment of the file.",
+                    "type": "string"
+                },
                 "discNumber": {
                     "description": "Disc number of the song in the album.",
                     "type": "integer"
                 },
                 "genreId": {
-                    "description": "Genre identifier of the song.",
+                    "description": "Identifier of the first genre of the song.",
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Record label that released the song.",
+                    "type": "string"
+                },
                 "lyrics": {
                     "description": "Lyrics of the song.",
                     "type": "string"
                 },
+                "movement": {
+                    "description": "Name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "Number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "Full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "Year of the original release.",
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "description": "Full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
                 "sha256": {
                     "description": "SHA256 hash of the song file.",
                     "type": "string"
@@ -913,25 +3787,29 @@ const docTemplate = `{
                     "description": "Title of the song.",
                     "type": "string"
                 },
+                "workId": {
+                    "description": "Identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
                 "year": {
                     "description": "Release year of the song.",
                     "type": "integer"
                 }
             }
         },
-        "song_handler.getByGenreIdResponse": {
+        "song_handler.getByWorkIdResponse": {
             "type": "object",
             "properties": {
                 "songs": {
-                    "description": "Array of songs belonging to a specific artist.",
+                    "description": "Array of movements of a specific work.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/song_handler.getByGenreIdResponseItem"
+                        "$ref": "#/definitions/song_handler.getByWorkIdResponseItem"
                     }
                 }
             }
         },
-        "song_handler.getByGenreIdResponseItem": {
+        "song_handler.getByWorkIdResponseItem": {
             "type": "object",
             "properties": {
                 "albumId": {
@@ -939,13 +3817,25 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "artistId": {
-                    "description": "Identifier of the artist of the song.",
+                    "description": "Identifier of the main artist of the song.",
                     "type": "integer"
                 },
                 "audioFileId": {
                     "description": "Identifier for the associated audio file.",
                     "type": "integer"
                 },
+                "bpm": {
+                    "description": "Tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "Catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment of the file.",
+                    "type": "string"
+                },
                 "discNumber": {
                     "description": "Disc number of the song in the album.",
                     "type": "integer"
@@ -954,10 +3844,38 @@ const docTemplate = `{
                     "description": "Genre identifier of the song.",
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Record label that released the song.",
+                    "type": "string"
+                },
                 "lyrics": {
                     "description": "Lyrics of the song.",
                     "type": "string"
                 },
+                "movement": {
+                    "description": "Name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "Number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "Full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "Year of the original release.",
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "description": "Full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
                 "sha256": {
                     "description": "SHA256 hash of the song file.",
                     "type": "string"
@@ -974,6 +3892,10 @@ const docTemplate = `{
                     "description": "Title of the song.",
                     "type": "string"
                 },
+                "workId": {
+                    "description": "Identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
                 "year": {
                     "description": "Release year of the song.",
                     "type": "integer"
@@ -991,10 +3913,29 @@ const docTemplate = `{
                     "description": "ArtistId is the identifier of the song's artist.",
                     "type": "integer"
                 },
+                "artists": {
+                    "description": "Artists are all artists credited on the song, in the order of the tags.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/song_handler.getResponseArtist"
+                    }
+                },
                 "audioFileId": {
                     "description": "AudioFileId is the identifier of the associated audio file.",
                     "type": "integer"
                 },
+                "bpm": {
+                    "description": "Bpm is the tempo of the song in beats per minute.",
+                    "type": "integer"
+                },
+                "catalogNumber": {
+                    "description": "CatalogNumber is the catalog number of the release given by the label.",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment is the comment of the file.",
+                    "type": "string"
+                },
                 "discNumber": {
                     "description": "DiscNumber is the disc number of the song in the album.",
                     "type": "integer"
@@ -1003,10 +3944,52 @@ const docTemplate = `{
                     "description": "GenreId is the genre identifier of the song.",
                     "type": "integer"
                 },
+                "genreIds": {
+                    "description": "GenreIds are the identifiers of all genres of the song, in the order of the tags.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "isrc": {
+                    "description": "Isrc is the International Standard Recording Code of the song.",
+                    "type": "string"
+                },
+                "label": {
+                    "description": "Label is the record label that released the song.",
+                    "type": "string"
+                },
                 "lyrics": {
                     "description": "Lyrics are the lyrics of the song.",
                     "type": "string"
                 },
+                "movement": {
+                    "description": "Movement is the name of the movement.",
+                    "type": "string"
+                },
+                "movementNumber": {
+                    "description": "MovementNumber is the number of the movement in the work.",
+                    "type": "integer"
+                },
+                "originalReleaseDate": {
+                    "description": "OriginalReleaseDate is the full date of the original release as YYYY-MM-DD.",
+                    "type": "string"
+                },
+                "originalYear": {
+                    "description": "OriginalYear is the year of the original release.",
+                    "type": "integer"
+                },
+                "overrides": {
+                    "description": "Overrides are the fields set by the user instead of the tags. The fields above already have them applied.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/song_handler.getResponseOverride"
+                    }
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate is the full release date as YYYY-MM-DD, if the tags carry the day.",
+                    "type": "string"
+                },
                 "sha256": {
                     "description": "Sha256 is the SHA256 hash of the song file.",
                     "type": "string"
@@ -1019,22 +4002,230 @@ const docTemplate = `{
                     "description": "SongNumber is the track number of the song in the album.",
                     "type": "integer"
                 },
+                "sortTitle": {
+                    "description": "SortTitle is the title the song is sorted by, if the file carries one.",
+                    "type": "string"
+                },
                 "title": {
                     "description": "Title is the title of the song.",
                     "type": "string"
                 },
+                "workId": {
+                    "description": "WorkId is the identifier of the work the song is a movement of.",
+                    "type": "integer"
+                },
                 "year": {
                     "description": "Year is the release year of the song.",
                     "type": "integer"
                 }
             }
+        },
+        "song_handler.getResponseArtist": {
+            "type": "object",
+            "properties": {
+                "artistId": {
+                    "description": "ArtistId is the identifier of the artist.",
+                    "type": "integer"
+                },
+                "role": {
+                    "description": "Role is the role of the artist on the song: main, featured, remixer, composer or conductor.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.SongArtistRole"
+                        }
+                    ]
+                }
+            }
+        },
+        "song_handler.getResponseOverride": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field is the name of the overridden field, like title or year.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.SongOverrideField"
+                        }
+                    ]
+                },
+                "tagValue": {
+                    "description": "TagValue is the value the audio file carries, as of its last scan.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value set by the user, or null if the user cleared the field.",
+                    "type": "string"
+                }
+            }
+        },
+        "song_handler.mergeAlbumsRequest": {
+            "type": "object",
+            "properties": {
+                "albumIds": {
+                    "description": "Identifiers of the albums to merge into the album of the path.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "song_handler.mergeArtistsRequest": {
+            "type": "object",
+            "properties": {
+                "artistIds": {
+                    "description": "Identifiers of the artists to merge into the artist of the path.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "song_handler.mergeGenresRequest": {
+            "type": "object",
+            "properties": {
+                "genreIds": {
+                    "description": "Identifiers of the genres to merge into the genre of the path.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "song_handler.normalizeGenresResponse": {
+            "type": "object",
+            "properties": {
+                "genres": {
+                    "description": "Array of merged legacy genres.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/song_handler.normalizeGenresResponseItem"
+                    }
+                }
+            }
+        },
+        "song_handler.normalizeGenresResponseItem": {
+            "type": "object",
+            "properties": {
+                "canonicalGenreIds": {
+                    "description": "Identifiers of the canonical genres the songs of the legacy genre got instead.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "genreId": {
+                    "description": "Identifier of the removed legacy genre.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Legacy name of the genre, like \"(17)Rock\".",
+                    "type": "string"
+                }
+            }
+        },
+        "song_handler.splitAlbumRequest": {
+            "type": "object",
+            "properties": {
+                "songIds": {
+                    "description": "Identifiers of the songs to move off the album.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "description": "Title of the new album. Must not match an existing album or album alias of the same album artist and year.",
+                    "type": "string"
+                }
+            }
+        },
+        "song_handler.splitArtistRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name of the new artist. Must not match an existing artist or artist alias.",
+                    "type": "string"
+                },
+                "songIds": {
+                    "description": "Identifiers of the songs to move off the artist.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "song_handler.splitGenreRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name of the new genre. Must not match an existing genre or genre alias.",
+                    "type": "string"
+                },
+                "songIds": {
+                    "description": "Identifiers of the songs to move off the genre.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "work_handler.getAllResponse": {
+            "type": "object",
+            "properties": {
+                "works": {
+                    "description": "Array of works.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/work_handler.getAllResponseItem"
+                    }
+                }
+            }
+        },
+        "work_handler.getAllResponseItem": {
+            "type": "object",
+            "properties": {
+                "composerId": {
+                    "description": "Identifier of the artist who composed the work.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title of the work.",
+                    "type": "string"
+                },
+                "workId": {
+                    "description": "Unique identifier for the work.",
+                    "type": "integer"
+                }
+            }
+        },
+        "work_handler.getResponse": {
+            "type": "object",
+            "properties": {
+                "composerId": {
+                    "description": "Identifier of the artist who composed the work.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title of the work.",
+                    "type": "string"
+                },
+                "workId": {
+                    "description": "Unique identifier for the work.",
+                    "type": "integer"
+                }
+            }
         }
     }
 }`
 
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
-	Version:          "0.4",
+	Version:          "0.4.2",
 	Host:             "localhost:8023",
 	BasePath:         "/api",
 	Schemes:          []string{},
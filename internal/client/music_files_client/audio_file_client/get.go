@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/rs/zerolog/log"
 	"io"
+	"music-metadata/internal/errors"
 	"net/http"
 	"time"
 )
@@ -38,6 +39,11 @@ func (c *Client) Get(audioFileId int) (audioFile GetResponse, err error) {
 		}
 	}(resp.Body)
 
+	if resp.StatusCode == http.StatusNotFound {
+		err := errors.NotFound{Resource: fmt.Sprintf("audio file with id=%d", audioFileId)}
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Audio file not found")
+		return GetResponse{}, err
+	}
 	if resp.StatusCode != http.StatusOK {
 		err := fmt.Errorf("received unexpected status code: %d", resp.StatusCode)
 		log.Error().Err(err).Str("statusCode", resp.Status).Msg("Received unexpected status code")
@@ -0,0 +1,176 @@
+package audio_file_client
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"io"
+	"net/http"
+)
+
+const rangedBlockSize = 64 * 1024
+
+// RangedFile is an io.ReaderAt over an audio file stored in music-files. Blocks of the file are
+// fetched with HTTP Range requests on first access and cached, so reading the tags of a large file
+// only transfers the blocks around its header and trailer
+type RangedFile struct {
+	client      *Client
+	audioFileId int
+	size        int64
+	blocks      map[int64][]byte
+	fetchErr    error
+}
+
+// Open returns a random access reader over the audio file and its size. If music-files ignores
+// the Range header, the whole file is downloaded and served from memory instead
+func (c *Client) Open(audioFileId int) (file io.ReaderAt, size int64, err error) {
+	log.Debug().Int("audioFileId", audioFileId).Msg("Opening audio file")
+
+	resp, err := c.requestRange(audioFileId, 0, rangedBlockSize-1)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to execute ranged request for audio file")
+		return nil, 0, err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to close body")
+		}
+	}(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		var start, end int64
+		_, err = fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size)
+		if err != nil || start != 0 {
+			log.Warn().Err(err).Int("audioFileId", audioFileId).Str("contentRange", resp.Header.Get("Content-Range")).
+				Msg("Unexpected Content-Range, falling back to full download")
+			return c.openDownloaded(audioFileId)
+		}
+		block, err := io.ReadAll(resp.Body)
+		if err != nil {
+			log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to read response body")
+			return nil, 0, err
+		}
+		rangedFile := &RangedFile{
+			client:      c,
+			audioFileId: audioFileId,
+			size:        size,
+			blocks:      map[int64][]byte{0: block},
+		}
+		log.Debug().Int("audioFileId", audioFileId).Int64("size", size).Msg("Audio file opened with ranged reads")
+		return rangedFile, size, nil
+	case http.StatusOK:
+		content, err := io.ReadAll(resp.Body)
+		if err != nil {
+			log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to read response body")
+			return nil, 0, err
+		}
+		log.Debug().Int("audioFileId", audioFileId).Msg("Ranges are not supported, audio file downloaded fully")
+		return bytes.NewReader(content), int64(len(content)), nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		return c.openDownloaded(audioFileId)
+	default:
+		err = fmt.Errorf("failed to open audio file with id=%d: received unexpected status code: %d", audioFileId, resp.StatusCode)
+		log.Error().Err(err).Str("statusCode", resp.Status).Msg("Received unexpected status code")
+		return nil, 0, err
+	}
+}
+
+func (c *Client) openDownloaded(audioFileId int) (file io.ReaderAt, size int64, err error) {
+	content, err := c.Download(audioFileId)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bytes.NewReader(content), int64(len(content)), nil
+}
+
+func (c *Client) requestRange(audioFileId int, start int64, end int64) (*http.Response, error) {
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+	return c.audioFileClient.RequestWithHeader(http.MethodGet, fmt.Sprintf("/api/audio-files/%d/download", audioFileId), header, nil)
+}
+
+func (f *RangedFile) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset: %d", off)
+	}
+
+	for n < len(p) {
+		position := off + int64(n)
+		if position >= f.size {
+			return n, io.EOF
+		}
+
+		blockStart := position - position%rangedBlockSize
+		block, err := f.block(blockStart)
+		if err != nil {
+			return n, err
+		}
+
+		if position-blockStart >= int64(len(block)) {
+			return n, io.ErrUnexpectedEOF
+		}
+		n += copy(p[n:], block[position-blockStart:])
+	}
+
+	return n, nil
+}
+
+// Size returns the size of the whole audio file in bytes
+func (f *RangedFile) Size() int64 {
+	return f.size
+}
+
+// FetchErr returns the first error of fetching a block, so callers can tell a failed download from
+// a file that cannot be parsed
+func (f *RangedFile) FetchErr() error {
+	return f.fetchErr
+}
+
+func (f *RangedFile) block(blockStart int64) (block []byte, err error) {
+	if block, ok := f.blocks[blockStart]; ok {
+		return block, nil
+	}
+
+	block, err = f.fetch(blockStart)
+	if err != nil {
+		if f.fetchErr == nil {
+			f.fetchErr = err
+		}
+		return nil, err
+	}
+
+	f.blocks[blockStart] = block
+	return block, nil
+}
+
+func (f *RangedFile) fetch(blockStart int64) (block []byte, err error) {
+	blockEnd := min(blockStart+rangedBlockSize, f.size) - 1
+	resp, err := f.client.requestRange(f.audioFileId, blockStart, blockEnd)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", f.audioFileId).Int64("blockStart", blockStart).Msg("Failed to fetch block")
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to close body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusPartialContent {
+		err = fmt.Errorf("failed to fetch block of audio file with id=%d: received unexpected status code: %d", f.audioFileId, resp.StatusCode)
+		log.Error().Err(err).Str("statusCode", resp.Status).Msg("Received unexpected status code")
+		return nil, err
+	}
+
+	block, err = io.ReadAll(resp.Body)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", f.audioFileId).Int64("blockStart", blockStart).Msg("Failed to read block")
+		return nil, err
+	}
+
+	log.Trace().Int("audioFileId", f.audioFileId).Int64("blockStart", blockStart).Int("length", len(block)).Msg("Block fetched")
+	return block, nil
+}
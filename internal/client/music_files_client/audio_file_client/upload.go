@@ -0,0 +1,56 @@
+package audio_file_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"io"
+	"music-metadata/internal/errors"
+	"net/http"
+)
+
+// Upload replaces the content of the audio file and returns the audio file with its new hash
+func (c *Client) Upload(audioFileId int, file []byte) (audioFile GetResponse, err error) {
+	log.Debug().Int("audioFileId", audioFileId).Int("size", len(file)).Msg("Uploading audio file")
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	resp, err := c.audioFileClient.RequestWithHeader(http.MethodPut, fmt.Sprintf("/api/audio-files/%d/upload", audioFileId), header, bytes.NewReader(file))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to execute request for uploading audio file")
+		return GetResponse{}, err
+	}
+	defer func(Body io.ReadCloser) {
+		err = Body.Close()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to close body")
+		}
+	}(resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		err := errors.NotFound{Resource: fmt.Sprintf("audio file with id=%d", audioFileId)}
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Audio file not found")
+		return GetResponse{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("received unexpected status code: %d", resp.StatusCode)
+		log.Error().Err(err).Str("statusCode", resp.Status).Msg("Received unexpected status code")
+		return GetResponse{}, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read response body")
+		return GetResponse{}, err
+	}
+
+	err = json.Unmarshal(body, &audioFile)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to deserialize response body")
+		return GetResponse{}, err
+	}
+
+	log.Debug().Int("audioFileId", audioFileId).Str("sha256", audioFile.Sha256).Msg("Audio file uploaded successfully")
+	return audioFile, err
+}
@@ -23,6 +23,10 @@ func NewClient(baseUrl string) (client *Client) {
 }
 
 func (c *Client) Request(method, path string, body io.Reader) (*http.Response, error) {
+	return c.RequestWithHeader(method, path, nil, body)
+}
+
+func (c *Client) RequestWithHeader(method, path string, header http.Header, body io.Reader) (*http.Response, error) {
 	reqURL := c.BaseUrl + path
 
 	req, err := http.NewRequest(method, reqURL, body)
@@ -30,6 +34,11 @@ func (c *Client) Request(method, path string, body io.Reader) (*http.Response, e
 		log.Error().Err(err).Str("method", method).Str("path", path).Msg("Failed to create request")
 		return nil, err
 	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 
 	resp, err := c.HttpClient.Do(req)
 	if err != nil {
@@ -1,15 +1,20 @@
 package config
 
 import (
+	"fmt"
 	"github.com/rs/zerolog"
 	"github.com/spf13/viper"
+	"music-metadata/internal/cron"
 	"strings"
+	"time"
 )
 
 type Configuration struct {
 	Database
 	HttpServer
 	Logger
+	Scanner
+	Audit
 }
 
 type Database struct {
@@ -29,10 +34,56 @@ type Logger struct {
 	Level zerolog.Level
 }
 
+type Audit struct {
+	// Retention is how long change sets are kept in the audit log. Zero, the default, keeps them forever
+	Retention time.Duration
+}
+
+type Scanner struct {
+	// Workers is the number of audio files downloaded and parsed in parallel
+	Workers int
+	// MaxFilesInMemory limits how many downloaded files the scanner holds at once
+	MaxFilesInMemory int
+	// Schedule is a cron expression for periodic scans. Empty disables them
+	Schedule string
+	// ScheduledMode is the mode of periodic scans: full or incremental
+	ScheduledMode string
+	// ArtistSeparators split an artist tag into several main artists, e.g. "A & B" or "A vs. B"
+	ArtistSeparators []string
+	// ArtistSeparatorExceptions are artist names that contain a separator but are never split,
+	// e.g. "Simon & Garfunkel"
+	ArtistSeparatorExceptions []string
+	// FeaturingMarkers introduce featured artists in an artist or title tag, e.g. "A feat. B"
+	FeaturingMarkers []string
+	// GenreSeparators split a genre tag into several genres, e.g. "Rock; Alternative". Only ";" by default,
+	// as "/" and "," are part of genre names like "Folk, World, & Country"
+	GenreSeparators []string
+	// SortArticles are the leading articles dropped from names and titles without a sort tag, so that
+	// "The Beatles" sorts under B. They come from the configured sort languages
+	SortArticles []string
+}
+
+// sortLanguageArticles are the leading articles of the languages names and titles can be sorted in. Articles
+// ending with an apostrophe are elided, like "L'Amour"
+var sortLanguageArticles = map[string][]string{
+	"en": {"the", "a", "an"},
+	"de": {"der", "die", "das", "ein", "eine"},
+	"fr": {"le", "la", "les", "l'", "un", "une"},
+	"es": {"el", "la", "los", "las", "un", "una", "unos", "unas"},
+	"it": {"il", "lo", "la", "i", "gli", "le", "l'", "un", "uno", "una"},
+	"pt": {"o", "a", "os", "as", "um", "uma"},
+	"nl": {"de", "het", "een"},
+}
+
 func LoadConfiguration() (config *Configuration, err error) {
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	scanner, err := loadScanner()
+	if err != nil {
+		return nil, err
+	}
+
 	config = &Configuration{
 		Database{
 			ConnectionString: viper.GetString("WAKARIMI_MUSIC_METADATA_DB_STRING"),
@@ -46,6 +97,8 @@ func LoadConfiguration() (config *Configuration, err error) {
 		Logger{
 			Level: loadLoggingLevel(),
 		},
+		scanner,
+		loadAudit(),
 	}
 
 	return config, nil
@@ -70,3 +123,85 @@ func loadLoggingLevel() zerolog.Level {
 		return zerolog.InfoLevel
 	}
 }
+
+func loadAudit() Audit {
+	retentionDays := viper.GetInt("WAKARIMI_MUSIC_METADATA_AUDIT_RETENTION_DAYS")
+	if retentionDays < 0 {
+		retentionDays = 0
+	}
+	return Audit{
+		Retention: time.Duration(retentionDays) * 24 * time.Hour,
+	}
+}
+
+func loadScanner() (scanner Scanner, err error) {
+	workers := viper.GetInt("WAKARIMI_MUSIC_METADATA_SCAN_WORKERS")
+	if workers <= 0 {
+		workers = 4
+	}
+	maxFilesInMemory := viper.GetInt("WAKARIMI_MUSIC_METADATA_SCAN_MAX_FILES_IN_MEMORY")
+	if maxFilesInMemory <= 0 {
+		maxFilesInMemory = 2 * workers
+	}
+
+	schedule := strings.TrimSpace(viper.GetString("WAKARIMI_MUSIC_METADATA_SCAN_SCHEDULE"))
+	if len(schedule) > 0 {
+		if _, err = cron.Parse(schedule); err != nil {
+			return Scanner{}, fmt.Errorf("invalid scan schedule: %w", err)
+		}
+	}
+	scheduledMode := viper.GetString("WAKARIMI_MUSIC_METADATA_SCAN_SCHEDULED_MODE")
+	switch scheduledMode {
+	case "":
+		scheduledMode = "incremental"
+	case "full", "incremental":
+	default:
+		return Scanner{}, fmt.Errorf("invalid scheduled scan mode: %s", scheduledMode)
+	}
+
+	artistSeparators := loadList("WAKARIMI_MUSIC_METADATA_ARTIST_SEPARATORS", []string{";", " & ", " / ", " vs. ", " vs "})
+	artistSeparatorExceptions := loadList("WAKARIMI_MUSIC_METADATA_ARTIST_SEPARATOR_EXCEPTIONS", []string{
+		"Simon & Garfunkel", "Earth, Wind & Fire", "Crosby, Stills & Nash", "Crosby, Stills, Nash & Young",
+		"Hall & Oates", "Sam & Dave", "Ike & Tina Turner", "Kool & the Gang", "Echo & the Bunnymen",
+		"Derek & the Dominos", "Mumford & Sons", "Iron & Wine", "Marina & the Diamonds",
+	})
+	featuringMarkers := loadList("WAKARIMI_MUSIC_METADATA_ARTIST_FEATURING_MARKERS", []string{"feat.", "feat", "ft.", "ft", "featuring"})
+	genreSeparators := loadList("WAKARIMI_MUSIC_METADATA_GENRE_SEPARATORS", []string{";"})
+
+	sortArticles := make([]string, 0)
+	for _, language := range loadList("WAKARIMI_MUSIC_METADATA_SORT_LANGUAGES", []string{"en"}) {
+		articles, ok := sortLanguageArticles[strings.ToLower(strings.TrimSpace(language))]
+		if !ok {
+			return Scanner{}, fmt.Errorf("unsupported sort language: %s", language)
+		}
+		sortArticles = append(sortArticles, articles...)
+	}
+
+	return Scanner{
+		Workers:                   workers,
+		MaxFilesInMemory:          maxFilesInMemory,
+		Schedule:                  schedule,
+		ScheduledMode:             scheduledMode,
+		ArtistSeparators:          artistSeparators,
+		ArtistSeparatorExceptions: artistSeparatorExceptions,
+		FeaturingMarkers:          featuringMarkers,
+		GenreSeparators:           genreSeparators,
+		SortArticles:              sortArticles,
+	}, nil
+}
+
+// loadList reads a list separated by "|". Spaces around the items are kept, since they are often part of a separator
+func loadList(key string, defaultList []string) []string {
+	value := viper.GetString(key)
+	if len(value) == 0 {
+		return defaultList
+	}
+
+	list := make([]string, 0)
+	for _, item := range strings.Split(value, "|") {
+		if len(strings.TrimSpace(item)) > 0 {
+			list = append(list, item)
+		}
+	}
+	return list
+}
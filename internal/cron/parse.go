@@ -0,0 +1,121 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse reads a standard five-field cron expression (minute, hour, day of month, month, day of week)
+// with lists, ranges and steps, one of the descriptors like @daily, or "@every <duration>"
+func Parse(expression string) (schedule Schedule, err error) {
+	expression = strings.TrimSpace(expression)
+
+	if interval, ok := strings.CutPrefix(expression, "@every "); ok {
+		duration, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval in %q: %w", expression, err)
+		}
+		if duration < time.Minute {
+			return nil, fmt.Errorf("interval in %q is shorter than a minute", expression)
+		}
+		return everySchedule{interval: duration}, nil
+	}
+
+	if fields, ok := descriptors[expression]; ok {
+		expression = fields
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in %q, got %d", expression, len(fields))
+	}
+
+	// Like Vixie cron, a day field starting with "*", such as "*/2", counts as unrestricted
+	s := fieldsSchedule{
+		anyDayOfMonth: strings.HasPrefix(fields[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(fields[4], "*"),
+	}
+	if err = parseField(fields[0], 0, 59, s.minutes[:]); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if err = parseField(fields[1], 0, 23, s.hours[:]); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if err = parseField(fields[2], 1, 31, s.daysOfMonth[:]); err != nil {
+		return nil, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if err = parseField(fields[3], 1, 12, s.months[:]); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	var daysOfWeek [8]bool
+	if err = parseField(fields[4], 0, 7, daysOfWeek[:]); err != nil {
+		return nil, fmt.Errorf("invalid day of week field: %w", err)
+	}
+	copy(s.daysOfWeek[:], daysOfWeek[:7])
+	s.daysOfWeek[0] = s.daysOfWeek[0] || daysOfWeek[7]
+
+	if s.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("%q never activates", expression)
+	}
+	return s, nil
+}
+
+// parseField marks the values matched by a comma separated list of "*", "a", "a-b", each with an optional "/step"
+func parseField(field string, min int, max int, matches []bool) (err error) {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		var from, to int
+		switch {
+		case rangePart == "*":
+			from, to = min, max
+		case strings.Contains(rangePart, "-"):
+			fromPart, toPart, _ := strings.Cut(rangePart, "-")
+			from, err = strconv.Atoi(fromPart)
+			if err != nil {
+				return fmt.Errorf("invalid range in %q", part)
+			}
+			to, err = strconv.Atoi(toPart)
+			if err != nil {
+				return fmt.Errorf("invalid range in %q", part)
+			}
+		default:
+			from, err = strconv.Atoi(rangePart)
+			if err != nil {
+				return fmt.Errorf("invalid value in %q", part)
+			}
+			to = from
+			if hasStep {
+				to = max
+			}
+		}
+
+		if from < min || to > max || from > to {
+			return fmt.Errorf("%q is out of range %d-%d", part, min, max)
+		}
+		for value := from; value <= to; value += step {
+			matches[value] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cron
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{name: "every minute", expression: "* * * * *"},
+		{name: "lists, ranges and steps", expression: "0,30 8-18/2 1-15 */3 1-5"},
+		{name: "sunday as 7", expression: "0 0 * * 7"},
+		{name: "descriptor", expression: "@daily"},
+		{name: "interval", expression: "@every 6h"},
+		{name: "surrounding spaces", expression: "  0 3 * * *  "},
+		{name: "too few fields", expression: "0 3 * *", wantErr: true},
+		{name: "too many fields", expression: "0 3 * * * *", wantErr: true},
+		{name: "minute out of range", expression: "60 * * * *", wantErr: true},
+		{name: "hour out of range", expression: "0 24 * * *", wantErr: true},
+		{name: "day of month zero", expression: "0 0 0 * *", wantErr: true},
+		{name: "month out of range", expression: "0 0 1 13 *", wantErr: true},
+		{name: "day of week out of range", expression: "0 0 * * 8", wantErr: true},
+		{name: "reversed range", expression: "0 10-5 * * *", wantErr: true},
+		{name: "zero step", expression: "*/0 * * * *", wantErr: true},
+		{name: "not a number", expression: "a * * * *", wantErr: true},
+		{name: "never activates", expression: "0 0 30 2 *", wantErr: true},
+		{name: "interval shorter than a minute", expression: "@every 30s", wantErr: true},
+		{name: "invalid interval", expression: "@every soon", wantErr: true},
+		{name: "unknown descriptor", expression: "@fortnightly", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.expression, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDayFields(t *testing.T) {
+	tests := []struct {
+		name              string
+		expression        string
+		wantAnyDayOfMonth bool
+		wantAnyDayOfWeek  bool
+	}{
+		{name: "both stars", expression: "0 0 * * *", wantAnyDayOfMonth: true, wantAnyDayOfWeek: true},
+		{name: "star steps", expression: "0 0 */2 * */3", wantAnyDayOfMonth: true, wantAnyDayOfWeek: true},
+		{name: "day of month restricted", expression: "0 0 1 * *", wantAnyDayOfWeek: true},
+		{name: "day of week restricted", expression: "0 0 * * 1", wantAnyDayOfMonth: true},
+		{name: "both restricted", expression: "0 0 1-7 * 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expression, err)
+			}
+			s := schedule.(fieldsSchedule)
+			if s.anyDayOfMonth != tt.wantAnyDayOfMonth || s.anyDayOfWeek != tt.wantAnyDayOfWeek {
+				t.Errorf("Parse(%q) day fields = (%v, %v), want (%v, %v)", tt.expression,
+					s.anyDayOfMonth, s.anyDayOfWeek, tt.wantAnyDayOfMonth, tt.wantAnyDayOfWeek)
+			}
+		})
+	}
+}
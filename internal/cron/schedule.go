@@ -0,0 +1,75 @@
+package cron
+
+import (
+	"time"
+)
+
+// Schedule tells when a periodic task has to run
+type Schedule interface {
+	// Next returns the first activation time strictly after t
+	Next(t time.Time) time.Time
+}
+
+// everySchedule activates with a fixed interval
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+// fieldsSchedule activates at the minutes that match all five fields of a cron expression
+type fieldsSchedule struct {
+	minutes     [60]bool
+	hours       [24]bool
+	daysOfMonth [32]bool
+	months      [13]bool
+	daysOfWeek  [7]bool
+	// anyDayOfMonth and anyDayOfWeek follow the cron rule: when both day fields are restricted,
+	// a day matching either of them is enough
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// maxLookahead bounds the search, so an expression like "0 0 30 2 *" does not loop forever
+const maxLookahead = 5 * 366 * 24 * time.Hour
+
+func (s fieldsSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxLookahead)
+
+	for t.Before(limit) {
+		year, month, day := t.Date()
+		switch {
+		case !s.months[month]:
+			t = time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+		case !s.hours[t.Hour()]:
+			t = time.Date(year, month, day, t.Hour()+1, 0, 0, 0, loc)
+		case !s.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s fieldsSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[t.Weekday()]
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
@@ -0,0 +1,44 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	// 2024-01-10 is a Wednesday
+	from := time.Date(2024, time.January, 10, 12, 30, 45, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expression string
+		from       time.Time
+		want       time.Time
+	}{
+		{name: "every minute", expression: "* * * * *", from: from, want: time.Date(2024, 1, 10, 12, 31, 0, 0, time.UTC)},
+		{name: "strictly after", expression: "31 12 * * *", from: time.Date(2024, 1, 10, 12, 31, 0, 0, time.UTC), want: time.Date(2024, 1, 11, 12, 31, 0, 0, time.UTC)},
+		{name: "later today", expression: "0 15 * * *", from: from, want: time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC)},
+		{name: "tomorrow", expression: "0 3 * * *", from: from, want: time.Date(2024, 1, 11, 3, 0, 0, 0, time.UTC)},
+		{name: "hourly", expression: "@hourly", from: from, want: time.Date(2024, 1, 10, 13, 0, 0, 0, time.UTC)},
+		{name: "minute step", expression: "*/20 * * * *", from: from, want: time.Date(2024, 1, 10, 12, 40, 0, 0, time.UTC)},
+		{name: "next month", expression: "0 0 1 * *", from: from, want: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "next year", expression: "@yearly", from: from, want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day of week", expression: "0 0 * * 1", from: from, want: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expression: "0 0 * * 7", from: from, want: time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expression: "0 0 29 2 *", from: from, want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "both day fields restricted match either", expression: "0 0 20 * 5", from: from, want: time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)},
+		{name: "star step day of month keeps day of week", expression: "0 0 */2 * 5", from: from, want: time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC)},
+		{name: "star step day of week keeps day of month", expression: "0 0 20 * */2", from: from, want: time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)},
+		{name: "interval", expression: "@every 90m", from: from, want: time.Date(2024, 1, 10, 14, 0, 45, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expression, err)
+			}
+			if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) of %q = %v, want %v", tt.from, tt.expression, got, tt.want)
+			}
+		})
+	}
+}
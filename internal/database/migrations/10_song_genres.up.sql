@@ -0,0 +1,16 @@
+CREATE TABLE "song_genres"
+(
+    "song_id"  INTEGER NOT NULL,
+    "genre_id" INTEGER NOT NULL,
+    "position" INTEGER NOT NULL,
+    PRIMARY KEY ("song_id", "genre_id"),
+    FOREIGN KEY ("song_id") REFERENCES "songs" ("song_id") ON DELETE CASCADE,
+    FOREIGN KEY ("genre_id") REFERENCES "genres" ("genre_id")
+);
+
+CREATE INDEX "song_genres_genre_id_idx" ON "song_genres" ("genre_id");
+
+INSERT INTO "song_genres"("song_id", "genre_id", "position")
+SELECT "song_id", "genre_id", 0
+FROM "songs"
+WHERE "genre_id" IS NOT NULL;
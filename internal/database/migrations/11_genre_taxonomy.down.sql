@@ -0,0 +1,4 @@
+DROP TABLE "genre_aliases";
+
+ALTER TABLE "genres"
+    DROP COLUMN "parent_genre_id";
@@ -0,0 +1,13 @@
+ALTER TABLE "genres"
+    ADD COLUMN "parent_genre_id" INTEGER;
+ALTER TABLE "genres"
+    ADD FOREIGN KEY ("parent_genre_id") REFERENCES "genres" ("genre_id");
+
+CREATE TABLE "genre_aliases"
+(
+    "alias"    TEXT PRIMARY KEY,
+    "genre_id" INTEGER NOT NULL,
+    FOREIGN KEY ("genre_id") REFERENCES "genres" ("genre_id") ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX "genre_aliases_lower_alias_idx" ON "genre_aliases" (LOWER("alias"));
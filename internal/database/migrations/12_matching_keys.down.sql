@@ -0,0 +1,31 @@
+-- Merged rows are not split again. Names that differ only in case or form stay merged, so the
+-- restored unique constraints hold
+DROP INDEX "genre_aliases_alias_key_idx";
+ALTER TABLE "genre_aliases"
+    DROP COLUMN "alias_key";
+CREATE UNIQUE INDEX "genre_aliases_lower_alias_idx" ON "genre_aliases" (LOWER("alias"));
+
+DROP INDEX "albums_identity_idx";
+ALTER TABLE "albums"
+    DROP COLUMN "title_key";
+CREATE UNIQUE INDEX "albums_identity_idx" ON "albums" ("title", COALESCE("album_artist_id", 0), COALESCE("year", 0))
+    WHERE "musicbrainz_release_id" IS NULL;
+
+DROP INDEX "genres_name_key_idx";
+ALTER TABLE "genres"
+    DROP COLUMN "name_key";
+ALTER TABLE "genres"
+    ADD CONSTRAINT "genres_name_key" UNIQUE ("name");
+
+DROP INDEX "artists_name_key_idx";
+ALTER TABLE "artists"
+    DROP COLUMN "name_key";
+ALTER TABLE "artists"
+    ADD CONSTRAINT "artists_name_key" UNIQUE ("name");
+
+ALTER TABLE "songs"
+    DROP COLUMN "album_title";
+ALTER TABLE "song_genres"
+    DROP COLUMN "listed_name";
+ALTER TABLE "song_artists"
+    DROP COLUMN "credited_name";
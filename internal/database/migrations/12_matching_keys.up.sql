@@ -0,0 +1,234 @@
+-- Names are matched by a key: NFKC, case folded and with whitespace collapsed. The application computes
+-- keys in Go, this function approximates them for the existing rows: LOWER folds case like full case
+-- folding except for a few characters, of which ß and final sigma are the ones found in names
+CREATE FUNCTION "matching_key"("value" TEXT) RETURNS TEXT AS
+$$
+SELECT BTRIM(REGEXP_REPLACE(
+        NORMALIZE(REPLACE(REPLACE(LOWER(NORMALIZE("value", NFKC)), 'ß', 'ss'), 'ς', 'σ'), NFKC),
+        '\s+', ' ', 'g'))
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Every song remembers how it spells its artists, genres and album, so that rows merged by key can
+-- be shown under their most common spelling
+ALTER TABLE "song_artists"
+    ADD COLUMN "credited_name" TEXT;
+UPDATE "song_artists"
+SET "credited_name" = "artists"."name"
+FROM "artists"
+WHERE "artists"."artist_id" = "song_artists"."artist_id";
+ALTER TABLE "song_artists"
+    ALTER COLUMN "credited_name" SET NOT NULL;
+
+ALTER TABLE "song_genres"
+    ADD COLUMN "listed_name" TEXT;
+UPDATE "song_genres"
+SET "listed_name" = "genres"."name"
+FROM "genres"
+WHERE "genres"."genre_id" = "song_genres"."genre_id";
+ALTER TABLE "song_genres"
+    ALTER COLUMN "listed_name" SET NOT NULL;
+
+ALTER TABLE "songs"
+    ADD COLUMN "album_title" TEXT;
+UPDATE "songs"
+SET "album_title" = "albums"."title"
+FROM "albums"
+WHERE "albums"."album_id" = "songs"."album_id";
+
+ALTER TABLE "artists"
+    DROP CONSTRAINT "artists_name_key";
+ALTER TABLE "artists"
+    ADD COLUMN "name_key" TEXT;
+UPDATE "artists"
+SET "name_key" = "matching_key"("name");
+
+ALTER TABLE "genres"
+    DROP CONSTRAINT "genres_name_key";
+ALTER TABLE "genres"
+    ADD COLUMN "name_key" TEXT;
+UPDATE "genres"
+SET "name_key" = "matching_key"("name");
+
+DROP INDEX "albums_identity_idx";
+ALTER TABLE "albums"
+    ADD COLUMN "title_key" TEXT;
+UPDATE "albums"
+SET "title_key" = "matching_key"("title");
+
+DROP INDEX "genre_aliases_lower_alias_idx";
+ALTER TABLE "genre_aliases"
+    ADD COLUMN "alias_key" TEXT;
+UPDATE "genre_aliases"
+SET "alias_key" = "matching_key"("alias");
+
+-- Artists sharing a key are merged into the oldest of them. A song crediting several of them in one
+-- role keeps the first credit
+CREATE TEMPORARY TABLE "artist_merges" AS
+SELECT "artist_id", MIN("artist_id") OVER (PARTITION BY "name_key") AS "target_id"
+FROM "artists";
+
+DELETE
+FROM "song_artists"
+USING "artist_merges", "song_artists" AS "other", "artist_merges" AS "other_merges"
+WHERE "artist_merges"."artist_id" = "song_artists"."artist_id"
+  AND "other"."song_id" = "song_artists"."song_id"
+  AND "other"."role" = "song_artists"."role"
+  AND "other_merges"."artist_id" = "other"."artist_id"
+  AND "other_merges"."target_id" = "artist_merges"."target_id"
+  AND ("other"."position", "other"."artist_id") < ("song_artists"."position", "song_artists"."artist_id");
+
+UPDATE "song_artists"
+SET "artist_id" = "artist_merges"."target_id"
+FROM "artist_merges"
+WHERE "artist_merges"."artist_id" = "song_artists"."artist_id"
+  AND "artist_merges"."artist_id" <> "artist_merges"."target_id";
+
+UPDATE "songs"
+SET "artist_id" = "artist_merges"."target_id"
+FROM "artist_merges"
+WHERE "artist_merges"."artist_id" = "songs"."artist_id"
+  AND "artist_merges"."artist_id" <> "artist_merges"."target_id";
+
+UPDATE "albums"
+SET "album_artist_id" = "artist_merges"."target_id"
+FROM "artist_merges"
+WHERE "artist_merges"."artist_id" = "albums"."album_artist_id"
+  AND "artist_merges"."artist_id" <> "artist_merges"."target_id";
+
+DELETE
+FROM "artists"
+USING "artist_merges"
+WHERE "artist_merges"."artist_id" = "artists"."artist_id"
+  AND "artist_merges"."artist_id" <> "artist_merges"."target_id";
+
+DROP TABLE "artist_merges";
+
+-- Albums are merged after artists, as merged album artists can make their albums identical
+CREATE TEMPORARY TABLE "album_merges" AS
+SELECT "album_id",
+       MIN("album_id") OVER (PARTITION BY "title_key", "album_artist_id", "year") AS "target_id"
+FROM "albums"
+WHERE "musicbrainz_release_id" IS NULL;
+
+UPDATE "songs"
+SET "album_id" = "album_merges"."target_id"
+FROM "album_merges"
+WHERE "album_merges"."album_id" = "songs"."album_id"
+  AND "album_merges"."album_id" <> "album_merges"."target_id";
+
+UPDATE "albums"
+SET "compilation" = TRUE
+FROM "album_merges"
+         JOIN "albums" AS "source" ON "source"."album_id" = "album_merges"."album_id"
+WHERE "album_merges"."target_id" = "albums"."album_id"
+  AND "source"."compilation";
+
+DELETE
+FROM "albums"
+USING "album_merges"
+WHERE "album_merges"."album_id" = "albums"."album_id"
+  AND "album_merges"."album_id" <> "album_merges"."target_id";
+
+DROP TABLE "album_merges";
+
+-- Genres sharing a key are merged into the oldest of them, together with their aliases and subgenres
+CREATE TEMPORARY TABLE "genre_merges" AS
+SELECT "genre_id", MIN("genre_id") OVER (PARTITION BY "name_key") AS "target_id"
+FROM "genres";
+
+DELETE
+FROM "song_genres"
+USING "genre_merges", "song_genres" AS "other", "genre_merges" AS "other_merges"
+WHERE "genre_merges"."genre_id" = "song_genres"."genre_id"
+  AND "other"."song_id" = "song_genres"."song_id"
+  AND "other_merges"."genre_id" = "other"."genre_id"
+  AND "other_merges"."target_id" = "genre_merges"."target_id"
+  AND ("other"."position", "other"."genre_id") < ("song_genres"."position", "song_genres"."genre_id");
+
+UPDATE "song_genres"
+SET "genre_id" = "genre_merges"."target_id"
+FROM "genre_merges"
+WHERE "genre_merges"."genre_id" = "song_genres"."genre_id"
+  AND "genre_merges"."genre_id" <> "genre_merges"."target_id";
+
+UPDATE "songs"
+SET "genre_id" = "genre_merges"."target_id"
+FROM "genre_merges"
+WHERE "genre_merges"."genre_id" = "songs"."genre_id"
+  AND "genre_merges"."genre_id" <> "genre_merges"."target_id";
+
+UPDATE "genre_aliases"
+SET "genre_id" = "genre_merges"."target_id"
+FROM "genre_merges"
+WHERE "genre_merges"."genre_id" = "genre_aliases"."genre_id"
+  AND "genre_merges"."genre_id" <> "genre_merges"."target_id";
+
+UPDATE "genres"
+SET "parent_genre_id" = "genre_merges"."target_id"
+FROM "genre_merges"
+WHERE "genre_merges"."genre_id" = "genres"."parent_genre_id"
+  AND "genre_merges"."genre_id" <> "genre_merges"."target_id";
+
+UPDATE "genres"
+SET "parent_genre_id" = NULL
+WHERE "parent_genre_id" = "genre_id";
+
+DELETE
+FROM "genres"
+USING "genre_merges"
+WHERE "genre_merges"."genre_id" = "genres"."genre_id"
+  AND "genre_merges"."genre_id" <> "genre_merges"."target_id";
+
+DROP TABLE "genre_merges";
+
+DELETE
+FROM "genre_aliases"
+USING "genre_aliases" AS "other"
+WHERE "other"."alias_key" = "genre_aliases"."alias_key"
+  AND "other"."alias" < "genre_aliases"."alias";
+
+-- Merged rows are shown under the spelling their songs use most often
+UPDATE "artists"
+SET "name" = "spellings"."credited_name"
+FROM (SELECT DISTINCT ON ("artist_id") "artist_id", "credited_name"
+      FROM "song_artists"
+      GROUP BY "artist_id", "credited_name"
+      ORDER BY "artist_id", COUNT(*) DESC, "credited_name") AS "spellings"
+WHERE "spellings"."artist_id" = "artists"."artist_id";
+
+UPDATE "genres"
+SET "name" = "spellings"."listed_name"
+FROM (SELECT DISTINCT ON ("genre_id") "genre_id", "listed_name"
+      FROM "song_genres"
+      GROUP BY "genre_id", "listed_name"
+      ORDER BY "genre_id", COUNT(*) DESC, "listed_name") AS "spellings"
+WHERE "spellings"."genre_id" = "genres"."genre_id";
+
+UPDATE "albums"
+SET "title" = "spellings"."album_title"
+FROM (SELECT DISTINCT ON ("album_id") "album_id", "album_title"
+      FROM "songs"
+      WHERE "album_id" IS NOT NULL
+        AND "album_title" IS NOT NULL
+      GROUP BY "album_id", "album_title"
+      ORDER BY "album_id", COUNT(*) DESC, "album_title") AS "spellings"
+WHERE "spellings"."album_id" = "albums"."album_id";
+
+DROP FUNCTION "matching_key";
+
+ALTER TABLE "artists"
+    ALTER COLUMN "name_key" SET NOT NULL;
+CREATE UNIQUE INDEX "artists_name_key_idx" ON "artists" ("name_key");
+
+ALTER TABLE "genres"
+    ALTER COLUMN "name_key" SET NOT NULL;
+CREATE UNIQUE INDEX "genres_name_key_idx" ON "genres" ("name_key");
+
+ALTER TABLE "albums"
+    ALTER COLUMN "title_key" SET NOT NULL;
+CREATE UNIQUE INDEX "albums_identity_idx" ON "albums" ("title_key", COALESCE("album_artist_id", 0), COALESCE("year", 0))
+    WHERE "musicbrainz_release_id" IS NULL;
+
+ALTER TABLE "genre_aliases"
+    ALTER COLUMN "alias_key" SET NOT NULL;
+CREATE UNIQUE INDEX "genre_aliases_alias_key_idx" ON "genre_aliases" ("alias_key");
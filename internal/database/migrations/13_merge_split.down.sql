@@ -0,0 +1,3 @@
+DROP TABLE "song_splits";
+DROP TABLE "album_aliases";
+DROP TABLE "artist_aliases";
@@ -0,0 +1,41 @@
+CREATE TABLE "artist_aliases"
+(
+    "alias"     TEXT PRIMARY KEY,
+    "alias_key" TEXT    NOT NULL,
+    "artist_id" INTEGER NOT NULL,
+    FOREIGN KEY ("artist_id") REFERENCES "artists" ("artist_id") ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX "artist_aliases_alias_key_idx" ON "artist_aliases" ("alias_key");
+
+-- An album alias keeps the identity of a merged album, so files scanned with it resolve to the album
+CREATE TABLE "album_aliases"
+(
+    "album_alias_id"         SERIAL PRIMARY KEY,
+    "title"                  TEXT    NOT NULL,
+    "title_key"              TEXT    NOT NULL,
+    "album_artist_id"        INTEGER,
+    "year"                   INTEGER,
+    "musicbrainz_release_id" TEXT,
+    "album_id"               INTEGER NOT NULL,
+    FOREIGN KEY ("album_artist_id") REFERENCES "artists" ("artist_id"),
+    FOREIGN KEY ("album_id") REFERENCES "albums" ("album_id") ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX "album_aliases_identity_idx" ON "album_aliases" ("title_key", COALESCE("album_artist_id", 0), COALESCE("year", 0))
+    WHERE "musicbrainz_release_id" IS NULL;
+CREATE UNIQUE INDEX "album_aliases_musicbrainz_release_id_idx" ON "album_aliases" ("musicbrainz_release_id");
+
+-- A song split moves one song from the artist, album or genre its tags resolve to onto another one
+CREATE TABLE "song_splits"
+(
+    "song_id" INTEGER NOT NULL,
+    "kind"    TEXT    NOT NULL,
+    "from_id" INTEGER NOT NULL,
+    "to_id"   INTEGER NOT NULL,
+    PRIMARY KEY ("song_id", "kind", "from_id"),
+    FOREIGN KEY ("song_id") REFERENCES "songs" ("song_id") ON DELETE CASCADE
+);
+
+CREATE INDEX "song_splits_kind_from_id_idx" ON "song_splits" ("kind", "from_id");
+CREATE INDEX "song_splits_kind_to_id_idx" ON "song_splits" ("kind", "to_id");
@@ -0,0 +1,10 @@
+-- A song override replaces one tag of the song with a value set by the user, kept through later scans
+CREATE TABLE "song_overrides"
+(
+    "song_id"   INTEGER NOT NULL,
+    "field"     TEXT    NOT NULL,
+    "value"     TEXT,
+    "tag_value" TEXT,
+    PRIMARY KEY ("song_id", "field"),
+    FOREIGN KEY ("song_id") REFERENCES "songs" ("song_id") ON DELETE CASCADE
+);
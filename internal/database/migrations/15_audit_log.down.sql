@@ -0,0 +1,15 @@
+DROP TRIGGER "audit_genre_aliases" ON "genre_aliases";
+DROP TRIGGER "audit_genres" ON "genres";
+DROP TRIGGER "audit_artist_aliases" ON "artist_aliases";
+DROP TRIGGER "audit_artists" ON "artists";
+DROP TRIGGER "audit_album_aliases" ON "album_aliases";
+DROP TRIGGER "audit_albums" ON "albums";
+DROP TRIGGER "audit_song_splits" ON "song_splits";
+DROP TRIGGER "audit_song_overrides" ON "song_overrides";
+DROP TRIGGER "audit_song_genres" ON "song_genres";
+DROP TRIGGER "audit_song_artists" ON "song_artists";
+DROP TRIGGER "audit_songs" ON "songs";
+DROP TABLE "audit_entries";
+DROP TABLE "audit_change_sets";
+DROP FUNCTION "audit_forbid_change";
+DROP FUNCTION "audit_row_change";
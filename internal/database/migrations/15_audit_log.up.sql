@@ -0,0 +1,159 @@
+-- A change set groups the changes of one operation, like a scan or a merge. Changes without a source
+-- set by the application are recorded as unknown
+CREATE TABLE "audit_change_sets"
+(
+    "change_set_id"          SERIAL PRIMARY KEY,
+    "source"                 TEXT      NOT NULL,
+    "reverted_change_set_id" INTEGER,
+    "created_at"             TIMESTAMP NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    FOREIGN KEY ("reverted_change_set_id") REFERENCES "audit_change_sets" ("change_set_id")
+);
+
+CREATE UNIQUE INDEX "audit_change_sets_reverted_change_set_id_idx" ON "audit_change_sets" ("reverted_change_set_id");
+CREATE INDEX "audit_change_sets_created_at_idx" ON "audit_change_sets" ("created_at");
+
+-- An audit entry keeps a changed row before and after the change. row_key holds the primary key of the row,
+-- and previous_row_key the key it had before an update changing a key column, like the from_id of a song split
+CREATE TABLE "audit_entries"
+(
+    "audit_entry_id"   SERIAL PRIMARY KEY,
+    "change_set_id"    INTEGER NOT NULL,
+    "table_name"       TEXT    NOT NULL,
+    "row_key"          JSONB   NOT NULL,
+    "previous_row_key" JSONB,
+    "operation"        TEXT    NOT NULL,
+    "before"           JSONB,
+    "after"            JSONB,
+    FOREIGN KEY ("change_set_id") REFERENCES "audit_change_sets" ("change_set_id")
+);
+
+CREATE INDEX "audit_entries_change_set_id_idx" ON "audit_entries" ("change_set_id");
+CREATE INDEX "audit_entries_row_key_idx" ON "audit_entries" ("table_name", "row_key");
+CREATE INDEX "audit_entries_previous_row_key_idx" ON "audit_entries" ("table_name", "previous_row_key");
+CREATE INDEX "audit_entries_song_id_idx" ON "audit_entries" ((("row_key" ->> 'song_id')::INTEGER));
+
+-- Records a row change in the change set of the transaction, starting one on the first change. The
+-- trigger arguments are the primary key columns of the table
+CREATE FUNCTION "audit_row_change"() RETURNS TRIGGER AS
+$$
+DECLARE
+    "current_change_set_id" INTEGER;
+    "old_row"               JSONB;
+    "new_row"               JSONB;
+    "key_row"               JSONB := '{}';
+    "previous_key_row"      JSONB := '{}';
+    "key_column"            TEXT;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        "old_row" := TO_JSONB(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        "new_row" := TO_JSONB(NEW);
+    END IF;
+    IF "old_row" = "new_row" THEN
+        RETURN NULL;
+    END IF;
+
+    FOREACH "key_column" IN ARRAY TG_ARGV
+        LOOP
+            "key_row" := "key_row" || JSONB_BUILD_OBJECT("key_column", COALESCE("new_row", "old_row") -> "key_column");
+            "previous_key_row" := "previous_key_row" || JSONB_BUILD_OBJECT("key_column", "old_row" -> "key_column");
+        END LOOP;
+    IF TG_OP <> 'UPDATE' OR "previous_key_row" = "key_row" THEN
+        "previous_key_row" := NULL;
+    END IF;
+
+    "current_change_set_id" := NULLIF(CURRENT_SETTING('music_metadata.change_set_id', TRUE), '')::INTEGER;
+    IF "current_change_set_id" IS NULL THEN
+        INSERT INTO "audit_change_sets"("source")
+        VALUES (COALESCE(NULLIF(CURRENT_SETTING('music_metadata.change_source', TRUE), ''), 'unknown'))
+        RETURNING "change_set_id" INTO "current_change_set_id";
+        PERFORM SET_CONFIG('music_metadata.change_set_id', "current_change_set_id"::TEXT, TRUE);
+    END IF;
+
+    INSERT INTO "audit_entries"("change_set_id", "table_name", "row_key", "previous_row_key", "operation", "before", "after")
+    VALUES ("current_change_set_id", TG_TABLE_NAME, "key_row", "previous_key_row", LOWER(TG_OP), "old_row", "new_row");
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Keeps the audit log append-only. Expired change sets are deleted by the cleanup job, which marks its
+-- transaction with music_metadata.audit_cleanup
+CREATE FUNCTION "audit_forbid_change"() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' AND CURRENT_SETTING('music_metadata.audit_cleanup', TRUE) = 'on' THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'the audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_change_sets_append_only"
+    BEFORE UPDATE OR DELETE
+    ON "audit_change_sets"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_forbid_change"();
+CREATE TRIGGER "audit_entries_append_only"
+    BEFORE UPDATE OR DELETE
+    ON "audit_entries"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_forbid_change"();
+
+-- Audit triggers are named in lower case, so they fire after the foreign key triggers of the same row.
+-- Rows deleted by ON DELETE CASCADE are then recorded before the row they depend on
+CREATE TRIGGER "audit_songs"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "songs"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('song_id');
+CREATE TRIGGER "audit_song_artists"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "song_artists"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('song_id', 'artist_id', 'role');
+CREATE TRIGGER "audit_song_genres"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "song_genres"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('song_id', 'genre_id');
+CREATE TRIGGER "audit_song_overrides"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "song_overrides"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('song_id', 'field');
+CREATE TRIGGER "audit_song_splits"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "song_splits"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('song_id', 'kind', 'from_id');
+CREATE TRIGGER "audit_albums"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "albums"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('album_id');
+CREATE TRIGGER "audit_album_aliases"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "album_aliases"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('album_alias_id');
+CREATE TRIGGER "audit_artists"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "artists"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('artist_id');
+CREATE TRIGGER "audit_artist_aliases"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "artist_aliases"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('alias');
+CREATE TRIGGER "audit_genres"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "genres"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('genre_id');
+CREATE TRIGGER "audit_genre_aliases"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "genre_aliases"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('alias');
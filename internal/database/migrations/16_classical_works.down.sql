@@ -0,0 +1,12 @@
+DROP INDEX "songs_work_id_idx";
+
+ALTER TABLE "songs"
+    DROP COLUMN "movement_number",
+    DROP COLUMN "movement",
+    DROP COLUMN "work_id";
+
+DELETE
+FROM "song_artists"
+WHERE "role" IN ('composer', 'conductor');
+
+DROP TABLE "works";
@@ -0,0 +1,28 @@
+-- A work, like a symphony, is identified by its title and composer, so its movements group together
+-- across albums
+CREATE TABLE "works"
+(
+    "work_id"     SERIAL PRIMARY KEY,
+    "title"       TEXT NOT NULL,
+    "title_key"   TEXT NOT NULL,
+    "composer_id" INTEGER,
+    FOREIGN KEY ("composer_id") REFERENCES "artists" ("artist_id")
+);
+
+CREATE UNIQUE INDEX "works_identity_idx" ON "works" ("title_key", COALESCE("composer_id", 0));
+CREATE INDEX "works_composer_id_idx" ON "works" ("composer_id");
+
+ALTER TABLE "songs"
+    ADD COLUMN "work_id"         INTEGER,
+    ADD COLUMN "movement"        TEXT,
+    ADD COLUMN "movement_number" INTEGER;
+ALTER TABLE "songs"
+    ADD FOREIGN KEY ("work_id") REFERENCES "works" ("work_id");
+
+CREATE INDEX "songs_work_id_idx" ON "songs" ("work_id");
+
+CREATE TRIGGER "audit_works"
+    AFTER INSERT OR UPDATE OR DELETE
+    ON "works"
+    FOR EACH ROW
+EXECUTE FUNCTION "audit_row_change"('work_id');
@@ -0,0 +1,18 @@
+DROP TABLE "settings";
+
+DROP INDEX "songs_sort_key_idx";
+DROP INDEX "albums_sort_key_idx";
+DROP INDEX "artists_sort_key_idx";
+
+ALTER TABLE "songs"
+    DROP COLUMN "album_sort_title",
+    DROP COLUMN "album_artist_sort_name",
+    DROP COLUMN "artist_sort_name",
+    DROP COLUMN "sort_key",
+    DROP COLUMN "sort_title";
+ALTER TABLE "albums"
+    DROP COLUMN "sort_key",
+    DROP COLUMN "sort_title";
+ALTER TABLE "artists"
+    DROP COLUMN "sort_key",
+    DROP COLUMN "sort_name";
@@ -0,0 +1,39 @@
+-- sort_name and sort_title hold the sort tags of the files, sort_key the key listings are ordered by. Keys
+-- start as the matching keys and get their leading articles dropped when the service starts
+ALTER TABLE "artists"
+    ADD COLUMN "sort_name" TEXT,
+    ADD COLUMN "sort_key"  TEXT;
+UPDATE "artists"
+SET "sort_key" = "name_key";
+ALTER TABLE "artists"
+    ALTER COLUMN "sort_key" SET NOT NULL;
+
+ALTER TABLE "albums"
+    ADD COLUMN "sort_title" TEXT,
+    ADD COLUMN "sort_key"   TEXT;
+UPDATE "albums"
+SET "sort_key" = "title_key";
+ALTER TABLE "albums"
+    ALTER COLUMN "sort_key" SET NOT NULL;
+
+-- Songs also keep the sort tags of their artists and albums, so the sort names of artists and albums are
+-- the ones most of their files carry and are dropped once no file carries them
+ALTER TABLE "songs"
+    ADD COLUMN "sort_title"             TEXT,
+    ADD COLUMN "sort_key"               TEXT,
+    ADD COLUMN "artist_sort_name"       TEXT,
+    ADD COLUMN "album_artist_sort_name" TEXT,
+    ADD COLUMN "album_sort_title"       TEXT;
+UPDATE "songs"
+SET "sort_key" = LOWER("title");
+
+CREATE INDEX "artists_sort_key_idx" ON "artists" ("sort_key");
+CREATE INDEX "albums_sort_key_idx" ON "albums" ("sort_key");
+CREATE INDEX "songs_sort_key_idx" ON "songs" ("sort_key");
+
+-- Settings the stored data was built with, so that the service can tell when the configuration changed
+CREATE TABLE "settings"
+(
+    "name"  TEXT PRIMARY KEY,
+    "value" TEXT NOT NULL
+);
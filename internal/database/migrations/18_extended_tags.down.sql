@@ -0,0 +1,15 @@
+DROP INDEX "songs_isrc_idx";
+
+ALTER TABLE "albums"
+    DROP COLUMN "label",
+    DROP COLUMN "release_date";
+
+ALTER TABLE "songs"
+    DROP COLUMN "original_year",
+    DROP COLUMN "original_release_date",
+    DROP COLUMN "release_date",
+    DROP COLUMN "comment",
+    DROP COLUMN "catalog_number",
+    DROP COLUMN "label",
+    DROP COLUMN "isrc",
+    DROP COLUMN "bpm";
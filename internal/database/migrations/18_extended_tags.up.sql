@@ -0,0 +1,18 @@
+-- Dates are stored only when the tags give the full date. The original year is kept apart, as the tags
+-- often carry nothing more of the original release
+ALTER TABLE "songs"
+    ADD COLUMN "bpm"                   INTEGER,
+    ADD COLUMN "isrc"                  TEXT,
+    ADD COLUMN "label"                 TEXT,
+    ADD COLUMN "catalog_number"        TEXT,
+    ADD COLUMN "comment"               TEXT,
+    ADD COLUMN "release_date"          DATE,
+    ADD COLUMN "original_release_date" DATE,
+    ADD COLUMN "original_year"         INTEGER;
+
+-- The release date and label of an album are the ones most of its songs carry
+ALTER TABLE "albums"
+    ADD COLUMN "release_date" DATE,
+    ADD COLUMN "label"        TEXT;
+
+CREATE INDEX "songs_isrc_idx" ON "songs" ("isrc");
@@ -0,0 +1,15 @@
+CREATE TABLE "scan_jobs"
+(
+    "scan_job_id"     SERIAL PRIMARY KEY,
+    "status"          TEXT      NOT NULL,
+    "phase"           TEXT      NOT NULL,
+    "total_files"     INTEGER   NOT NULL DEFAULT 0,
+    "processed_files" INTEGER   NOT NULL DEFAULT 0,
+    "created_count"   INTEGER   NOT NULL DEFAULT 0,
+    "removed_count"   INTEGER   NOT NULL DEFAULT 0,
+    "moved_count"     INTEGER   NOT NULL DEFAULT 0,
+    "changed_count"   INTEGER   NOT NULL DEFAULT 0,
+    "error"           TEXT,
+    "started_at"      TIMESTAMP NOT NULL,
+    "finished_at"     TIMESTAMP
+);
@@ -0,0 +1,6 @@
+ALTER TABLE "scan_jobs"
+    DROP COLUMN "content_high_water_mark",
+    DROP COLUMN "mode";
+
+ALTER TABLE "songs"
+    DROP COLUMN "last_content_update";
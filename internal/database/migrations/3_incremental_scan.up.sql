@@ -0,0 +1,6 @@
+ALTER TABLE "songs"
+    ADD COLUMN "last_content_update" TIMESTAMPTZ;
+
+ALTER TABLE "scan_jobs"
+    ADD COLUMN "mode"                    TEXT NOT NULL DEFAULT 'full',
+    ADD COLUMN "content_high_water_mark" TIMESTAMPTZ;
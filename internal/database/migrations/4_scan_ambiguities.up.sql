@@ -0,0 +1,13 @@
+CREATE TABLE "scan_ambiguities"
+(
+    "scan_ambiguity_id" SERIAL PRIMARY KEY,
+    "scan_job_id"       INTEGER NOT NULL,
+    "kind"              TEXT    NOT NULL,
+    "audio_file_id"     INTEGER,
+    "song_id"           INTEGER,
+    "sha_256"           TEXT,
+    "description"       TEXT    NOT NULL,
+    FOREIGN KEY ("scan_job_id") REFERENCES "scan_jobs" ("scan_job_id") ON DELETE CASCADE
+);
+
+CREATE INDEX "scan_ambiguities_scan_job_id_idx" ON "scan_ambiguities" ("scan_job_id");
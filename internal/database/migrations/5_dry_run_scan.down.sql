@@ -0,0 +1,4 @@
+DROP TABLE "scan_diffs";
+
+ALTER TABLE "scan_jobs"
+    DROP COLUMN "dry_run";
@@ -0,0 +1,9 @@
+ALTER TABLE "scan_jobs"
+    ADD COLUMN "dry_run" BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE "scan_diffs"
+(
+    "scan_job_id" INTEGER PRIMARY KEY,
+    "diff"        JSONB NOT NULL,
+    FOREIGN KEY ("scan_job_id") REFERENCES "scan_jobs" ("scan_job_id") ON DELETE CASCADE
+);
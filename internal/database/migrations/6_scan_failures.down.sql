@@ -0,0 +1,4 @@
+DROP TABLE "scan_failures";
+
+ALTER TABLE "scan_jobs"
+    DROP COLUMN "failed_count";
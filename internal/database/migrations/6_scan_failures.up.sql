@@ -0,0 +1,13 @@
+ALTER TABLE "scan_jobs"
+    ADD COLUMN "failed_count" INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE "scan_failures"
+(
+    "scan_failure_id" SERIAL PRIMARY KEY,
+    "scan_job_id"     INTEGER     NOT NULL,
+    "audio_file_id"   INTEGER     NOT NULL UNIQUE,
+    "phase"           TEXT        NOT NULL,
+    "error"           TEXT        NOT NULL,
+    "failed_at"       TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY ("scan_job_id") REFERENCES "scan_jobs" ("scan_job_id") ON DELETE CASCADE
+);
@@ -0,0 +1,23 @@
+DROP INDEX "albums_musicbrainz_release_id_idx";
+DROP INDEX "albums_identity_idx";
+
+UPDATE "songs"
+SET "album_id" = "kept"."album_id"
+FROM "albums"
+         JOIN (SELECT "title", MIN("album_id") AS "album_id"
+               FROM "albums"
+               GROUP BY "title") AS "kept" ON "kept"."title" = "albums"."title"
+WHERE "songs"."album_id" = "albums"."album_id";
+
+DELETE
+FROM "albums"
+WHERE "album_id" NOT IN (SELECT MIN("album_id")
+                         FROM "albums"
+                         GROUP BY "title");
+
+ALTER TABLE "albums"
+    DROP COLUMN "musicbrainz_release_id",
+    DROP COLUMN "year",
+    DROP COLUMN "album_artist_id";
+ALTER TABLE "albums"
+    ADD CONSTRAINT "albums_title_key" UNIQUE ("title");
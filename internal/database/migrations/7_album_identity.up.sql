@@ -0,0 +1,50 @@
+ALTER TABLE "albums"
+    DROP CONSTRAINT "albums_title_key";
+ALTER TABLE "albums"
+    ADD COLUMN "album_artist_id"        INTEGER,
+    ADD COLUMN "year"                   INTEGER,
+    ADD COLUMN "musicbrainz_release_id" TEXT;
+ALTER TABLE "albums"
+    ADD FOREIGN KEY ("album_artist_id") REFERENCES "artists" ("artist_id");
+
+-- Album artists were not stored, so the track artist stands in for them. Albums whose songs have
+-- different artists or years are split, like a scan would do for files without an album artist tag
+CREATE TEMPORARY TABLE "album_splits" AS
+SELECT "album_id",
+       "artist_id",
+       "year",
+       ROW_NUMBER() OVER (PARTITION BY "album_id" ORDER BY "artist_id" NULLS LAST, "year" NULLS LAST) AS "n"
+FROM (SELECT DISTINCT "album_id", "artist_id", "year"
+      FROM "songs"
+      WHERE "album_id" IS NOT NULL) AS "combinations";
+
+UPDATE "albums"
+SET "album_artist_id" = "album_splits"."artist_id",
+    "year"            = "album_splits"."year"
+FROM "album_splits"
+WHERE "album_splits"."album_id" = "albums"."album_id"
+  AND "album_splits"."n" = 1;
+
+INSERT INTO "albums"("title", "album_artist_id", "year")
+SELECT "albums"."title", "album_splits"."artist_id", "album_splits"."year"
+FROM "album_splits"
+         JOIN "albums" ON "albums"."album_id" = "album_splits"."album_id"
+WHERE "album_splits"."n" > 1;
+
+UPDATE "songs"
+SET "album_id" = "target"."album_id"
+FROM "album_splits"
+         JOIN "albums" AS "source" ON "source"."album_id" = "album_splits"."album_id"
+         JOIN "albums" AS "target" ON "target"."title" = "source"."title"
+    AND "target"."album_artist_id" IS NOT DISTINCT FROM "album_splits"."artist_id"
+    AND "target"."year" IS NOT DISTINCT FROM "album_splits"."year"
+WHERE "album_splits"."n" > 1
+  AND "songs"."album_id" = "album_splits"."album_id"
+  AND "songs"."artist_id" IS NOT DISTINCT FROM "album_splits"."artist_id"
+  AND "songs"."year" IS NOT DISTINCT FROM "album_splits"."year";
+
+DROP TABLE "album_splits";
+
+CREATE UNIQUE INDEX "albums_identity_idx" ON "albums" ("title", COALESCE("album_artist_id", 0), COALESCE("year", 0))
+    WHERE "musicbrainz_release_id" IS NULL;
+CREATE UNIQUE INDEX "albums_musicbrainz_release_id_idx" ON "albums" ("musicbrainz_release_id");
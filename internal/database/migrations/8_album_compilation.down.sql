@@ -0,0 +1,4 @@
+ALTER TABLE "songs"
+    DROP COLUMN "compilation";
+ALTER TABLE "albums"
+    DROP COLUMN "compilation";
@@ -0,0 +1,18 @@
+ALTER TABLE "albums"
+    ADD COLUMN "compilation" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE "albums"
+SET "compilation" = TRUE
+FROM "artists"
+WHERE "artists"."artist_id" = "albums"."album_artist_id"
+  AND LOWER("artists"."name") IN ('various artists', 'various', 'va');
+
+-- Songs keep whether their files belong to a compilation, so the flag of an album follows its files
+ALTER TABLE "songs"
+    ADD COLUMN "compilation" BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE "songs"
+SET "compilation" = TRUE
+FROM "albums"
+WHERE "albums"."album_id" = "songs"."album_id"
+  AND "albums"."compilation";
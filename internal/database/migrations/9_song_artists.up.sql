@@ -0,0 +1,17 @@
+CREATE TABLE "song_artists"
+(
+    "song_id"   INTEGER NOT NULL,
+    "artist_id" INTEGER NOT NULL,
+    "role"      TEXT    NOT NULL,
+    "position"  INTEGER NOT NULL,
+    PRIMARY KEY ("song_id", "artist_id", "role"),
+    FOREIGN KEY ("song_id") REFERENCES "songs" ("song_id") ON DELETE CASCADE,
+    FOREIGN KEY ("artist_id") REFERENCES "artists" ("artist_id")
+);
+
+CREATE INDEX "song_artists_artist_id_idx" ON "song_artists" ("artist_id");
+
+INSERT INTO "song_artists"("song_id", "artist_id", "role", "position")
+SELECT "song_id", "artist_id", 'main', 0
+FROM "songs"
+WHERE "artist_id" IS NOT NULL;
@@ -0,0 +1,37 @@
+package album_alias_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, albumAlias model.AlbumAlias) (albumAliasId int, err error) {
+	query := `
+		INSERT INTO album_aliases(title, title_key, album_artist_id, year, musicbrainz_release_id, album_id)
+		VALUES (:title, :title_key, :album_artist_id, :year, :musicbrainz_release_id, :album_id)
+		RETURNING album_alias_id
+	`
+	albumAlias.TitleKey = model.MatchingKey(albumAlias.Title)
+	rows, err := tx.NamedQuery(query, albumAlias)
+	if err != nil {
+		log.Error().Err(err).Str("title", albumAlias.Title).Int("albumId", albumAlias.AlbumId).Msg("Failed to create album alias")
+		return 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&albumAliasId); err != nil {
+			log.Error().Err(err).Str("title", albumAlias.Title).Msg("Failed to scan id into filed")
+			return 0, err
+		}
+	} else {
+		err := fmt.Errorf("no id returned after album alias insert")
+		log.Error().Err(err).Str("title", albumAlias.Title).Msg("No id returned after album alias insert")
+		return 0, err
+	}
+
+	log.Debug().Int("id", albumAliasId).Int("albumId", albumAlias.AlbumId).Msg("Album alias created successfully")
+	return albumAliasId, nil
+}
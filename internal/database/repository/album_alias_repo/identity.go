@@ -0,0 +1,22 @@
+package album_alias_repo
+
+import "music-metadata/internal/model"
+
+// identityCondition selects the alias with the same identity, compared the way albums are
+func identityCondition(identity model.Album) (condition string, args map[string]interface{}) {
+	if identity.MusicBrainzReleaseId != nil {
+		return "musicbrainz_release_id = :musicbrainz_release_id", map[string]interface{}{
+			"musicbrainz_release_id": *identity.MusicBrainzReleaseId,
+		}
+	}
+
+	condition = `musicbrainz_release_id IS NULL
+			AND title_key = :title_key
+			AND album_artist_id IS NOT DISTINCT FROM CAST(:album_artist_id AS INTEGER)
+			AND year IS NOT DISTINCT FROM CAST(:year AS INTEGER)`
+	return condition, map[string]interface{}{
+		"title_key":       model.MatchingKey(identity.Title),
+		"album_artist_id": identity.AlbumArtistId,
+		"year":            identity.Year,
+	}
+}
@@ -0,0 +1,38 @@
+package album_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) IsExistsByIdentity(tx *sqlx.Tx, identity model.Album) (exists bool, err error) {
+	condition, args := identityCondition(identity)
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM album_aliases
+			WHERE ` + condition + `
+		)
+	`
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to execute query to check album alias existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Interface("identity", identity).Msg("Failed to scan result of album alias existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Interface("identity", identity).Msg("Album alias exists")
+	} else {
+		log.Debug().Interface("identity", identity).Msg("No album alias found")
+	}
+	return exists, nil
+}
@@ -0,0 +1,36 @@
+package album_alias_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadByIdentity(tx *sqlx.Tx, identity model.Album) (albumAlias model.AlbumAlias, err error) {
+	condition, args := identityCondition(identity)
+	query := `
+		SELECT *
+		FROM album_aliases
+		WHERE ` + condition
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to fetch album alias")
+		return model.AlbumAlias{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&albumAlias); err != nil {
+			log.Error().Err(err).Interface("identity", identity).Msg("Failed to scan album alias into struct")
+			return model.AlbumAlias{}, err
+		}
+	} else {
+		err := fmt.Errorf("no album alias found with title: %s", identity.Title)
+		log.Error().Err(err).Interface("identity", identity).Msg("No album alias found")
+		return model.AlbumAlias{}, err
+	}
+
+	log.Debug().Int("albumId", albumAlias.AlbumId).Msg("Album alias fetched by identity successfully")
+	return albumAlias, nil
+}
@@ -0,0 +1,45 @@
+package album_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReplaceAlbumArtistId credits the aliases of fromArtistId to toArtistId. Aliases that would then
+// repeat an alias of toArtistId are dropped
+func (r Repository) ReplaceAlbumArtistId(tx *sqlx.Tx, fromArtistId int, toArtistId int) (err error) {
+	args := map[string]interface{}{
+		"from_artist_id": fromArtistId,
+		"to_artist_id":   toArtistId,
+	}
+
+	query := `
+		DELETE FROM album_aliases
+		USING album_aliases AS other
+		WHERE album_aliases.album_artist_id = :from_artist_id
+			AND album_aliases.musicbrainz_release_id IS NULL
+			AND other.album_artist_id = :to_artist_id
+			AND other.musicbrainz_release_id IS NULL
+			AND other.title_key = album_aliases.title_key
+			AND other.year IS NOT DISTINCT FROM album_aliases.year
+	`
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Failed to delete repeated album aliases")
+		return err
+	}
+
+	query = `
+		UPDATE album_aliases
+		SET album_artist_id = :to_artist_id
+		WHERE album_artist_id = :from_artist_id
+	`
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Failed to replace album artist of album aliases")
+		return err
+	}
+
+	log.Debug().Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Album artist of album aliases replaced successfully")
+	return nil
+}
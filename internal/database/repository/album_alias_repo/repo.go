@@ -0,0 +1,21 @@
+package album_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, albumAlias model.AlbumAlias) (albumAliasId int, err error)
+	ReadByIdentity(tx *sqlx.Tx, identity model.Album) (albumAlias model.AlbumAlias, err error)
+	UpdateAlbumId(tx *sqlx.Tx, fromAlbumId int, toAlbumId int) (err error)
+	ReplaceAlbumArtistId(tx *sqlx.Tx, fromArtistId int, toArtistId int) (err error)
+	IsExistsByIdentity(tx *sqlx.Tx, identity model.Album) (exists bool, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
@@ -0,0 +1,27 @@
+package album_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateAlbumId moves every alias of the album fromAlbumId to the album toAlbumId
+func (r Repository) UpdateAlbumId(tx *sqlx.Tx, fromAlbumId int, toAlbumId int) (err error) {
+	query := `
+		UPDATE album_aliases
+		SET album_id = :to_album_id
+		WHERE album_id = :from_album_id
+	`
+	args := map[string]interface{}{
+		"from_album_id": fromAlbumId,
+		"to_album_id":   toAlbumId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromAlbumId", fromAlbumId).Int("toAlbumId", toAlbumId).Msg("Failed to move album aliases")
+		return err
+	}
+
+	log.Debug().Int("fromAlbumId", fromAlbumId).Int("toAlbumId", toAlbumId).Msg("Album aliases moved successfully")
+	return nil
+}
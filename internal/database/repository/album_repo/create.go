@@ -9,10 +9,14 @@ import (
 
 func (r Repository) Create(tx *sqlx.Tx, album model.Album) (albumId int, err error) {
 	query := `
-		INSERT INTO albums(title)
-		VALUES (:title)
+		INSERT INTO albums(title, title_key, album_artist_id, year, musicbrainz_release_id, compilation, sort_title, sort_key)
+		VALUES (:title, :title_key, :album_artist_id, :year, :musicbrainz_release_id, :compilation, :sort_title, :sort_key)
 		RETURNING album_id
 	`
+	album.TitleKey = model.MatchingKey(album.Title)
+	if len(album.SortKey) == 0 {
+		album.SortKey = album.TitleKey
+	}
 	rows, err := tx.NamedQuery(query, album)
 	if err != nil {
 		log.Error().Err(err).Str("title", album.Title).Msg("Failed to create album")
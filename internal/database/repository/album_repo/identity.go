@@ -0,0 +1,23 @@
+package album_repo
+
+import "music-metadata/internal/model"
+
+// identityCondition selects the album with the same identity: the MusicBrainz release id if the
+// album has one, otherwise title, album artist and year, where a missing value matches only a missing value
+func identityCondition(identity model.Album) (condition string, args map[string]interface{}) {
+	if identity.MusicBrainzReleaseId != nil {
+		return "musicbrainz_release_id = :musicbrainz_release_id", map[string]interface{}{
+			"musicbrainz_release_id": *identity.MusicBrainzReleaseId,
+		}
+	}
+
+	condition = `musicbrainz_release_id IS NULL
+			AND title_key = :title_key
+			AND album_artist_id IS NOT DISTINCT FROM CAST(:album_artist_id AS INTEGER)
+			AND year IS NOT DISTINCT FROM CAST(:year AS INTEGER)`
+	return condition, map[string]interface{}{
+		"title_key":       model.MatchingKey(identity.Title),
+		"album_artist_id": identity.AlbumArtistId,
+		"year":            identity.Year,
+	}
+}
@@ -0,0 +1,38 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) IsExistsByIdentity(tx *sqlx.Tx, identity model.Album) (exists bool, err error) {
+	condition, args := identityCondition(identity)
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM albums
+			WHERE ` + condition + `
+		)
+	`
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to execute query to check album existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Interface("identity", identity).Msg("Failed to scan result of album existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Interface("identity", identity).Msg("Album exists")
+	} else {
+		log.Debug().Interface("identity", identity).Msg("No album found")
+	}
+	return exists, nil
+}
@@ -7,9 +7,9 @@ import (
 
 func (r Repository) IsUsed(tx *sqlx.Tx, albumId int) (used bool, err error) {
 	query := `
-		SELECT COUNT(*)
-		FROM songs
-		WHERE album_id = :album_id
+		SELECT (SELECT COUNT(*) FROM songs WHERE album_id = :album_id)
+			+ (SELECT COUNT(*) FROM album_aliases WHERE album_id = :album_id)
+			+ (SELECT COUNT(*) FROM song_splits WHERE kind = 'album' AND (from_id = :album_id OR to_id = :album_id))
 	`
 
 	args := map[string]interface{}{
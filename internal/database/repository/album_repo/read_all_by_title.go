@@ -0,0 +1,36 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllByTitle(tx *sqlx.Tx, title string) (albums []model.Album, err error) {
+	query := `
+		SELECT *
+		FROM albums
+		WHERE title_key = :title_key
+	`
+	args := map[string]interface{}{
+		"title_key": model.MatchingKey(title),
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to fetch albums")
+		return make([]model.Album, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var album model.Album
+		if err = rows.StructScan(&album); err != nil {
+			log.Error().Err(err).Str("title", title).Msg("Failed to scan albums data")
+			return make([]model.Album, 0), err
+		}
+		albums = append(albums, album)
+	}
+
+	log.Debug().Str("title", title).Int("count", len(albums)).Msg("Albums fetched by title successfully")
+	return albums, nil
+}
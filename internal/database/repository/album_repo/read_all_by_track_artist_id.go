@@ -0,0 +1,43 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReadAllByTrackArtistId reads the albums the artist appears on with some songs without being their album artist
+func (r Repository) ReadAllByTrackArtistId(tx *sqlx.Tx, artistId int) (albums []model.Album, err error) {
+	query := `
+		SELECT *
+		FROM albums
+		WHERE album_artist_id IS DISTINCT FROM :artist_id
+		  AND album_id IN (
+			SELECT songs.album_id
+			FROM songs
+			JOIN song_artists ON song_artists.song_id = songs.song_id
+			WHERE song_artists.artist_id = :artist_id
+		)
+	`
+	args := map[string]interface{}{
+		"artist_id": artistId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to fetch albums")
+		return make([]model.Album, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var album model.Album
+		if err = rows.StructScan(&album); err != nil {
+			log.Error().Err(err).Int("artistId", artistId).Msg("Failed to scan albums data")
+			return make([]model.Album, 0), err
+		}
+		albums = append(albums, album)
+	}
+
+	log.Debug().Int("artistId", artistId).Int("count", len(albums)).Msg("Albums fetched by track artist successfully")
+	return albums, nil
+}
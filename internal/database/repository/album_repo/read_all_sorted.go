@@ -0,0 +1,47 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+// sortColumns are the columns albums are ordered by for every sort field, the last one breaking ties
+var sortColumns = map[string][]string{
+	model.SortFieldTitle:  {"sort_key", "year", "album_id"},
+	model.SortFieldArtist: {"(SELECT sort_key FROM artists WHERE artist_id = albums.album_artist_id)", "year", "sort_key", "album_id"},
+	model.SortFieldYear:   {"year", "sort_key", "album_id"},
+}
+
+func (r Repository) ReadAllSorted(tx *sqlx.Tx, order model.SortOrder) (albums []model.Album, err error) {
+	terms := make([]string, 0)
+	for _, column := range sortColumns[order.Field] {
+		if order.Descending {
+			column += " DESC"
+		}
+		terms = append(terms, column+" NULLS LAST")
+	}
+	query := `
+		SELECT *
+		FROM albums
+		ORDER BY ` + strings.Join(terms, ", ")
+	rows, err := tx.Queryx(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch albums")
+		return make([]model.Album, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var album model.Album
+		if err = rows.StructScan(&album); err != nil {
+			log.Error().Err(err).Msg("Failed to scan albums data")
+			return make([]model.Album, 0), err
+		}
+		albums = append(albums, album)
+	}
+
+	log.Debug().Int("count", len(albums)).Str("sort", order.Field).Msg("All albums fetched successfully")
+	return albums, nil
+}
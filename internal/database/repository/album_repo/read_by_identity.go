@@ -0,0 +1,36 @@
+package album_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadByIdentity(tx *sqlx.Tx, identity model.Album) (album model.Album, err error) {
+	condition, args := identityCondition(identity)
+	query := `
+		SELECT *
+		FROM albums
+		WHERE ` + condition
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to fetch album")
+		return model.Album{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&album); err != nil {
+			log.Error().Err(err).Interface("identity", identity).Msg("Failed to scan album into struct")
+			return model.Album{}, err
+		}
+	} else {
+		err := fmt.Errorf("no album found with title: %s", identity.Title)
+		log.Error().Err(err).Interface("identity", identity).Msg("No album found")
+		return model.Album{}, err
+	}
+
+	log.Debug().Int("id", album.AlbumId).Msg("Album fetched by identity successfully")
+	return album, nil
+}
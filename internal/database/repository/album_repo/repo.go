@@ -8,11 +8,20 @@ import (
 type Repo interface {
 	Create(tx *sqlx.Tx, album model.Album) (albumId int, err error)
 	Read(tx *sqlx.Tx, albumId int) (album model.Album, err error)
-	ReadByTitle(tx *sqlx.Tx, title string) (album model.Album, err error)
+	ReadByIdentity(tx *sqlx.Tx, identity model.Album) (album model.Album, err error)
+	ReadAllByTitle(tx *sqlx.Tx, title string) (albums []model.Album, err error)
 	ReadAll(tx *sqlx.Tx) (albums []model.Album, err error)
+	ReadAllSorted(tx *sqlx.Tx, order model.SortOrder) (albums []model.Album, err error)
+	ReadAllByAlbumArtistId(tx *sqlx.Tx, artistId int) (albums []model.Album, err error)
+	ReadAllByTrackArtistId(tx *sqlx.Tx, artistId int) (albums []model.Album, err error)
+	UpdateCompilationFromSongs(tx *sqlx.Tx, albumId int) (err error)
+	UpdateAlbumArtistId(tx *sqlx.Tx, albumId int, albumArtistId *int) (err error)
+	UpdateTitleToMostCommon(tx *sqlx.Tx, albumId int) (err error)
+	UpdateReleaseToMostCommon(tx *sqlx.Tx, albumId int) (err error)
+	UpdateSort(tx *sqlx.Tx, albumId int, sortTitle *string, sortKey string) (err error)
 	Delete(tx *sqlx.Tx, albumId int) (err error)
 	IsExists(tx *sqlx.Tx, albumId int) (exists bool, err error)
-	IsExistsByTitle(tx *sqlx.Tx, title string) (exists bool, err error)
+	IsExistsByIdentity(tx *sqlx.Tx, identity model.Album) (exists bool, err error)
 	IsUsed(tx *sqlx.Tx, albumId int) (used bool, err error)
 }
 
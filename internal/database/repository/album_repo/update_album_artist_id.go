@@ -0,0 +1,38 @@
+package album_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) UpdateAlbumArtistId(tx *sqlx.Tx, albumId int, albumArtistId *int) (err error) {
+	query := `
+		UPDATE albums
+		SET album_artist_id = :album_artist_id
+		WHERE album_id = :album_id
+	`
+	args := map[string]interface{}{
+		"album_id":        albumId,
+		"album_artist_id": albumArtistId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to update album artist of album")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to get rows affected after album update")
+		return err
+	}
+	if rowsAffected == 0 {
+		err := fmt.Errorf("no rows affected while updating album")
+		log.Error().Err(err).Int("id", albumId).Msg("No rows affected while updating album")
+		return err
+	}
+
+	log.Info().Int("id", albumId).Interface("albumArtistId", albumArtistId).Msg("Album artist of album updated successfully")
+	return nil
+}
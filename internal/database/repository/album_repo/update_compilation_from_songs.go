@@ -0,0 +1,39 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateCompilationFromSongs marks the album as a compilation while any of its songs belongs to one, so
+// retagging its files as a regular album clears the flag. The flag stays as it is while no song uses the album
+func (r Repository) UpdateCompilationFromSongs(tx *sqlx.Tx, albumId int) (err error) {
+	query := `
+		UPDATE albums
+		SET compilation = songs.compilation
+		FROM (
+			SELECT BOOL_OR(compilation) AS compilation
+			FROM songs
+			WHERE album_id = :album_id
+			HAVING COUNT(*) > 0
+		) AS songs
+		WHERE album_id = :album_id
+	`
+	args := map[string]interface{}{
+		"album_id": albumId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to update album compilation flag")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to get rows affected after album update")
+		return err
+	}
+
+	log.Debug().Int("id", albumId).Int64("rowsAffected", rowsAffected).Msg("Album compilation flag updated from its songs successfully")
+	return nil
+}
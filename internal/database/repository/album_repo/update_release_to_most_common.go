@@ -0,0 +1,48 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateReleaseToMostCommon sets the release date and label of the album to the ones its songs carry most
+// often, the earlier date and the first label in alphabetical order winning ties
+func (r Repository) UpdateReleaseToMostCommon(tx *sqlx.Tx, albumId int) (err error) {
+	query := `
+		UPDATE albums
+		SET release_date = (
+				SELECT release_date
+				FROM songs
+				WHERE album_id = :album_id AND release_date IS NOT NULL
+				GROUP BY release_date
+				ORDER BY COUNT(*) DESC, release_date
+				LIMIT 1
+			),
+			label = (
+				SELECT label
+				FROM songs
+				WHERE album_id = :album_id AND label IS NOT NULL
+				GROUP BY label
+				ORDER BY COUNT(*) DESC, label
+				LIMIT 1
+			)
+		WHERE album_id = :album_id
+	`
+	args := map[string]interface{}{
+		"album_id": albumId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to update album release")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to get rows affected after album update")
+		return err
+	}
+
+	log.Debug().Int("id", albumId).Int64("rowsAffected", rowsAffected).Msg("Album release updated to the most common one successfully")
+	return nil
+}
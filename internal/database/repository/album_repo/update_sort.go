@@ -0,0 +1,27 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) UpdateSort(tx *sqlx.Tx, albumId int, sortTitle *string, sortKey string) (err error) {
+	query := `
+		UPDATE albums
+		SET sort_title = :sort_title, sort_key = :sort_key
+		WHERE album_id = :album_id
+	`
+	args := map[string]interface{}{
+		"album_id":   albumId,
+		"sort_title": sortTitle,
+		"sort_key":   sortKey,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to update album sort title")
+		return err
+	}
+
+	log.Debug().Int("id", albumId).Msg("Album sort title updated successfully")
+	return nil
+}
@@ -0,0 +1,41 @@
+package album_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateTitleToMostCommon sets the title of the album to the spelling its songs use most often.
+// The title stays as it is while no song uses the album
+func (r Repository) UpdateTitleToMostCommon(tx *sqlx.Tx, albumId int) (err error) {
+	query := `
+		UPDATE albums
+		SET title = spellings.album_title
+		FROM (
+			SELECT album_title
+			FROM songs
+			WHERE album_id = :album_id AND album_title IS NOT NULL
+			GROUP BY album_title
+			ORDER BY COUNT(*) DESC, album_title
+			LIMIT 1
+		) AS spellings
+		WHERE album_id = :album_id
+	`
+	args := map[string]interface{}{
+		"album_id": albumId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to update album title")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", albumId).Msg("Failed to get rows affected after album update")
+		return err
+	}
+
+	log.Debug().Int("id", albumId).Int64("rowsAffected", rowsAffected).Msg("Album title updated to the most common spelling successfully")
+	return nil
+}
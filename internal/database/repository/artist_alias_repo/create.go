@@ -0,0 +1,23 @@
+package artist_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, artistAlias model.ArtistAlias) (err error) {
+	query := `
+		INSERT INTO artist_aliases(alias, alias_key, artist_id)
+		VALUES (:alias, :alias_key, :artist_id)
+	`
+	artistAlias.AliasKey = model.MatchingKey(artistAlias.Alias)
+	_, err = tx.NamedExec(query, artistAlias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", artistAlias.Alias).Int("artistId", artistAlias.ArtistId).Msg("Failed to create artist alias")
+		return err
+	}
+
+	log.Debug().Str("alias", artistAlias.Alias).Int("artistId", artistAlias.ArtistId).Msg("Artist alias created successfully")
+	return nil
+}
@@ -0,0 +1,40 @@
+package artist_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) IsExistsByAlias(tx *sqlx.Tx, alias string) (exists bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM artist_aliases
+			WHERE alias_key = :alias_key
+		)
+	`
+	args := map[string]interface{}{
+		"alias_key": model.MatchingKey(alias),
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to execute query to check artist alias existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Str("alias", alias).Msg("Failed to scan result of artist alias existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Str("alias", alias).Msg("Artist alias exists")
+	} else {
+		log.Debug().Str("alias", alias).Msg("No artist alias found")
+	}
+	return exists, nil
+}
@@ -0,0 +1,39 @@
+package artist_alias_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadByAlias(tx *sqlx.Tx, alias string) (artistAlias model.ArtistAlias, err error) {
+	query := `
+		SELECT *
+		FROM artist_aliases
+		WHERE alias_key = :alias_key
+	`
+	args := map[string]interface{}{
+		"alias_key": model.MatchingKey(alias),
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to fetch artist alias")
+		return model.ArtistAlias{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&artistAlias); err != nil {
+			log.Error().Err(err).Str("alias", alias).Msg("Failed to scan artist alias into struct")
+			return model.ArtistAlias{}, err
+		}
+	} else {
+		err := fmt.Errorf("no artist alias found: %s", alias)
+		log.Error().Err(err).Str("alias", alias).Msg("No artist alias found")
+		return model.ArtistAlias{}, err
+	}
+
+	log.Debug().Str("alias", alias).Int("artistId", artistAlias.ArtistId).Msg("Artist alias fetched successfully")
+	return artistAlias, nil
+}
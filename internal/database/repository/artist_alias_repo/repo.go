@@ -0,0 +1,20 @@
+package artist_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, artistAlias model.ArtistAlias) (err error)
+	ReadByAlias(tx *sqlx.Tx, alias string) (artistAlias model.ArtistAlias, err error)
+	UpdateArtistId(tx *sqlx.Tx, fromArtistId int, toArtistId int) (err error)
+	IsExistsByAlias(tx *sqlx.Tx, alias string) (exists bool, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
@@ -0,0 +1,27 @@
+package artist_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateArtistId moves every alias of the artist fromArtistId to the artist toArtistId
+func (r Repository) UpdateArtistId(tx *sqlx.Tx, fromArtistId int, toArtistId int) (err error) {
+	query := `
+		UPDATE artist_aliases
+		SET artist_id = :to_artist_id
+		WHERE artist_id = :from_artist_id
+	`
+	args := map[string]interface{}{
+		"from_artist_id": fromArtistId,
+		"to_artist_id":   toArtistId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Failed to move artist aliases")
+		return err
+	}
+
+	log.Debug().Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Artist aliases moved successfully")
+	return nil
+}
@@ -9,10 +9,14 @@ import (
 
 func (r Repository) Create(tx *sqlx.Tx, artist model.Artist) (artistId int, err error) {
 	query := `
-		INSERT INTO artists(name)
-		VALUES (:name)
+		INSERT INTO artists(name, name_key, sort_name, sort_key)
+		VALUES (:name, :name_key, :sort_name, :sort_key)
 		RETURNING artist_id
 	`
+	artist.NameKey = model.MatchingKey(artist.Name)
+	if len(artist.SortKey) == 0 {
+		artist.SortKey = artist.NameKey
+	}
 	rows, err := tx.NamedQuery(query, artist)
 	if err != nil {
 		log.Error().Err(err).Str("name", artist.Name).Msg("Failed to create artist")
@@ -7,9 +7,13 @@ import (
 
 func (r Repository) IsUsed(tx *sqlx.Tx, artistId int) (used bool, err error) {
 	query := `
-		SELECT COUNT(*)
-		FROM songs
-		WHERE artist_id = :artist_id
+		SELECT (SELECT COUNT(*) FROM songs WHERE artist_id = :artist_id)
+			+ (SELECT COUNT(*) FROM song_artists WHERE artist_id = :artist_id)
+			+ (SELECT COUNT(*) FROM albums WHERE album_artist_id = :artist_id)
+			+ (SELECT COUNT(*) FROM artist_aliases WHERE artist_id = :artist_id)
+			+ (SELECT COUNT(*) FROM album_aliases WHERE album_artist_id = :artist_id)
+			+ (SELECT COUNT(*) FROM works WHERE composer_id = :artist_id)
+			+ (SELECT COUNT(*) FROM song_splits WHERE kind = 'artist' AND (from_id = :artist_id OR to_id = :artist_id))
 	`
 
 	args := map[string]interface{}{
@@ -26,7 +30,7 @@ func (r Repository) IsUsed(tx *sqlx.Tx, artistId int) (used bool, err error) {
 	var count int
 	if rows.Next() {
 		if err := rows.Scan(&count); err != nil {
-			log.Error().Err(err).Msg("Failed to scan count of songs for the artist")
+			log.Error().Err(err).Msg("Failed to scan count of songs and albums for the artist")
 			return false, err
 		}
 	}
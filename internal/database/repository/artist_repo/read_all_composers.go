@@ -0,0 +1,35 @@
+package artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReadAllComposers reads the artists credited as composer on a song or composing a work
+func (r Repository) ReadAllComposers(tx *sqlx.Tx) (artists []model.Artist, err error) {
+	query := `
+		SELECT *
+		FROM artists
+		WHERE artist_id IN (SELECT artist_id FROM song_artists WHERE role = 'composer')
+		   OR artist_id IN (SELECT composer_id FROM works)
+	`
+	rows, err := tx.Queryx(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch composers")
+		return make([]model.Artist, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var artist model.Artist
+		if err = rows.StructScan(&artist); err != nil {
+			log.Error().Err(err).Msg("Failed to scan composers data")
+			return make([]model.Artist, 0), err
+		}
+		artists = append(artists, artist)
+	}
+
+	log.Debug().Int("count", len(artists)).Msg("All composers fetched successfully")
+	return artists, nil
+}
@@ -0,0 +1,45 @@
+package artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+// sortColumns are the columns artists are ordered by for every sort field, the last one breaking ties
+var sortColumns = map[string][]string{
+	model.SortFieldName: {"sort_key", "artist_id"},
+}
+
+func (r Repository) ReadAllSorted(tx *sqlx.Tx, order model.SortOrder) (artists []model.Artist, err error) {
+	terms := make([]string, 0)
+	for _, column := range sortColumns[order.Field] {
+		if order.Descending {
+			column += " DESC"
+		}
+		terms = append(terms, column+" NULLS LAST")
+	}
+	query := `
+		SELECT *
+		FROM artists
+		ORDER BY ` + strings.Join(terms, ", ")
+	rows, err := tx.Queryx(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch artists")
+		return make([]model.Artist, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var artist model.Artist
+		if err = rows.StructScan(&artist); err != nil {
+			log.Error().Err(err).Msg("Failed to scan artists data")
+			return make([]model.Artist, 0), err
+		}
+		artists = append(artists, artist)
+	}
+
+	log.Debug().Int("count", len(artists)).Str("sort", order.Field).Msg("All artists fetched successfully")
+	return artists, nil
+}
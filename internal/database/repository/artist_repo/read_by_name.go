@@ -11,10 +11,10 @@ func (r Repository) ReadByName(tx *sqlx.Tx, name string) (artist model.Artist, e
 	query := `
 		SELECT *
 		FROM artists
-		WHERE name = :name
+		WHERE name_key = :name_key
 	`
 	args := map[string]interface{}{
-		"name": name,
+		"name_key": model.MatchingKey(name),
 	}
 	rows, err := tx.NamedQuery(query, args)
 	if err != nil {
@@ -10,6 +10,10 @@ type Repo interface {
 	Read(tx *sqlx.Tx, artistId int) (artist model.Artist, err error)
 	ReadByName(tx *sqlx.Tx, name string) (artist model.Artist, err error)
 	ReadAll(tx *sqlx.Tx) (artists []model.Artist, err error)
+	ReadAllSorted(tx *sqlx.Tx, order model.SortOrder) (artists []model.Artist, err error)
+	ReadAllComposers(tx *sqlx.Tx) (artists []model.Artist, err error)
+	UpdateNameToMostCommon(tx *sqlx.Tx, artistId int) (err error)
+	UpdateSort(tx *sqlx.Tx, artistId int, sortName *string, sortKey string) (err error)
 	Delete(tx *sqlx.Tx, artistId int) (err error)
 	IsExists(tx *sqlx.Tx, artistId int) (exists bool, err error)
 	IsExistsByName(tx *sqlx.Tx, name string) (exists bool, err error)
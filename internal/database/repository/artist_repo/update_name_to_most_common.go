@@ -0,0 +1,41 @@
+package artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateNameToMostCommon sets the name of the artist to the spelling its songs use most often.
+// The name stays as it is while no song uses the artist
+func (r Repository) UpdateNameToMostCommon(tx *sqlx.Tx, artistId int) (err error) {
+	query := `
+		UPDATE artists
+		SET name = spellings.credited_name
+		FROM (
+			SELECT credited_name
+			FROM song_artists
+			WHERE artist_id = :artist_id
+			GROUP BY credited_name
+			ORDER BY COUNT(*) DESC, credited_name
+			LIMIT 1
+		) AS spellings
+		WHERE artist_id = :artist_id
+	`
+	args := map[string]interface{}{
+		"artist_id": artistId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", artistId).Msg("Failed to update artist name")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", artistId).Msg("Failed to get rows affected after artist update")
+		return err
+	}
+
+	log.Debug().Int("id", artistId).Int64("rowsAffected", rowsAffected).Msg("Artist name updated to the most common spelling successfully")
+	return nil
+}
@@ -0,0 +1,27 @@
+package artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) UpdateSort(tx *sqlx.Tx, artistId int, sortName *string, sortKey string) (err error) {
+	query := `
+		UPDATE artists
+		SET sort_name = :sort_name, sort_key = :sort_key
+		WHERE artist_id = :artist_id
+	`
+	args := map[string]interface{}{
+		"artist_id": artistId,
+		"sort_name": sortName,
+		"sort_key":  sortKey,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", artistId).Msg("Failed to update artist sort name")
+		return err
+	}
+
+	log.Debug().Int("id", artistId).Msg("Artist sort name updated successfully")
+	return nil
+}
@@ -0,0 +1,45 @@
+package audit_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"strconv"
+)
+
+// CreateChangeSet creates the change set and makes the following changes of tx part of it
+func (r Repository) CreateChangeSet(tx *sqlx.Tx, changeSet model.ChangeSet) (changeSetId int, err error) {
+	query := `
+		INSERT INTO audit_change_sets(source, reverted_change_set_id)
+		VALUES (:source, :reverted_change_set_id)
+		RETURNING change_set_id
+	`
+	rows, err := tx.NamedQuery(query, changeSet)
+	if err != nil {
+		log.Error().Err(err).Interface("changeSet", changeSet).Msg("Failed to create change set")
+		return 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&changeSetId); err != nil {
+			log.Error().Err(err).Msg("Failed to scan id into filed")
+			return 0, err
+		}
+	} else {
+		err := fmt.Errorf("no id returned after change set insert")
+		log.Error().Err(err).Msg("No id returned after change set insert")
+		return 0, err
+	}
+	rows.Close()
+
+	_, err = tx.Exec(`SELECT set_config('music_metadata.change_set_id', $1, TRUE)`, strconv.Itoa(changeSetId))
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to use change set")
+		return 0, err
+	}
+
+	log.Debug().Int("changeSetId", changeSetId).Msg("Change set created successfully")
+	return changeSetId, nil
+}
@@ -0,0 +1,57 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+// DeleteAllChangeSetsBefore deletes the change sets created before the time, together with their entries.
+// A change set reverted by a change set that is kept stays too, so that the revert still points to it
+func (r Repository) DeleteAllChangeSetsBefore(tx *sqlx.Tx, before time.Time) (deleted int64, err error) {
+	_, err = tx.Exec(`SELECT set_config('music_metadata.audit_cleanup', 'on', TRUE)`)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to allow audit log cleanup")
+		return 0, err
+	}
+
+	expired := `
+		SELECT change_set.change_set_id
+		FROM audit_change_sets AS change_set
+		WHERE change_set.created_at < :before
+			AND NOT EXISTS (
+				SELECT 1
+				FROM audit_change_sets AS reverting
+				WHERE reverting.reverted_change_set_id = change_set.change_set_id
+					AND reverting.created_at >= :before
+			)
+	`
+	args := map[string]interface{}{
+		"before": before.UTC(),
+	}
+	_, err = tx.NamedExec(`DELETE FROM audit_entries WHERE change_set_id IN (`+expired+`)`, args)
+	if err != nil {
+		log.Error().Err(err).Time("before", before).Msg("Failed to delete expired audit entries")
+		return 0, err
+	}
+	result, err := tx.NamedExec(`DELETE FROM audit_change_sets WHERE change_set_id IN (`+expired+`)`, args)
+	if err != nil {
+		log.Error().Err(err).Time("before", before).Msg("Failed to delete expired change sets")
+		return 0, err
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Time("before", before).Msg("Failed to get rows affected after change set deletion")
+		return 0, err
+	}
+
+	_, err = tx.Exec(`SELECT set_config('music_metadata.audit_cleanup', '', TRUE)`)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to forbid audit log cleanup")
+		return 0, err
+	}
+
+	log.Debug().Time("before", before).Int64("count", deleted).Msg("Expired change sets deleted successfully")
+	return deleted, nil
+}
@@ -0,0 +1,39 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) IsChangeSetExists(tx *sqlx.Tx, changeSetId int) (exists bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM audit_change_sets
+			WHERE change_set_id = :change_set_id
+		)
+	`
+	args := map[string]interface{}{
+		"change_set_id": changeSetId,
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to execute query to check change set existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to scan result of change set existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Int("changeSetId", changeSetId).Msg("Change set exists")
+	} else {
+		log.Debug().Int("changeSetId", changeSetId).Msg("No change set found")
+	}
+	return exists, nil
+}
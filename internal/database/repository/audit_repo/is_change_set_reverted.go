@@ -0,0 +1,39 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) IsChangeSetReverted(tx *sqlx.Tx, changeSetId int) (reverted bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM audit_change_sets
+			WHERE reverted_change_set_id = :change_set_id
+		)
+	`
+	args := map[string]interface{}{
+		"change_set_id": changeSetId,
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to execute query to check change set revert")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&reverted); err != nil {
+			log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to scan result of change set revert check")
+			return false, err
+		}
+	}
+
+	if reverted {
+		log.Debug().Int("changeSetId", changeSetId).Msg("Change set is reverted")
+	} else {
+		log.Debug().Int("changeSetId", changeSetId).Msg("Change set is not reverted")
+	}
+	return reverted, nil
+}
@@ -0,0 +1,64 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// IsChangedAfter tells whether a row changed by the change set was changed again by a later change set.
+// Rows are matched by their keys both before and after each change, so that a change moving a row to
+// another key is not missed
+func (r Repository) IsChangedAfter(tx *sqlx.Tx, changeSetId int) (changed bool, err error) {
+	query := `
+		WITH entry_keys AS (
+			SELECT audit_entry_id, table_name, row_key
+			FROM audit_entries
+			WHERE change_set_id = :change_set_id
+			UNION ALL
+			SELECT audit_entry_id, table_name, previous_row_key
+			FROM audit_entries
+			WHERE change_set_id = :change_set_id
+				AND previous_row_key IS NOT NULL
+		)
+		SELECT EXISTS (
+			SELECT 1
+			FROM entry_keys
+			JOIN audit_entries AS later_entry
+				ON later_entry.table_name = entry_keys.table_name
+					AND later_entry.row_key = entry_keys.row_key
+			WHERE later_entry.audit_entry_id > entry_keys.audit_entry_id
+				AND later_entry.change_set_id <> :change_set_id
+		) OR EXISTS (
+			SELECT 1
+			FROM entry_keys
+			JOIN audit_entries AS later_entry
+				ON later_entry.table_name = entry_keys.table_name
+					AND later_entry.previous_row_key = entry_keys.row_key
+			WHERE later_entry.audit_entry_id > entry_keys.audit_entry_id
+				AND later_entry.change_set_id <> :change_set_id
+		)
+	`
+	args := map[string]interface{}{
+		"change_set_id": changeSetId,
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to execute query to check later changes")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&changed); err != nil {
+			log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to scan result of later changes check")
+			return false, err
+		}
+	}
+
+	if changed {
+		log.Debug().Int("changeSetId", changeSetId).Msg("Rows of change set changed after it")
+	} else {
+		log.Debug().Int("changeSetId", changeSetId).Msg("Rows of change set not changed after it")
+	}
+	return changed, nil
+}
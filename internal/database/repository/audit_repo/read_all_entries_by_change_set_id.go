@@ -0,0 +1,38 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllEntriesByChangeSetId(tx *sqlx.Tx, changeSetId int) (auditEntries []model.AuditEntry, err error) {
+	query := `
+		SELECT *
+		FROM audit_entries
+		WHERE change_set_id = :change_set_id
+		ORDER BY audit_entry_id
+	`
+	args := map[string]interface{}{
+		"change_set_id": changeSetId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to fetch audit entries")
+		return make([]model.AuditEntry, 0), err
+	}
+	defer rows.Close()
+
+	auditEntries = make([]model.AuditEntry, 0)
+	for rows.Next() {
+		var auditEntry model.AuditEntry
+		if err = rows.StructScan(&auditEntry); err != nil {
+			log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to scan audit entry")
+			return make([]model.AuditEntry, 0), err
+		}
+		auditEntries = append(auditEntries, auditEntry)
+	}
+
+	log.Debug().Int("changeSetId", changeSetId).Int("count", len(auditEntries)).Msg("Audit entries fetched successfully")
+	return auditEntries, nil
+}
@@ -0,0 +1,39 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReadAllEntriesBySongId returns the changes of the song itself and of the rows keyed by it, like its artists
+func (r Repository) ReadAllEntriesBySongId(tx *sqlx.Tx, songId int) (auditEntries []model.AuditEntry, err error) {
+	query := `
+		SELECT *
+		FROM audit_entries
+		WHERE CAST(row_key ->> 'song_id' AS INTEGER) = :song_id
+		ORDER BY audit_entry_id
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to fetch audit entries")
+		return make([]model.AuditEntry, 0), err
+	}
+	defer rows.Close()
+
+	auditEntries = make([]model.AuditEntry, 0)
+	for rows.Next() {
+		var auditEntry model.AuditEntry
+		if err = rows.StructScan(&auditEntry); err != nil {
+			log.Error().Err(err).Int("songId", songId).Msg("Failed to scan audit entry")
+			return make([]model.AuditEntry, 0), err
+		}
+		auditEntries = append(auditEntries, auditEntry)
+	}
+
+	log.Debug().Int("songId", songId).Int("count", len(auditEntries)).Msg("Audit entries fetched successfully")
+	return auditEntries, nil
+}
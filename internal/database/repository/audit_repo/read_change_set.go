@@ -0,0 +1,39 @@
+package audit_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadChangeSet(tx *sqlx.Tx, changeSetId int) (changeSet model.ChangeSet, err error) {
+	query := `
+		SELECT *
+		FROM audit_change_sets
+		WHERE change_set_id = :change_set_id
+	`
+	args := map[string]interface{}{
+		"change_set_id": changeSetId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to fetch change set")
+		return model.ChangeSet{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&changeSet); err != nil {
+			log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to scan change set into struct")
+			return model.ChangeSet{}, err
+		}
+	} else {
+		err := fmt.Errorf("no change set found with change_set_id: %d", changeSetId)
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("No change set found")
+		return model.ChangeSet{}, err
+	}
+
+	log.Debug().Int("changeSetId", changeSetId).Msg("Change set fetched successfully")
+	return changeSet, nil
+}
@@ -0,0 +1,26 @@
+package audit_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+	"time"
+)
+
+type Repo interface {
+	CreateChangeSet(tx *sqlx.Tx, changeSet model.ChangeSet) (changeSetId int, err error)
+	ReadChangeSet(tx *sqlx.Tx, changeSetId int) (changeSet model.ChangeSet, err error)
+	ReadAllEntriesByChangeSetId(tx *sqlx.Tx, changeSetId int) (auditEntries []model.AuditEntry, err error)
+	ReadAllEntriesBySongId(tx *sqlx.Tx, songId int) (auditEntries []model.AuditEntry, err error)
+	IsChangeSetExists(tx *sqlx.Tx, changeSetId int) (exists bool, err error)
+	IsChangeSetReverted(tx *sqlx.Tx, changeSetId int) (reverted bool, err error)
+	IsChangedAfter(tx *sqlx.Tx, changeSetId int) (changed bool, err error)
+	RevertEntry(tx *sqlx.Tx, auditEntry model.AuditEntry) (err error)
+	DeleteAllChangeSetsBefore(tx *sqlx.Tx, before time.Time) (deleted int64, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
@@ -0,0 +1,113 @@
+package audit_repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"sort"
+	"strings"
+)
+
+// auditedTables are the tables recorded in the audit log
+var auditedTables = map[string]bool{
+	"songs":          true,
+	"song_artists":   true,
+	"song_genres":    true,
+	"song_overrides": true,
+	"song_splits":    true,
+	"albums":         true,
+	"album_aliases":  true,
+	"artists":        true,
+	"artist_aliases": true,
+	"genres":         true,
+	"genre_aliases":  true,
+	"works":          true,
+}
+
+// RevertEntry undoes the change: an inserted row is deleted, a deleted row is inserted again and an
+// updated row gets its previous values back
+func (r Repository) RevertEntry(tx *sqlx.Tx, auditEntry model.AuditEntry) (err error) {
+	if !auditedTables[auditEntry.TableName] {
+		err = fmt.Errorf("table %q is not audited", auditEntry.TableName)
+		log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("Failed to revert audit entry")
+		return err
+	}
+	table := quoteIdentifier(auditEntry.TableName)
+	keyColumns, err := columnsOf(&auditEntry.RowKey)
+	if err != nil {
+		log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("Failed to read row key of audit entry")
+		return err
+	}
+	keyCondition := fmt.Sprintf("(%s) = (SELECT %s FROM jsonb_populate_record(CAST(NULL AS %s), :row_key))",
+		keyColumns, keyColumns, table)
+
+	var query string
+	args := map[string]interface{}{
+		"row_key": string(auditEntry.RowKey),
+	}
+	switch auditEntry.Operation {
+	case model.AuditOperationInsert:
+		query = fmt.Sprintf("DELETE FROM %s WHERE %s", table, keyCondition)
+	case model.AuditOperationDelete:
+		query = fmt.Sprintf("INSERT INTO %s SELECT * FROM jsonb_populate_record(CAST(NULL AS %s), :before)", table, table)
+		args["before"] = string(*auditEntry.Before)
+	case model.AuditOperationUpdate:
+		columns, err := columnsOf(auditEntry.Before)
+		if err != nil {
+			log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("Failed to read columns of audit entry")
+			return err
+		}
+		query = fmt.Sprintf("UPDATE %s SET (%s) = (SELECT %s FROM jsonb_populate_record(CAST(NULL AS %s), :before)) WHERE %s",
+			table, columns, columns, table, keyCondition)
+		args["before"] = string(*auditEntry.Before)
+	default:
+		err = fmt.Errorf("unknown audit operation %q", auditEntry.Operation)
+		log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("Failed to revert audit entry")
+		return err
+	}
+
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("Failed to revert audit entry")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("Failed to get rows affected after audit entry revert")
+		return err
+	}
+	if rowsAffected == 0 {
+		err := fmt.Errorf("no rows affected while reverting audit entry")
+		log.Error().Err(err).Int("auditEntryId", auditEntry.AuditEntryId).Msg("No rows affected while reverting audit entry")
+		return err
+	}
+
+	log.Debug().Int("auditEntryId", auditEntry.AuditEntryId).Msg("Audit entry reverted successfully")
+	return nil
+}
+
+// columnsOf lists the keys of a JSON row as quoted column names
+func columnsOf(row *json.RawMessage) (columns string, err error) {
+	if row == nil {
+		return "", fmt.Errorf("row is missing")
+	}
+	var values map[string]json.RawMessage
+	err = json.Unmarshal(*row, &values)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, quoteIdentifier(name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", "), nil
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,23 @@
+package genre_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, genreAlias model.GenreAlias) (err error) {
+	query := `
+		INSERT INTO genre_aliases(alias, alias_key, genre_id)
+		VALUES (:alias, :alias_key, :genre_id)
+	`
+	genreAlias.AliasKey = model.MatchingKey(genreAlias.Alias)
+	_, err = tx.NamedExec(query, genreAlias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", genreAlias.Alias).Int("genreId", genreAlias.GenreId).Msg("Failed to create genre alias")
+		return err
+	}
+
+	log.Debug().Str("alias", genreAlias.Alias).Int("genreId", genreAlias.GenreId).Msg("Genre alias created successfully")
+	return nil
+}
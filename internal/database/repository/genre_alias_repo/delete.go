@@ -0,0 +1,25 @@
+package genre_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Delete(tx *sqlx.Tx, alias string) (err error) {
+	query := `
+		DELETE FROM genre_aliases
+		WHERE alias_key = :alias_key
+	`
+	args := map[string]interface{}{
+		"alias_key": model.MatchingKey(alias),
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to delete genre alias")
+		return err
+	}
+
+	log.Debug().Str("alias", alias).Msg("Genre alias deleted successfully")
+	return nil
+}
@@ -0,0 +1,40 @@
+package genre_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) IsExistsByAlias(tx *sqlx.Tx, alias string) (exists bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM genre_aliases
+			WHERE alias_key = :alias_key
+		)
+	`
+	args := map[string]interface{}{
+		"alias_key": model.MatchingKey(alias),
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to execute query to check genre alias existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Str("alias", alias).Msg("Failed to scan result of genre alias existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Str("alias", alias).Msg("Genre alias exists")
+	} else {
+		log.Debug().Str("alias", alias).Msg("No genre alias found")
+	}
+	return exists, nil
+}
@@ -0,0 +1,38 @@
+package genre_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllByGenreId(tx *sqlx.Tx, genreId int) (genreAliases []model.GenreAlias, err error) {
+	query := `
+		SELECT *
+		FROM genre_aliases
+		WHERE genre_id = :genre_id
+		ORDER BY alias
+	`
+	args := map[string]interface{}{
+		"genre_id": genreId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to fetch genre aliases")
+		return make([]model.GenreAlias, 0), err
+	}
+	defer rows.Close()
+
+	genreAliases = make([]model.GenreAlias, 0)
+	for rows.Next() {
+		var genreAlias model.GenreAlias
+		if err = rows.StructScan(&genreAlias); err != nil {
+			log.Error().Err(err).Int("genreId", genreId).Msg("Failed to scan genre alias")
+			return make([]model.GenreAlias, 0), err
+		}
+		genreAliases = append(genreAliases, genreAlias)
+	}
+
+	log.Debug().Int("genreId", genreId).Int("count", len(genreAliases)).Msg("Genre aliases fetched successfully")
+	return genreAliases, nil
+}
@@ -0,0 +1,39 @@
+package genre_alias_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadByAlias(tx *sqlx.Tx, alias string) (genreAlias model.GenreAlias, err error) {
+	query := `
+		SELECT *
+		FROM genre_aliases
+		WHERE alias_key = :alias_key
+	`
+	args := map[string]interface{}{
+		"alias_key": model.MatchingKey(alias),
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to fetch genre alias")
+		return model.GenreAlias{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&genreAlias); err != nil {
+			log.Error().Err(err).Str("alias", alias).Msg("Failed to scan genre alias into struct")
+			return model.GenreAlias{}, err
+		}
+	} else {
+		err := fmt.Errorf("no genre alias found: %s", alias)
+		log.Error().Err(err).Str("alias", alias).Msg("No genre alias found")
+		return model.GenreAlias{}, err
+	}
+
+	log.Debug().Str("alias", alias).Int("genreId", genreAlias.GenreId).Msg("Genre alias fetched successfully")
+	return genreAlias, nil
+}
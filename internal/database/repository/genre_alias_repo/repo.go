@@ -0,0 +1,22 @@
+package genre_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, genreAlias model.GenreAlias) (err error)
+	ReadByAlias(tx *sqlx.Tx, alias string) (genreAlias model.GenreAlias, err error)
+	ReadAllByGenreId(tx *sqlx.Tx, genreId int) (genreAliases []model.GenreAlias, err error)
+	UpdateGenreId(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error)
+	Delete(tx *sqlx.Tx, alias string) (err error)
+	IsExistsByAlias(tx *sqlx.Tx, alias string) (exists bool, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
@@ -0,0 +1,27 @@
+package genre_alias_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateGenreId moves every alias of the genre fromGenreId to the genre toGenreId
+func (r Repository) UpdateGenreId(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error) {
+	query := `
+		UPDATE genre_aliases
+		SET genre_id = :to_genre_id
+		WHERE genre_id = :from_genre_id
+	`
+	args := map[string]interface{}{
+		"from_genre_id": fromGenreId,
+		"to_genre_id":   toGenreId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Failed to move genre aliases")
+		return err
+	}
+
+	log.Debug().Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Genre aliases moved successfully")
+	return nil
+}
@@ -9,10 +9,11 @@ import (
 
 func (r Repository) Create(tx *sqlx.Tx, genre model.Genre) (genreId int, err error) {
 	query := `
-		INSERT INTO genres(name)
-		VALUES (:name)
+		INSERT INTO genres(name, name_key)
+		VALUES (:name, :name_key)
 		RETURNING genre_id
 	`
+	genre.NameKey = model.MatchingKey(genre.Name)
 	rows, err := tx.NamedQuery(query, genre)
 	if err != nil {
 		log.Error().Err(err).Str("name", genre.Name).Msg("Failed to create genre")
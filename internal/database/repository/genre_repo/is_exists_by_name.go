@@ -3,6 +3,7 @@ package genre_repo
 import (
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
 )
 
 func (r Repository) IsExistsByName(tx *sqlx.Tx, name string) (exists bool, err error) {
@@ -10,11 +11,11 @@ func (r Repository) IsExistsByName(tx *sqlx.Tx, name string) (exists bool, err e
 		SELECT EXISTS (
 			SELECT 1 
 			FROM genres
-			WHERE name = :name
+			WHERE name_key = :name_key
 		)
 	`
 	args := map[string]interface{}{
-		"name": name,
+		"name_key": model.MatchingKey(name),
 	}
 	row, err := tx.NamedQuery(query, args)
 	if err != nil {
@@ -7,9 +7,11 @@ import (
 
 func (r Repository) IsUsed(tx *sqlx.Tx, genreId int) (used bool, err error) {
 	query := `
-		SELECT COUNT(*)
-		FROM songs
-		WHERE genre_id = :genre_id
+		SELECT (SELECT COUNT(*) FROM songs WHERE genre_id = :genre_id)
+			+ (SELECT COUNT(*) FROM song_genres WHERE genre_id = :genre_id)
+			+ (SELECT COUNT(*) FROM genres WHERE parent_genre_id = :genre_id)
+			+ (SELECT COUNT(*) FROM genre_aliases WHERE genre_id = :genre_id)
+			+ (SELECT COUNT(*) FROM song_splits WHERE kind = 'genre' AND (from_id = :genre_id OR to_id = :genre_id))
 	`
 
 	args := map[string]interface{}{
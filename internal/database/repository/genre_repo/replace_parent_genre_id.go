@@ -0,0 +1,27 @@
+package genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReplaceParentGenreId places the subgenres of fromGenreId under toGenreId
+func (r Repository) ReplaceParentGenreId(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error) {
+	query := `
+		UPDATE genres
+		SET parent_genre_id = :to_genre_id
+		WHERE parent_genre_id = :from_genre_id
+	`
+	args := map[string]interface{}{
+		"from_genre_id": fromGenreId,
+		"to_genre_id":   toGenreId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Failed to replace parent genre")
+		return err
+	}
+
+	log.Debug().Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Parent genre replaced successfully")
+	return nil
+}
@@ -10,6 +10,9 @@ type Repo interface {
 	Read(tx *sqlx.Tx, genreId int) (genre model.Genre, err error)
 	ReadByName(tx *sqlx.Tx, name string) (genre model.Genre, err error)
 	ReadAll(tx *sqlx.Tx) (genres []model.Genre, err error)
+	UpdateParent(tx *sqlx.Tx, genreId int, parentGenreId *int) (err error)
+	UpdateNameToMostCommon(tx *sqlx.Tx, genreId int) (err error)
+	ReplaceParentGenreId(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error)
 	Delete(tx *sqlx.Tx, genreId int) (err error)
 	IsExists(tx *sqlx.Tx, genreId int) (exists bool, err error)
 	IsExistsByName(tx *sqlx.Tx, name string) (exists bool, err error)
@@ -0,0 +1,41 @@
+package genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// UpdateNameToMostCommon sets the name of the genre to the spelling its songs use most often.
+// The name stays as it is while no song uses the genre
+func (r Repository) UpdateNameToMostCommon(tx *sqlx.Tx, genreId int) (err error) {
+	query := `
+		UPDATE genres
+		SET name = spellings.listed_name
+		FROM (
+			SELECT listed_name
+			FROM song_genres
+			WHERE genre_id = :genre_id
+			GROUP BY listed_name
+			ORDER BY COUNT(*) DESC, listed_name
+			LIMIT 1
+		) AS spellings
+		WHERE genre_id = :genre_id
+	`
+	args := map[string]interface{}{
+		"genre_id": genreId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", genreId).Msg("Failed to update genre name")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", genreId).Msg("Failed to get rows affected after genre update")
+		return err
+	}
+
+	log.Debug().Int("id", genreId).Int64("rowsAffected", rowsAffected).Msg("Genre name updated to the most common spelling successfully")
+	return nil
+}
@@ -0,0 +1,38 @@
+package genre_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) UpdateParent(tx *sqlx.Tx, genreId int, parentGenreId *int) (err error) {
+	query := `
+		UPDATE genres
+		SET parent_genre_id = :parent_genre_id
+		WHERE genre_id = :genre_id
+	`
+	args := map[string]interface{}{
+		"genre_id":        genreId,
+		"parent_genre_id": parentGenreId,
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", genreId).Msg("Failed to update genre parent")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", genreId).Msg("Failed to get rows affected after genre update")
+		return err
+	}
+	if rowsAffected == 0 {
+		err := fmt.Errorf("no rows affected while updating genre")
+		log.Error().Err(err).Int("id", genreId).Msg("No rows affected while updating genre")
+		return err
+	}
+
+	log.Info().Int("id", genreId).Interface("parentGenreId", parentGenreId).Msg("Genre parent updated successfully")
+	return nil
+}
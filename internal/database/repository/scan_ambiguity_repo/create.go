@@ -0,0 +1,36 @@
+package scan_ambiguity_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, scanAmbiguity model.ScanAmbiguity) (scanAmbiguityId int, err error) {
+	query := `
+		INSERT INTO scan_ambiguities(scan_job_id, kind, audio_file_id, song_id, sha_256, description)
+		VALUES (:scan_job_id, :kind, :audio_file_id, :song_id, :sha_256, :description)
+		RETURNING scan_ambiguity_id
+	`
+	rows, err := tx.NamedQuery(query, scanAmbiguity)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanAmbiguity.ScanJobId).Msg("Failed to create scan ambiguity")
+		return 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&scanAmbiguityId); err != nil {
+			log.Error().Err(err).Int("scanJobId", scanAmbiguity.ScanJobId).Msg("Failed to scan id into filed")
+			return 0, err
+		}
+	} else {
+		err := fmt.Errorf("no id returned after scan ambiguity insert")
+		log.Error().Err(err).Int("scanJobId", scanAmbiguity.ScanJobId).Msg("No id returned after scan ambiguity insert")
+		return 0, err
+	}
+
+	log.Debug().Int("id", scanAmbiguityId).Msg("Scan ambiguity created successfully")
+	return scanAmbiguityId, nil
+}
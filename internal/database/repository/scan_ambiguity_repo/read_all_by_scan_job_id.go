@@ -0,0 +1,37 @@
+package scan_ambiguity_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllByScanJobId(tx *sqlx.Tx, scanJobId int) (scanAmbiguities []model.ScanAmbiguity, err error) {
+	query := `
+		SELECT *
+		FROM scan_ambiguities
+		WHERE scan_job_id = :scan_job_id
+		ORDER BY scan_ambiguity_id
+	`
+	args := map[string]interface{}{
+		"scan_job_id": scanJobId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to fetch scan ambiguities")
+		return make([]model.ScanAmbiguity, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var scanAmbiguity model.ScanAmbiguity
+		if err = rows.StructScan(&scanAmbiguity); err != nil {
+			log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to scan scan ambiguity")
+			return make([]model.ScanAmbiguity, 0), err
+		}
+		scanAmbiguities = append(scanAmbiguities, scanAmbiguity)
+	}
+
+	log.Debug().Int("scanJobId", scanJobId).Int("count", len(scanAmbiguities)).Msg("All scan ambiguities by scanJobId fetched successfully")
+	return scanAmbiguities, nil
+}
@@ -0,0 +1,18 @@
+package scan_ambiguity_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, scanAmbiguity model.ScanAmbiguity) (scanAmbiguityId int, err error)
+	ReadAllByScanJobId(tx *sqlx.Tx, scanJobId int) (scanAmbiguities []model.ScanAmbiguity, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
@@ -0,0 +1,32 @@
+package scan_diff_repo
+
+import (
+	"encoding/json"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, scanJobId int, scanDiff model.ScanDiff) (err error) {
+	query := `
+		INSERT INTO scan_diffs(scan_job_id, diff)
+		VALUES (:scan_job_id, :diff)
+	`
+	diff, err := json.Marshal(scanDiff)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to serialize scan diff")
+		return err
+	}
+	args := map[string]interface{}{
+		"scan_job_id": scanJobId,
+		"diff":        string(diff),
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to create scan diff")
+		return err
+	}
+
+	log.Debug().Int("scanJobId", scanJobId).Msg("Scan diff created successfully")
+	return nil
+}
@@ -0,0 +1,47 @@
+package scan_diff_repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Read(tx *sqlx.Tx, scanJobId int) (scanDiff model.ScanDiff, err error) {
+	query := `
+		SELECT diff
+		FROM scan_diffs
+		WHERE scan_job_id = :scan_job_id
+	`
+	args := map[string]interface{}{
+		"scan_job_id": scanJobId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to fetch scan diff")
+		return model.ScanDiff{}, err
+	}
+	defer rows.Close()
+
+	var diff []byte
+	if rows.Next() {
+		if err := rows.Scan(&diff); err != nil {
+			log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to scan scan diff")
+			return model.ScanDiff{}, err
+		}
+	} else {
+		err := fmt.Errorf("no scan diff found with scan_job_id: %d", scanJobId)
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("No scan diff found")
+		return model.ScanDiff{}, err
+	}
+
+	err = json.Unmarshal(diff, &scanDiff)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to deserialize scan diff")
+		return model.ScanDiff{}, err
+	}
+
+	log.Debug().Int("scanJobId", scanJobId).Msg("Scan diff fetched successfully")
+	return scanDiff, nil
+}
@@ -0,0 +1,19 @@
+package scan_diff_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, scanJobId int, scanDiff model.ScanDiff) (err error)
+	Read(tx *sqlx.Tx, scanJobId int) (scanDiff model.ScanDiff, err error)
+	IsExists(tx *sqlx.Tx, scanJobId int) (exists bool, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
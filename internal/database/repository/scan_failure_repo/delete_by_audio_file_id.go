@@ -0,0 +1,24 @@
+package scan_failure_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) DeleteByAudioFileId(tx *sqlx.Tx, audioFileId int) (err error) {
+	query := `
+		DELETE FROM scan_failures
+		WHERE audio_file_id = :audio_file_id
+	`
+	args := map[string]interface{}{
+		"audio_file_id": audioFileId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to delete scan failure")
+		return err
+	}
+
+	log.Debug().Int("audioFileId", audioFileId).Msg("Scan failure deleted successfully")
+	return nil
+}
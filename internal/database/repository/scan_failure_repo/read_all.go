@@ -0,0 +1,23 @@
+package scan_failure_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAll(tx *sqlx.Tx) (scanFailures []model.ScanFailure, err error) {
+	query := `
+		SELECT *
+		FROM scan_failures
+		ORDER BY failed_at DESC, scan_failure_id DESC
+	`
+	err = tx.Select(&scanFailures, query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch scan failures")
+		return make([]model.ScanFailure, 0), err
+	}
+
+	log.Debug().Int("count", len(scanFailures)).Msg("All scan failures fetched successfully")
+	return scanFailures, nil
+}
@@ -0,0 +1,19 @@
+package scan_failure_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Save(tx *sqlx.Tx, scanFailure model.ScanFailure) (scanFailureId int, err error)
+	ReadAll(tx *sqlx.Tx) (scanFailures []model.ScanFailure, err error)
+	DeleteByAudioFileId(tx *sqlx.Tx, audioFileId int) (err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
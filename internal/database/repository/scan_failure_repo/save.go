@@ -0,0 +1,40 @@
+package scan_failure_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// Save records the failure of an audio file, replacing its previous failure if there is one
+func (r Repository) Save(tx *sqlx.Tx, scanFailure model.ScanFailure) (scanFailureId int, err error) {
+	query := `
+		INSERT INTO scan_failures(scan_job_id, audio_file_id, phase, error, failed_at)
+		VALUES (:scan_job_id, :audio_file_id, :phase, :error, :failed_at)
+		ON CONFLICT (audio_file_id) DO UPDATE
+		SET scan_job_id = EXCLUDED.scan_job_id, phase = EXCLUDED.phase, error = EXCLUDED.error,
+		    failed_at = EXCLUDED.failed_at
+		RETURNING scan_failure_id
+	`
+	rows, err := tx.NamedQuery(query, scanFailure)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", scanFailure.AudioFileId).Msg("Failed to save scan failure")
+		return 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&scanFailureId); err != nil {
+			log.Error().Err(err).Int("audioFileId", scanFailure.AudioFileId).Msg("Failed to scan id into filed")
+			return 0, err
+		}
+	} else {
+		err := fmt.Errorf("no id returned after scan failure insert")
+		log.Error().Err(err).Int("audioFileId", scanFailure.AudioFileId).Msg("No id returned after scan failure insert")
+		return 0, err
+	}
+
+	log.Debug().Int("id", scanFailureId).Msg("Scan failure saved successfully")
+	return scanFailureId, nil
+}
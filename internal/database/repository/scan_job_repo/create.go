@@ -0,0 +1,38 @@
+package scan_job_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, scanJob model.ScanJob) (scanJobId int, err error) {
+	query := `
+		INSERT INTO scan_jobs(mode, dry_run, status, phase, total_files, processed_files, created_count, removed_count,
+		                      moved_count, changed_count, failed_count, error, started_at, finished_at, content_high_water_mark)
+		VALUES (:mode, :dry_run, :status, :phase, :total_files, :processed_files, :created_count, :removed_count,
+		        :moved_count, :changed_count, :failed_count, :error, :started_at, :finished_at, :content_high_water_mark)
+		RETURNING scan_job_id
+	`
+	rows, err := tx.NamedQuery(query, scanJob)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create scan job")
+		return 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&scanJobId); err != nil {
+			log.Error().Err(err).Msg("Failed to scan id into filed")
+			return 0, err
+		}
+	} else {
+		err := fmt.Errorf("no id returned after scan job insert")
+		log.Error().Err(err).Msg("No id returned after scan job insert")
+		return 0, err
+	}
+
+	log.Debug().Int("id", scanJobId).Msg("Scan job created successfully")
+	return scanJobId, nil
+}
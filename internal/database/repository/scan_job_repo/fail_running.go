@@ -0,0 +1,36 @@
+package scan_job_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"time"
+)
+
+func (r Repository) FailRunning(tx *sqlx.Tx, reason string) (err error) {
+	query := `
+		UPDATE scan_jobs
+		SET status = :failed_status, error = :error, finished_at = :finished_at
+		WHERE status = :running_status
+	`
+	args := map[string]interface{}{
+		"failed_status":  model.ScanJobStatusFailed,
+		"running_status": model.ScanJobStatusRunning,
+		"error":          reason,
+		"finished_at":    time.Now().UTC(),
+	}
+	result, err := tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fail running scan jobs")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get rows affected after failing running scan jobs")
+		return err
+	}
+
+	log.Debug().Int64("count", rowsAffected).Msg("Running scan jobs marked as failed")
+	return nil
+}
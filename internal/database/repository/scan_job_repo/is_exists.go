@@ -0,0 +1,39 @@
+package scan_job_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) IsExists(tx *sqlx.Tx, scanJobId int) (exists bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM scan_jobs
+			WHERE scan_job_id = :scan_job_id
+		)
+	`
+	args := map[string]interface{}{
+		"scan_job_id": scanJobId,
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to execute query to check scan job existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to scan result of scan job existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Int("scanJobId", scanJobId).Msg("Scan job exists")
+	} else {
+		log.Debug().Int("scanJobId", scanJobId).Msg("No scan job found")
+	}
+	return exists, nil
+}
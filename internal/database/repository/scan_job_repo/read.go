@@ -0,0 +1,39 @@
+package scan_job_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Read(tx *sqlx.Tx, scanJobId int) (scanJob model.ScanJob, err error) {
+	query := `
+		SELECT *
+		FROM scan_jobs
+		WHERE scan_job_id = :scan_job_id
+	`
+	args := map[string]interface{}{
+		"scan_job_id": scanJobId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to fetch scan job")
+		return model.ScanJob{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&scanJob); err != nil {
+			log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to scan scan job into struct")
+			return model.ScanJob{}, err
+		}
+	} else {
+		err := fmt.Errorf("no scan job found with scan_job_id: %d", scanJobId)
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("No scan job found")
+		return model.ScanJob{}, err
+	}
+
+	log.Debug().Int("id", scanJob.ScanJobId).Msg("Scan job fetched successfully")
+	return scanJob, nil
+}
@@ -0,0 +1,23 @@
+package scan_job_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAll(tx *sqlx.Tx) (scanJobs []model.ScanJob, err error) {
+	query := `
+		SELECT *
+		FROM scan_jobs
+		ORDER BY scan_job_id DESC
+	`
+	err = tx.Select(&scanJobs, query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch scan jobs")
+		return make([]model.ScanJob, 0), err
+	}
+
+	log.Debug().Int("count", len(scanJobs)).Msg("All scan jobs fetched successfully")
+	return scanJobs, nil
+}
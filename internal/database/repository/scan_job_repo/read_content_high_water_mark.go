@@ -0,0 +1,35 @@
+package scan_job_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"time"
+)
+
+func (r Repository) ReadContentHighWaterMark(tx *sqlx.Tx) (highWaterMark *time.Time, err error) {
+	query := `
+		SELECT MAX(content_high_water_mark)
+		FROM scan_jobs
+		WHERE status = :status AND NOT dry_run
+	`
+	args := map[string]interface{}{
+		"status": model.ScanJobStatusSucceeded,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch content high-water mark")
+		return nil, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&highWaterMark); err != nil {
+			log.Error().Err(err).Msg("Failed to scan content high-water mark")
+			return nil, err
+		}
+	}
+
+	log.Debug().Interface("highWaterMark", highWaterMark).Msg("Content high-water mark fetched successfully")
+	return highWaterMark, nil
+}
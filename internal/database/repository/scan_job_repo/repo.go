@@ -0,0 +1,24 @@
+package scan_job_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+	"time"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, scanJob model.ScanJob) (scanJobId int, err error)
+	Read(tx *sqlx.Tx, scanJobId int) (scanJob model.ScanJob, err error)
+	ReadAll(tx *sqlx.Tx) (scanJobs []model.ScanJob, err error)
+	Update(tx *sqlx.Tx, scanJobId int, scanJob model.ScanJob) (err error)
+	ReadContentHighWaterMark(tx *sqlx.Tx) (highWaterMark *time.Time, err error)
+	FailRunning(tx *sqlx.Tx, reason string) (err error)
+	IsExists(tx *sqlx.Tx, scanJobId int) (exists bool, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
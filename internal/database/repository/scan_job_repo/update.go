@@ -0,0 +1,39 @@
+package scan_job_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Update(tx *sqlx.Tx, scanJobId int, scanJob model.ScanJob) (err error) {
+	query := `
+		UPDATE scan_jobs
+		SET mode = :mode, dry_run = :dry_run, status = :status, phase = :phase, total_files = :total_files, processed_files = :processed_files,
+		    created_count = :created_count, removed_count = :removed_count, moved_count = :moved_count,
+		    changed_count = :changed_count, failed_count = :failed_count, error = :error, started_at = :started_at, finished_at = :finished_at,
+		    content_high_water_mark = :content_high_water_mark
+		WHERE scan_job_id = :scan_job_id
+	`
+	scanJob.ScanJobId = scanJobId
+	result, err := tx.NamedExec(query, scanJob)
+	if err != nil {
+		log.Error().Err(err).Int("id", scanJobId).Msg("Failed to update scan job")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		log.Error().Err(err).Int("id", scanJobId).Msg("Failed to get rows affected after scan job update")
+		return err
+	}
+	if rowsAffected == 0 {
+		err := fmt.Errorf("no rows affected while updating scan job")
+		log.Error().Err(err).Int("id", scanJobId).Msg("No rows affected while updating scan job")
+		return err
+	}
+
+	log.Debug().Int("id", scanJobId).Msg("Scan job updated successfully")
+	return nil
+}
@@ -0,0 +1,34 @@
+package setting_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// Read returns the stored value of the setting, or nil if it was never saved
+func (r Repository) Read(tx *sqlx.Tx, name string) (value *string, err error) {
+	query := `
+		SELECT value
+		FROM settings
+		WHERE name = :name
+	`
+	args := map[string]interface{}{
+		"name": name,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to fetch setting")
+		return nil, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&value); err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to scan setting")
+			return nil, err
+		}
+	}
+
+	log.Debug().Str("name", name).Msg("Setting fetched successfully")
+	return value, nil
+}
@@ -0,0 +1,17 @@
+package setting_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+)
+
+type Repo interface {
+	Read(tx *sqlx.Tx, name string) (value *string, err error)
+	Save(tx *sqlx.Tx, name string, value string) (err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
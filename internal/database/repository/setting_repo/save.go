@@ -0,0 +1,28 @@
+package setting_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// Save stores the value of the setting, replacing the previous one
+func (r Repository) Save(tx *sqlx.Tx, name string, value string) (err error) {
+	query := `
+		INSERT INTO settings(name, value)
+		VALUES (:name, :value)
+		ON CONFLICT (name) DO UPDATE
+		SET value = EXCLUDED.value
+	`
+	args := map[string]interface{}{
+		"name":  name,
+		"value": value,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to save setting")
+		return err
+	}
+
+	log.Debug().Str("name", name).Msg("Setting saved successfully")
+	return nil
+}
@@ -0,0 +1,22 @@
+package song_artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, songArtist model.SongArtist) (err error) {
+	query := `
+		INSERT INTO song_artists(song_id, artist_id, role, position, credited_name)
+		VALUES (:song_id, :artist_id, :role, :position, :credited_name)
+	`
+	_, err = tx.NamedExec(query, songArtist)
+	if err != nil {
+		log.Error().Err(err).Interface("songArtist", songArtist).Msg("Failed to create song artist")
+		return err
+	}
+
+	log.Debug().Interface("songArtist", songArtist).Msg("Song artist created successfully")
+	return nil
+}
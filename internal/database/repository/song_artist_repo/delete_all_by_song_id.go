@@ -0,0 +1,24 @@
+package song_artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) DeleteAllBySongId(tx *sqlx.Tx, songId int) (err error) {
+	query := `
+		DELETE FROM song_artists
+		WHERE song_id = :song_id
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to delete song artists")
+		return err
+	}
+
+	log.Debug().Int("songId", songId).Msg("Song artists deleted successfully")
+	return nil
+}
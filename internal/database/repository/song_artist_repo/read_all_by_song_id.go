@@ -0,0 +1,38 @@
+package song_artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllBySongId(tx *sqlx.Tx, songId int) (songArtists []model.SongArtist, err error) {
+	query := `
+		SELECT *
+		FROM song_artists
+		WHERE song_id = :song_id
+		ORDER BY position
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to fetch song artists")
+		return make([]model.SongArtist, 0), err
+	}
+	defer rows.Close()
+
+	songArtists = make([]model.SongArtist, 0)
+	for rows.Next() {
+		var songArtist model.SongArtist
+		if err = rows.StructScan(&songArtist); err != nil {
+			log.Error().Err(err).Int("songId", songId).Msg("Failed to scan song artist")
+			return make([]model.SongArtist, 0), err
+		}
+		songArtists = append(songArtists, songArtist)
+	}
+
+	log.Debug().Int("songId", songId).Int("count", len(songArtists)).Msg("Song artists fetched successfully")
+	return songArtists, nil
+}
@@ -0,0 +1,19 @@
+package song_artist_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, songArtist model.SongArtist) (err error)
+	ReadAllBySongId(tx *sqlx.Tx, songId int) (songArtists []model.SongArtist, err error)
+	DeleteAllBySongId(tx *sqlx.Tx, songId int) (err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
@@ -0,0 +1,30 @@
+package song_genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// CopyGenre adds the genre toGenreId to every song that has the genre fromGenreId, at the same position.
+// The copies are listed under the name of toGenreId
+func (r Repository) CopyGenre(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error) {
+	query := `
+		INSERT INTO song_genres(song_id, genre_id, position, listed_name)
+		SELECT song_id, :to_genre_id, position, (SELECT name FROM genres WHERE genre_id = :to_genre_id)
+		FROM song_genres
+		WHERE genre_id = :from_genre_id
+		ON CONFLICT DO NOTHING
+	`
+	args := map[string]interface{}{
+		"from_genre_id": fromGenreId,
+		"to_genre_id":   toGenreId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Failed to copy song genres")
+		return err
+	}
+
+	log.Debug().Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Song genres copied successfully")
+	return nil
+}
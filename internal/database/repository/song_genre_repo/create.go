@@ -0,0 +1,22 @@
+package song_genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, songGenre model.SongGenre) (err error) {
+	query := `
+		INSERT INTO song_genres(song_id, genre_id, position, listed_name)
+		VALUES (:song_id, :genre_id, :position, :listed_name)
+	`
+	_, err = tx.NamedExec(query, songGenre)
+	if err != nil {
+		log.Error().Err(err).Interface("songGenre", songGenre).Msg("Failed to create song genre")
+		return err
+	}
+
+	log.Debug().Interface("songGenre", songGenre).Msg("Song genre created successfully")
+	return nil
+}
@@ -0,0 +1,24 @@
+package song_genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) DeleteAllByGenreId(tx *sqlx.Tx, genreId int) (err error) {
+	query := `
+		DELETE FROM song_genres
+		WHERE genre_id = :genre_id
+	`
+	args := map[string]interface{}{
+		"genre_id": genreId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to delete song genres")
+		return err
+	}
+
+	log.Debug().Int("genreId", genreId).Msg("Song genres deleted successfully")
+	return nil
+}
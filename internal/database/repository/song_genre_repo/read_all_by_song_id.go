@@ -0,0 +1,38 @@
+package song_genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllBySongId(tx *sqlx.Tx, songId int) (songGenres []model.SongGenre, err error) {
+	query := `
+		SELECT *
+		FROM song_genres
+		WHERE song_id = :song_id
+		ORDER BY position
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to fetch song genres")
+		return make([]model.SongGenre, 0), err
+	}
+	defer rows.Close()
+
+	songGenres = make([]model.SongGenre, 0)
+	for rows.Next() {
+		var songGenre model.SongGenre
+		if err = rows.StructScan(&songGenre); err != nil {
+			log.Error().Err(err).Int("songId", songId).Msg("Failed to scan song genre")
+			return make([]model.SongGenre, 0), err
+		}
+		songGenres = append(songGenres, songGenre)
+	}
+
+	log.Debug().Int("songId", songId).Int("count", len(songGenres)).Msg("Song genres fetched successfully")
+	return songGenres, nil
+}
@@ -0,0 +1,21 @@
+package song_genre_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, songGenre model.SongGenre) (err error)
+	ReadAllBySongId(tx *sqlx.Tx, songId int) (songGenres []model.SongGenre, err error)
+	DeleteAllBySongId(tx *sqlx.Tx, songId int) (err error)
+	CopyGenre(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error)
+	DeleteAllByGenreId(tx *sqlx.Tx, genreId int) (err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
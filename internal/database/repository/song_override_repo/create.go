@@ -0,0 +1,24 @@
+package song_override_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// Create saves the override, replacing the value of an earlier override of the same field
+func (r Repository) Create(tx *sqlx.Tx, songOverride model.SongOverride) (err error) {
+	query := `
+		INSERT INTO song_overrides(song_id, field, value, tag_value)
+		VALUES (:song_id, :field, :value, :tag_value)
+		ON CONFLICT (song_id, field) DO UPDATE SET value = EXCLUDED.value
+	`
+	_, err = tx.NamedExec(query, songOverride)
+	if err != nil {
+		log.Error().Err(err).Interface("songOverride", songOverride).Msg("Failed to create song override")
+		return err
+	}
+
+	log.Debug().Interface("songOverride", songOverride).Msg("Song override created successfully")
+	return nil
+}
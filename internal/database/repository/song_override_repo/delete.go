@@ -0,0 +1,26 @@
+package song_override_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Delete(tx *sqlx.Tx, songId int, field model.SongOverrideField) (err error) {
+	query := `
+		DELETE FROM song_overrides
+		WHERE song_id = :song_id AND field = :field
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+		"field":   field,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Str("field", string(field)).Msg("Failed to delete song override")
+		return err
+	}
+
+	log.Debug().Int("songId", songId).Str("field", string(field)).Msg("Song override deleted successfully")
+	return nil
+}
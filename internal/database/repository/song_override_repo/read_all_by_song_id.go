@@ -0,0 +1,38 @@
+package song_override_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllBySongId(tx *sqlx.Tx, songId int) (songOverrides []model.SongOverride, err error) {
+	query := `
+		SELECT *
+		FROM song_overrides
+		WHERE song_id = :song_id
+		ORDER BY field
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to fetch song overrides")
+		return make([]model.SongOverride, 0), err
+	}
+	defer rows.Close()
+
+	songOverrides = make([]model.SongOverride, 0)
+	for rows.Next() {
+		var songOverride model.SongOverride
+		if err = rows.StructScan(&songOverride); err != nil {
+			log.Error().Err(err).Int("songId", songId).Msg("Failed to scan song override")
+			return make([]model.SongOverride, 0), err
+		}
+		songOverrides = append(songOverrides, songOverride)
+	}
+
+	log.Debug().Int("songId", songId).Int("count", len(songOverrides)).Msg("Song overrides fetched successfully")
+	return songOverrides, nil
+}
@@ -0,0 +1,20 @@
+package song_override_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, songOverride model.SongOverride) (err error)
+	ReadAllBySongId(tx *sqlx.Tx, songId int) (songOverrides []model.SongOverride, err error)
+	UpdateTagValue(tx *sqlx.Tx, songId int, field model.SongOverrideField, tagValue *string) (err error)
+	Delete(tx *sqlx.Tx, songId int, field model.SongOverrideField) (err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
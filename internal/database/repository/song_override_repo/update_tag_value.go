@@ -0,0 +1,28 @@
+package song_override_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) UpdateTagValue(tx *sqlx.Tx, songId int, field model.SongOverrideField, tagValue *string) (err error) {
+	query := `
+		UPDATE song_overrides
+		SET tag_value = :tag_value
+		WHERE song_id = :song_id AND field = :field
+	`
+	args := map[string]interface{}{
+		"song_id":   songId,
+		"field":     field,
+		"tag_value": tagValue,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Str("field", string(field)).Msg("Failed to update tag value of song override")
+		return err
+	}
+
+	log.Debug().Int("songId", songId).Str("field", string(field)).Msg("Tag value of song override updated successfully")
+	return nil
+}
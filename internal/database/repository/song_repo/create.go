@@ -9,8 +9,14 @@ import (
 
 func (r Repository) Create(tx *sqlx.Tx, song model.Song) (songId int, err error) {
 	const query = `
-		INSERT INTO songs(audio_file_id, title, album_id, artist_id, genre_id, year, song_number, disc_number, lyrics, sha_256)
-		VALUES (:audio_file_id, :title, :album_id, :artist_id, :genre_id, :year, :song_number, :disc_number, :lyrics, :sha_256)
+		INSERT INTO songs(audio_file_id, title, album_id, album_title, artist_id, genre_id, year, song_number, disc_number,
+		                  lyrics, work_id, movement, movement_number, sort_title, sort_key, artist_sort_name,
+		                  album_artist_sort_name, album_sort_title, bpm, isrc, label, catalog_number,
+		                  comment, release_date, original_release_date, original_year, compilation, sha_256, last_content_update)
+		VALUES (:audio_file_id, :title, :album_id, :album_title, :artist_id, :genre_id, :year, :song_number, :disc_number,
+		        :lyrics, :work_id, :movement, :movement_number, :sort_title, :sort_key, :artist_sort_name,
+		        :album_artist_sort_name, :album_sort_title, :bpm, :isrc, :label, :catalog_number,
+		        :comment, :release_date, :original_release_date, :original_year, :compilation, :sha_256, :last_content_update)
 		RETURNING song_id
 	`
 	rows, err := tx.NamedQuery(query, song)
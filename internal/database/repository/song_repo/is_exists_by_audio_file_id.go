@@ -0,0 +1,39 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) IsExistsByAudioFileId(tx *sqlx.Tx, audioFileId int) (exists bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM songs
+			WHERE audio_file_id = :audio_file_id
+		)
+	`
+	args := map[string]interface{}{
+		"audio_file_id": audioFileId,
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to execute query to check song existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to scan result of song existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Int("audioFileId", audioFileId).Msg("Song exists")
+	} else {
+		log.Debug().Int("audioFileId", audioFileId).Msg("No song found")
+	}
+	return exists, nil
+}
@@ -10,7 +10,7 @@ func (r Repository) ReadAllByArtistId(tx *sqlx.Tx, artistId int) (songs []model.
 	query := `
 		SELECT *
 		FROM songs
-		WHERE artist_id = :artist_id
+		WHERE song_id IN (SELECT song_id FROM song_artists WHERE artist_id = :artist_id)
 	`
 	args := map[string]interface{}{
 		"artist_id": artistId,
@@ -0,0 +1,39 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReadAllByComposerId reads the songs the artist is credited as composer on, with the movements of a work
+// kept together
+func (r Repository) ReadAllByComposerId(tx *sqlx.Tx, composerId int) (songs []model.Song, err error) {
+	query := `
+		SELECT *
+		FROM songs
+		WHERE song_id IN (SELECT song_id FROM song_artists WHERE artist_id = :composer_id AND role = 'composer')
+		ORDER BY work_id NULLS LAST, movement_number NULLS LAST, song_id
+	`
+	args := map[string]interface{}{
+		"composer_id": composerId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch song")
+		return make([]model.Song, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var song model.Song
+		if err = rows.StructScan(&song); err != nil {
+			log.Error().Err(err).Msg("Failed to scan song")
+			return make([]model.Song, 0), err
+		}
+		songs = append(songs, song)
+	}
+
+	log.Debug().Int("composerId", composerId).Int("count", len(songs)).Msg("All song by composerId fetched successfully")
+	return songs, nil
+}
@@ -10,7 +10,7 @@ func (r Repository) ReadAllByGenreId(tx *sqlx.Tx, genreId int) (songs []model.So
 	query := `
 		SELECT *
 		FROM songs
-		WHERE genre_id = :genre_id
+		WHERE song_id IN (SELECT song_id FROM song_genres WHERE genre_id = :genre_id)
 	`
 	args := map[string]interface{}{
 		"genre_id": genreId,
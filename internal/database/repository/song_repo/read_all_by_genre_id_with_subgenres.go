@@ -0,0 +1,50 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReadAllByGenreIdWithSubgenres reads the songs of the genre and of all genres below it in the hierarchy
+func (r Repository) ReadAllByGenreIdWithSubgenres(tx *sqlx.Tx, genreId int) (songs []model.Song, err error) {
+	query := `
+		WITH RECURSIVE subgenres AS (
+			SELECT genre_id
+			FROM genres
+			WHERE genre_id = :genre_id
+			UNION
+			SELECT genres.genre_id
+			FROM genres
+			JOIN subgenres ON genres.parent_genre_id = subgenres.genre_id
+		)
+		SELECT *
+		FROM songs
+		WHERE song_id IN (
+			SELECT song_id
+			FROM song_genres
+			WHERE genre_id IN (SELECT genre_id FROM subgenres)
+		)
+	`
+	args := map[string]interface{}{
+		"genre_id": genreId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch song")
+		return make([]model.Song, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var song model.Song
+		if err = rows.StructScan(&song); err != nil {
+			log.Error().Err(err).Msg("Failed to scan song")
+			return make([]model.Song, 0), err
+		}
+		songs = append(songs, song)
+	}
+
+	log.Debug().Int("genreId", genreId).Int("count", len(songs)).Msg("All song by genreId fetched successfully")
+	return songs, nil
+}
@@ -0,0 +1,38 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReadAllByWorkId reads the movements of the work in their order, whatever album they come from
+func (r Repository) ReadAllByWorkId(tx *sqlx.Tx, workId int) (songs []model.Song, err error) {
+	query := `
+		SELECT *
+		FROM songs
+		WHERE work_id = :work_id
+		ORDER BY movement_number NULLS LAST, album_id, disc_number, song_number, song_id
+	`
+	args := map[string]interface{}{
+		"work_id": workId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch song")
+		return make([]model.Song, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var song model.Song
+		if err = rows.StructScan(&song); err != nil {
+			log.Error().Err(err).Msg("Failed to scan song")
+			return make([]model.Song, 0), err
+		}
+		songs = append(songs, song)
+	}
+
+	log.Debug().Int("workId", workId).Int("count", len(songs)).Msg("All song by workId fetched successfully")
+	return songs, nil
+}
@@ -0,0 +1,38 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReadAllPreviousSha256s returns the hashes the songs had before their content changed, as recorded in the
+// audit log, by song id
+func (r Repository) ReadAllPreviousSha256s(tx *sqlx.Tx) (previousSha256s map[int][]string, err error) {
+	query := `
+		SELECT DISTINCT CAST(row_key ->> 'song_id' AS INTEGER) AS song_id, before ->> 'sha_256' AS sha_256
+		FROM audit_entries
+		WHERE table_name = 'songs'
+			AND operation = 'update'
+			AND before ->> 'sha_256' IS DISTINCT FROM after ->> 'sha_256'
+	`
+	rows, err := tx.Queryx(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch previous song hashes")
+		return make(map[int][]string), err
+	}
+	defer rows.Close()
+
+	previousSha256s = make(map[int][]string)
+	for rows.Next() {
+		var songId int
+		var sha256 string
+		if err = rows.Scan(&songId, &sha256); err != nil {
+			log.Error().Err(err).Msg("Failed to scan previous song hash")
+			return make(map[int][]string), err
+		}
+		previousSha256s[songId] = append(previousSha256s[songId], sha256)
+	}
+
+	log.Debug().Int("count", len(previousSha256s)).Msg("Previous song hashes fetched successfully")
+	return previousSha256s, nil
+}
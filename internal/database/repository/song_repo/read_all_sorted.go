@@ -0,0 +1,48 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+// sortColumns are the columns songs are ordered by for every sort field, the last one breaking ties. Songs
+// of an album keep the order of its discs and tracks
+var sortColumns = map[string][]string{
+	model.SortFieldTitle:  {"sort_key", "song_id"},
+	model.SortFieldArtist: {"(SELECT sort_key FROM artists WHERE artist_id = songs.artist_id)", "(SELECT sort_key FROM albums WHERE album_id = songs.album_id)", "album_id", "disc_number", "song_number", "sort_key", "song_id"},
+	model.SortFieldAlbum:  {"(SELECT sort_key FROM albums WHERE album_id = songs.album_id)", "album_id", "disc_number", "song_number", "sort_key", "song_id"},
+}
+
+func (r Repository) ReadAllSorted(tx *sqlx.Tx, order model.SortOrder) (songs []model.Song, err error) {
+	terms := make([]string, 0)
+	for _, column := range sortColumns[order.Field] {
+		if order.Descending {
+			column += " DESC"
+		}
+		terms = append(terms, column+" NULLS LAST")
+	}
+	query := `
+		SELECT *
+		FROM songs
+		ORDER BY ` + strings.Join(terms, ", ")
+	rows, err := tx.Queryx(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch songs")
+		return make([]model.Song, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var song model.Song
+		if err = rows.StructScan(&song); err != nil {
+			log.Error().Err(err).Msg("Failed to scan song")
+			return make([]model.Song, 0), err
+		}
+		songs = append(songs, song)
+	}
+
+	log.Debug().Int("count", len(songs)).Str("sort", order.Field).Msg("All songs fetched successfully")
+	return songs, nil
+}
@@ -0,0 +1,39 @@
+package song_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadByAudioFileId(tx *sqlx.Tx, audioFileId int) (song model.Song, err error) {
+	query := `
+		SELECT *
+		FROM songs
+		WHERE audio_file_id = :audio_file_id
+	`
+	args := map[string]interface{}{
+		"audio_file_id": audioFileId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to fetch song")
+		return model.Song{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&song); err != nil {
+			log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to scan song into struct")
+			return model.Song{}, err
+		}
+	} else {
+		err := fmt.Errorf("no song found with audio_file_id: %d", audioFileId)
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("No song found")
+		return model.Song{}, err
+	}
+
+	log.Debug().Int("id", song.SongId).Msg("Song fetched successfully")
+	return song, nil
+}
@@ -0,0 +1,39 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReadMostCommonAlbumSortTitle returns the album sort tag the songs of the album carry most often. It is
+// nil while none of them has one
+func (r Repository) ReadMostCommonAlbumSortTitle(tx *sqlx.Tx, albumId int) (sortTitle *string, err error) {
+	query := `
+		SELECT album_sort_title
+		FROM songs
+		WHERE album_id = :album_id
+			AND album_sort_title IS NOT NULL
+		GROUP BY album_sort_title
+		ORDER BY COUNT(*) DESC, album_sort_title
+		LIMIT 1
+	`
+	args := map[string]interface{}{
+		"album_id": albumId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to fetch album sort title")
+		return nil, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&sortTitle); err != nil {
+			log.Error().Err(err).Int("albumId", albumId).Msg("Failed to scan album sort title")
+			return nil, err
+		}
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Most common album sort title fetched successfully")
+	return sortTitle, nil
+}
@@ -0,0 +1,48 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReadMostCommonArtistSortName returns the sort name the songs of the artist carry most often, counting the
+// artist sort tags of its songs and the album artist sort tags of the songs on its albums. It is nil while
+// none of them has one
+func (r Repository) ReadMostCommonArtistSortName(tx *sqlx.Tx, artistId int) (sortName *string, err error) {
+	query := `
+		SELECT sort_name
+		FROM (
+			SELECT artist_sort_name AS sort_name
+			FROM songs
+			WHERE artist_id = :artist_id
+			UNION ALL
+			SELECT songs.album_artist_sort_name AS sort_name
+			FROM songs
+			JOIN albums ON albums.album_id = songs.album_id
+			WHERE albums.album_artist_id = :artist_id
+		) AS sort_names
+		WHERE sort_name IS NOT NULL
+		GROUP BY sort_name
+		ORDER BY COUNT(*) DESC, sort_name
+		LIMIT 1
+	`
+	args := map[string]interface{}{
+		"artist_id": artistId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to fetch artist sort name")
+		return nil, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err = rows.Scan(&sortName); err != nil {
+			log.Error().Err(err).Int("artistId", artistId).Msg("Failed to scan artist sort name")
+			return nil, err
+		}
+	}
+
+	log.Debug().Int("artistId", artistId).Msg("Most common artist sort name fetched successfully")
+	return sortName, nil
+}
@@ -0,0 +1,26 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) ReplaceGenreId(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error) {
+	query := `
+		UPDATE songs
+		SET genre_id = :to_genre_id
+		WHERE genre_id = :from_genre_id
+	`
+	args := map[string]interface{}{
+		"from_genre_id": fromGenreId,
+		"to_genre_id":   toGenreId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Failed to replace genre of songs")
+		return err
+	}
+
+	log.Info().Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Genre of songs replaced successfully")
+	return nil
+}
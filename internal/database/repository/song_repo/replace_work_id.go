@@ -0,0 +1,26 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) ReplaceWorkId(tx *sqlx.Tx, fromWorkId int, toWorkId int) (err error) {
+	query := `
+		UPDATE songs
+		SET work_id = :to_work_id
+		WHERE work_id = :from_work_id
+	`
+	args := map[string]interface{}{
+		"from_work_id": fromWorkId,
+		"to_work_id":   toWorkId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("fromWorkId", fromWorkId).Int("toWorkId", toWorkId).Msg("Failed to replace work of songs")
+		return err
+	}
+
+	log.Info().Int("fromWorkId", fromWorkId).Int("toWorkId", toWorkId).Msg("Work of songs replaced successfully")
+	return nil
+}
@@ -8,14 +8,27 @@ import (
 type Repo interface {
 	Create(tx *sqlx.Tx, song model.Song) (songId int, err error)
 	Read(tx *sqlx.Tx, songId int) (song model.Song, err error)
+	ReadByAudioFileId(tx *sqlx.Tx, audioFileId int) (song model.Song, err error)
 	ReadAll(tx *sqlx.Tx) (dirs []model.Song, err error)
+	ReadAllSorted(tx *sqlx.Tx, order model.SortOrder) (songs []model.Song, err error)
+	ReadAllPreviousSha256s(tx *sqlx.Tx) (previousSha256s map[int][]string, err error)
 	ReadAllByAlbumId(tx *sqlx.Tx, albumId int) (songs []model.Song, err error)
 	ReadAllByArtistId(tx *sqlx.Tx, artistId int) (songs []model.Song, err error)
+	ReadAllByMainArtistId(tx *sqlx.Tx, artistId int) (songs []model.Song, err error)
 	ReadAllByGenreId(tx *sqlx.Tx, genreId int) (songs []model.Song, err error)
+	ReadAllByGenreIdWithSubgenres(tx *sqlx.Tx, genreId int) (songs []model.Song, err error)
+	ReadAllByComposerId(tx *sqlx.Tx, composerId int) (songs []model.Song, err error)
+	ReadAllByWorkId(tx *sqlx.Tx, workId int) (songs []model.Song, err error)
+	ReadMostCommonArtistSortName(tx *sqlx.Tx, artistId int) (sortName *string, err error)
+	ReadMostCommonAlbumSortTitle(tx *sqlx.Tx, albumId int) (sortTitle *string, err error)
 	Update(tx *sqlx.Tx, songId int, song model.Song) (err error)
 	UpdateAudioFileId(tx *sqlx.Tx, songId int, audioFileId int) (err error)
+	UpdateSortKey(tx *sqlx.Tx, songId int, sortKey *string) (err error)
+	ReplaceGenreId(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error)
+	ReplaceWorkId(tx *sqlx.Tx, fromWorkId int, toWorkId int) (err error)
 	Delete(tx *sqlx.Tx, songId int) (err error)
 	IsExists(tx *sqlx.Tx, songId int) (exists bool, err error)
+	IsExistsByAudioFileId(tx *sqlx.Tx, audioFileId int) (exists bool, err error)
 }
 
 type Repository struct {
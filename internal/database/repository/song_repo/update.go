@@ -10,9 +10,14 @@ import (
 func (r Repository) Update(tx *sqlx.Tx, songId int, song model.Song) (err error) {
 	query := `
 		UPDATE songs
-		SET audio_file_id = :audio_file_id, title = :title, album_id = :album_id, artist_id = :artist_id,
+		SET audio_file_id = :audio_file_id, title = :title, album_id = :album_id, album_title = :album_title, artist_id = :artist_id,
 		    genre_id = :genre_id, year = :year, song_number = :song_number, disc_number = :disc_number,
-		    lyrics = :lyrics, sha_256 = :sha_256
+		    lyrics = :lyrics, work_id = :work_id, movement = :movement, movement_number = :movement_number,
+		    sort_title = :sort_title, sort_key = :sort_key, artist_sort_name = :artist_sort_name,
+		    album_artist_sort_name = :album_artist_sort_name, album_sort_title = :album_sort_title, bpm = :bpm, isrc = :isrc, label = :label,
+		    catalog_number = :catalog_number, comment = :comment, release_date = :release_date,
+		    original_release_date = :original_release_date, original_year = :original_year, compilation = :compilation,
+		    sha_256 = :sha_256, last_content_update = :last_content_update
 		WHERE song_id = :song_id
 	`
 	song.SongId = songId
@@ -0,0 +1,26 @@
+package song_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) UpdateSortKey(tx *sqlx.Tx, songId int, sortKey *string) (err error) {
+	query := `
+		UPDATE songs
+		SET sort_key = :sort_key
+		WHERE song_id = :song_id
+	`
+	args := map[string]interface{}{
+		"song_id":  songId,
+		"sort_key": sortKey,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", songId).Msg("Failed to update song sort key")
+		return err
+	}
+
+	log.Debug().Int("id", songId).Msg("Song sort key updated successfully")
+	return nil
+}
@@ -0,0 +1,24 @@
+package song_split_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// Create saves the split, replacing an earlier split of the song from the same id
+func (r Repository) Create(tx *sqlx.Tx, songSplit model.SongSplit) (err error) {
+	query := `
+		INSERT INTO song_splits(song_id, kind, from_id, to_id)
+		VALUES (:song_id, :kind, :from_id, :to_id)
+		ON CONFLICT (song_id, kind, from_id) DO UPDATE SET to_id = EXCLUDED.to_id
+	`
+	_, err = tx.NamedExec(query, songSplit)
+	if err != nil {
+		log.Error().Err(err).Interface("songSplit", songSplit).Msg("Failed to create song split")
+		return err
+	}
+
+	log.Debug().Interface("songSplit", songSplit).Msg("Song split created successfully")
+	return nil
+}
@@ -0,0 +1,37 @@
+package song_split_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllBySongId(tx *sqlx.Tx, songId int) (songSplits []model.SongSplit, err error) {
+	query := `
+		SELECT *
+		FROM song_splits
+		WHERE song_id = :song_id
+	`
+	args := map[string]interface{}{
+		"song_id": songId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to fetch song splits")
+		return make([]model.SongSplit, 0), err
+	}
+	defer rows.Close()
+
+	songSplits = make([]model.SongSplit, 0)
+	for rows.Next() {
+		var songSplit model.SongSplit
+		if err = rows.StructScan(&songSplit); err != nil {
+			log.Error().Err(err).Int("songId", songId).Msg("Failed to scan song split")
+			return make([]model.SongSplit, 0), err
+		}
+		songSplits = append(songSplits, songSplit)
+	}
+
+	log.Debug().Int("songId", songId).Int("count", len(songSplits)).Msg("Song splits fetched successfully")
+	return songSplits, nil
+}
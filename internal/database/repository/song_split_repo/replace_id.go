@@ -0,0 +1,49 @@
+package song_split_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ReplaceId makes the splits of the kind refer to toId instead of fromId, as when fromId is merged into
+// toId. Splits of a song from fromId give way to its splits from toId, and splits ending where they
+// start are dropped
+func (r Repository) ReplaceId(tx *sqlx.Tx, kind model.SongSplitKind, fromId int, toId int) (err error) {
+	args := map[string]interface{}{
+		"kind":    kind,
+		"from_id": fromId,
+		"to_id":   toId,
+	}
+
+	queries := []string{`
+		DELETE FROM song_splits
+		USING song_splits AS other
+		WHERE song_splits.kind = :kind
+			AND song_splits.from_id = :from_id
+			AND other.song_id = song_splits.song_id
+			AND other.kind = song_splits.kind
+			AND other.from_id = :to_id
+	`, `
+		UPDATE song_splits
+		SET from_id = :to_id
+		WHERE kind = :kind AND from_id = :from_id
+	`, `
+		UPDATE song_splits
+		SET to_id = :to_id
+		WHERE kind = :kind AND to_id = :from_id
+	`, `
+		DELETE FROM song_splits
+		WHERE kind = :kind AND from_id = to_id
+	`}
+	for _, query := range queries {
+		_, err = tx.NamedExec(query, args)
+		if err != nil {
+			log.Error().Err(err).Str("kind", string(kind)).Int("fromId", fromId).Int("toId", toId).Msg("Failed to replace id of song splits")
+			return err
+		}
+	}
+
+	log.Debug().Str("kind", string(kind)).Int("fromId", fromId).Int("toId", toId).Msg("Id of song splits replaced successfully")
+	return nil
+}
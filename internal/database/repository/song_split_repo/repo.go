@@ -0,0 +1,19 @@
+package song_split_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, songSplit model.SongSplit) (err error)
+	ReadAllBySongId(tx *sqlx.Tx, songId int) (songSplits []model.SongSplit, err error)
+	ReplaceId(tx *sqlx.Tx, kind model.SongSplitKind, fromId int, toId int) (err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
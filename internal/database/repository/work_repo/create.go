@@ -0,0 +1,37 @@
+package work_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Create(tx *sqlx.Tx, work model.Work) (workId int, err error) {
+	query := `
+		INSERT INTO works(title, title_key, composer_id)
+		VALUES (:title, :title_key, :composer_id)
+		RETURNING work_id
+	`
+	work.TitleKey = model.MatchingKey(work.Title)
+	rows, err := tx.NamedQuery(query, work)
+	if err != nil {
+		log.Error().Err(err).Str("title", work.Title).Msg("Failed to create work")
+		return 0, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&workId); err != nil {
+			log.Error().Err(err).Str("title", work.Title).Msg("Failed to scan id into filed")
+			return 0, err
+		}
+	} else {
+		err := fmt.Errorf("no id returned after work insert")
+		log.Error().Err(err).Str("title", work.Title).Msg("No id returned after work insert")
+		return 0, err
+	}
+
+	log.Debug().Int("id", workId).Str("title", work.Title).Msg("Work created successfully")
+	return workId, nil
+}
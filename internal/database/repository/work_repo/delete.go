@@ -0,0 +1,24 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) Delete(tx *sqlx.Tx, workId int) (err error) {
+	query := `
+		DELETE FROM works
+		WHERE work_id = :work_id
+	`
+	args := map[string]interface{}{
+		"work_id": workId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("id", workId).Msg("Failed to delete work")
+		return err
+	}
+
+	log.Debug().Int("id", workId).Msg("Work deleted successfully")
+	return nil
+}
@@ -0,0 +1,14 @@
+package work_repo
+
+import "music-metadata/internal/model"
+
+// identityCondition selects the work with the same title and composer, where a missing composer matches
+// only a missing composer
+func identityCondition(identity model.Work) (condition string, args map[string]interface{}) {
+	condition = `title_key = :title_key
+			AND composer_id IS NOT DISTINCT FROM CAST(:composer_id AS INTEGER)`
+	return condition, map[string]interface{}{
+		"title_key":   model.MatchingKey(identity.Title),
+		"composer_id": identity.ComposerId,
+	}
+}
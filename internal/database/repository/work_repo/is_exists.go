@@ -0,0 +1,39 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) IsExists(tx *sqlx.Tx, workId int) (exists bool, err error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 
+			FROM works
+			WHERE work_id = :work_id
+		)
+	`
+	args := map[string]interface{}{
+		"work_id": workId,
+	}
+	row, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to execute query to check work existence")
+		return false, err
+	}
+	defer row.Close()
+
+	if row.Next() {
+		if err = row.Scan(&exists); err != nil {
+			log.Error().Err(err).Int("workId", workId).Msg("Failed to scan result of work existence check")
+			return false, err
+		}
+	}
+
+	if exists {
+		log.Debug().Int("workId", workId).Msg("Work exists")
+	} else {
+		log.Debug().Int("workId", workId).Msg("No work found")
+	}
+	return exists, nil
+}
@@ -0,0 +1,33 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) IsUsed(tx *sqlx.Tx, workId int) (used bool, err error) {
+	query := `
+		SELECT COUNT(*) FROM songs WHERE work_id = :work_id
+	`
+
+	args := map[string]interface{}{
+		"work_id": workId,
+	}
+
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to check if work is used")
+		return false, err
+	}
+	defer rows.Close()
+
+	var count int
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			log.Error().Err(err).Msg("Failed to scan count of songs for the work")
+			return false, err
+		}
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,39 @@
+package work_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) Read(tx *sqlx.Tx, workId int) (work model.Work, err error) {
+	query := `
+		SELECT *
+		FROM works
+		WHERE work_id = :work_id
+	`
+	args := map[string]interface{}{
+		"work_id": workId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to fetch work")
+		return model.Work{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&work); err != nil {
+			log.Error().Err(err).Int("workId", workId).Msg("Failed to scan work into struct")
+			return model.Work{}, err
+		}
+	} else {
+		err := fmt.Errorf("no work found with work_id: %d", workId)
+		log.Error().Err(err).Int("workId", workId).Msg("No work found")
+		return model.Work{}, err
+	}
+
+	log.Debug().Int("id", work.WorkId).Msg("Work fetched successfully")
+	return work, nil
+}
@@ -0,0 +1,32 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAll(tx *sqlx.Tx) (works []model.Work, err error) {
+	query := `
+		SELECT *
+		FROM works
+	`
+	rows, err := tx.Queryx(query)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to fetch works")
+		return make([]model.Work, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var work model.Work
+		if err = rows.StructScan(&work); err != nil {
+			log.Error().Err(err).Msg("Failed to scan works data")
+			return make([]model.Work, 0), err
+		}
+		works = append(works, work)
+	}
+
+	log.Debug().Int("count", len(works)).Msg("All works fetched successfully")
+	return works, nil
+}
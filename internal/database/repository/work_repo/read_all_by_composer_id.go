@@ -0,0 +1,36 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadAllByComposerId(tx *sqlx.Tx, composerId int) (works []model.Work, err error) {
+	query := `
+		SELECT *
+		FROM works
+		WHERE composer_id = :composer_id
+	`
+	args := map[string]interface{}{
+		"composer_id": composerId,
+	}
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("composerId", composerId).Msg("Failed to fetch works")
+		return make([]model.Work, 0), err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var work model.Work
+		if err = rows.StructScan(&work); err != nil {
+			log.Error().Err(err).Msg("Failed to scan work")
+			return make([]model.Work, 0), err
+		}
+		works = append(works, work)
+	}
+
+	log.Debug().Int("composerId", composerId).Int("count", len(works)).Msg("All works by composerId fetched successfully")
+	return works, nil
+}
@@ -0,0 +1,36 @@
+package work_repo
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (r Repository) ReadByIdentity(tx *sqlx.Tx, identity model.Work) (work model.Work, err error) {
+	condition, args := identityCondition(identity)
+	query := `
+		SELECT *
+		FROM works
+		WHERE ` + condition
+	rows, err := tx.NamedQuery(query, args)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to fetch work")
+		return model.Work{}, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.StructScan(&work); err != nil {
+			log.Error().Err(err).Interface("identity", identity).Msg("Failed to scan work into struct")
+			return model.Work{}, err
+		}
+	} else {
+		err := fmt.Errorf("no work found with title: %s", identity.Title)
+		log.Error().Err(err).Interface("identity", identity).Msg("No work found")
+		return model.Work{}, err
+	}
+
+	log.Debug().Int("id", work.WorkId).Msg("Work fetched by identity successfully")
+	return work, nil
+}
@@ -0,0 +1,26 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"music-metadata/internal/model"
+)
+
+type Repo interface {
+	Create(tx *sqlx.Tx, work model.Work) (workId int, err error)
+	Read(tx *sqlx.Tx, workId int) (work model.Work, err error)
+	ReadByIdentity(tx *sqlx.Tx, identity model.Work) (work model.Work, err error)
+	ReadAll(tx *sqlx.Tx) (works []model.Work, err error)
+	ReadAllByComposerId(tx *sqlx.Tx, composerId int) (works []model.Work, err error)
+	UpdateComposerId(tx *sqlx.Tx, workId int, composerId *int) (err error)
+	Delete(tx *sqlx.Tx, workId int) (err error)
+	IsExists(tx *sqlx.Tx, workId int) (exists bool, err error)
+	IsExistsByIdentity(tx *sqlx.Tx, identity model.Work) (exists bool, err error)
+	IsUsed(tx *sqlx.Tx, workId int) (used bool, err error)
+}
+
+type Repository struct {
+}
+
+func NewRepository() Repo {
+	return &Repository{}
+}
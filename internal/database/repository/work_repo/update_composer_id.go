@@ -0,0 +1,26 @@
+package work_repo
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (r Repository) UpdateComposerId(tx *sqlx.Tx, workId int, composerId *int) (err error) {
+	query := `
+		UPDATE works
+		SET composer_id = :composer_id
+		WHERE work_id = :work_id
+	`
+	args := map[string]interface{}{
+		"work_id":     workId,
+		"composer_id": composerId,
+	}
+	_, err = tx.NamedExec(query, args)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to update composer of work")
+		return err
+	}
+
+	log.Debug().Int("workId", workId).Msg("Composer of work updated successfully")
+	return nil
+}
@@ -0,0 +1,9 @@
+package errors
+
+type Conflict struct {
+	Message string
+}
+
+func (e Conflict) Error() string {
+	return e.Message
+}
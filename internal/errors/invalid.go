@@ -0,0 +1,9 @@
+package errors
+
+type Invalid struct {
+	Message string
+}
+
+func (e Invalid) Error() string {
+	return e.Message
+}
@@ -18,6 +18,18 @@ type getResponse struct {
 	AlbumId int `json:"albumId"`
 	// Title of the album.
 	Title string `json:"title"`
+	// Identifier of the album artist, if known.
+	AlbumArtistId *int `json:"albumArtistId"`
+	// Release year of the album, if known.
+	Year *int `json:"year"`
+	// MusicBrainz release identifier, if the files carry one.
+	MusicBrainzReleaseId *string `json:"musicBrainzReleaseId"`
+	// Whether the album is a compilation of various artists.
+	Compilation bool `json:"compilation"`
+	// Release date most songs of the album carry as YYYY-MM-DD, if known.
+	ReleaseDate *string `json:"releaseDate"`
+	// Record label most songs of the album carry, if known.
+	Label *string `json:"label"`
 }
 
 // Get retrieves detailed information about an album.
@@ -74,7 +86,13 @@ func (h *Handler) Get(c *gin.Context) {
 
 	log.Debug().Msg("Albums got successfully")
 	c.JSON(http.StatusOK, getResponse{
-		AlbumId: album.AlbumId,
-		Title:   album.Title,
+		AlbumId:              album.AlbumId,
+		Title:                album.Title,
+		AlbumArtistId:        album.AlbumArtistId,
+		Year:                 album.Year,
+		MusicBrainzReleaseId: album.MusicBrainzReleaseId,
+		Compilation:          album.Compilation,
+		ReleaseDate:          response.Date(album.ReleaseDate),
+		Label:                album.Label,
 	})
 }
@@ -1,6 +1,7 @@
 package album_handler
 
 import (
+	"music-metadata/internal/errors"
 	"music-metadata/internal/handlers/response"
 	"music-metadata/internal/model"
 	"net/http"
@@ -16,6 +17,20 @@ type getAllResponseItem struct {
 	AlbumId int `json:"albumId"`
 	// Title of the album.
 	Title string `json:"title"`
+	// Title the album is sorted by, if the files carry one.
+	SortTitle *string `json:"sortTitle"`
+	// Identifier of the album artist, if known.
+	AlbumArtistId *int `json:"albumArtistId"`
+	// Release year of the album, if known.
+	Year *int `json:"year"`
+	// MusicBrainz release identifier, if the files carry one.
+	MusicBrainzReleaseId *string `json:"musicBrainzReleaseId"`
+	// Whether the album is a compilation of various artists.
+	Compilation bool `json:"compilation"`
+	// Release date most songs of the album carry as YYYY-MM-DD, if known.
+	ReleaseDate *string `json:"releaseDate"`
+	// Record label most songs of the album carry, if known.
+	Label *string `json:"label"`
 }
 
 // getAllResponse represents the response model for GetAllAlbums API.
@@ -26,21 +41,32 @@ type getAllResponse struct {
 
 // GetAll retrieves a list of all albums with optional best covers.
 // @Summary Retrieve all albums
-// @Description Retrieves a list of all albums, including their best covers if requested.
+// @Description Retrieves a list of all albums, including their best covers if requested. Albums of different artists or years may share a title.
 // @Tags Albums
 // @Accept  json
 // @Produce  json
 // @Param   bestCovers   query   int     false       "Number of best covers for each album to retrieve"
+// @Param   title        query   string  false       "Return only albums with this title"
+// @Param   sort         query   string  false       "Sort by title, artist or year, in reverse with a leading minus, like -year. Ignored with title"
 // @Success 200 {object} getAllResponse "Success response with a list of albums and optional best covers for each"
-// @Failure 400 {object} response.Error "Invalid bestCovers format"
+// @Failure 400 {object} response.Error "Invalid bestCovers format or sort"
 // @Failure 500 {object} response.Error "Internal Server Error"
 // @Router /albums [get]
 func (h *Handler) GetAll(c *gin.Context) {
 	log.Debug().Msg("Getting albums")
 
+	title, byTitle := c.GetQuery("title")
+	sort, sorted := c.GetQuery("sort")
+
 	var albums []model.Album
 	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
-		albums, err = h.AlbumService.GetAll(tx)
+		if byTitle {
+			albums, err = h.AlbumService.GetAllByTitle(tx, title)
+		} else if sorted {
+			albums, err = h.AlbumService.GetAllSorted(tx, model.ParseSortOrder(sort))
+		} else {
+			albums, err = h.AlbumService.GetAll(tx)
+		}
 		if err != nil {
 			return err
 		}
@@ -48,18 +74,32 @@ func (h *Handler) GetAll(c *gin.Context) {
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get albums")
-		c.JSON(http.StatusInternalServerError, response.Error{
-			Message: "Failed to get albums",
-			Reason:  err.Error(),
-		})
+		if _, ok := err.(errors.Invalid); ok {
+			c.JSON(http.StatusBadRequest, response.Error{
+				Message: "Invalid sort",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get albums",
+				Reason:  err.Error(),
+			})
+		}
 		return
 	}
 
 	albumsResponseItems := make([]getAllResponseItem, len(albums))
 	for i, album := range albums {
 		albumsResponseItems[i] = getAllResponseItem{
-			AlbumId: album.AlbumId,
-			Title:   album.Title,
+			AlbumId:              album.AlbumId,
+			Title:                album.Title,
+			SortTitle:            album.SortTitle,
+			AlbumArtistId:        album.AlbumArtistId,
+			Year:                 album.Year,
+			MusicBrainzReleaseId: album.MusicBrainzReleaseId,
+			Compilation:          album.Compilation,
+			ReleaseDate:          response.Date(album.ReleaseDate),
+			Label:                album.Label,
 		}
 	}
 
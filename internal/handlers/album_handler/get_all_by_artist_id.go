@@ -0,0 +1,116 @@
+package album_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getAllByArtistIdResponseItem represents a single album item in the GetAlbumsByArtistId API response.
+type getAllByArtistIdResponseItem struct {
+	// Unique identifier for the album.
+	AlbumId int `json:"albumId"`
+	// Title of the album.
+	Title string `json:"title"`
+	// Identifier of the album artist, if known.
+	AlbumArtistId *int `json:"albumArtistId"`
+	// Release year of the album, if known.
+	Year *int `json:"year"`
+	// MusicBrainz release identifier, if the files carry one.
+	MusicBrainzReleaseId *string `json:"musicBrainzReleaseId"`
+	// Whether the album is a compilation of various artists.
+	Compilation bool `json:"compilation"`
+	// Release date most songs of the album carry as YYYY-MM-DD, if known.
+	ReleaseDate *string `json:"releaseDate"`
+	// Record label most songs of the album carry, if known.
+	Label *string `json:"label"`
+}
+
+// getAllByArtistIdResponse represents the response model for GetAlbumsByArtistId API.
+type getAllByArtistIdResponse struct {
+	// Albums where the artist is the album artist.
+	Albums []getAllByArtistIdResponseItem `json:"albums"`
+	// Albums of other album artists, including compilations, where the artist only appears on some songs.
+	Appearances []getAllByArtistIdResponseItem `json:"appearances"`
+}
+
+// GetAllByArtistId retrieves the albums of a specific artist.
+// @Summary Retrieve albums by artist ID
+// @Description Retrieves the albums where the artist is the album artist and, separately, the albums where the artist only appears on some songs.
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   artistId   path   int     true   "Unique identifier of the artist"
+// @Success 200 {object} getAllByArtistIdResponse "Successful response with the albums of the requested artist"
+// @Failure 400 {object} response.Error "Invalid artistId format"
+// @Failure 404 {object} response.Error "Artist not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /artists/{artistId}/albums [get]
+func (h *Handler) GetAllByArtistId(c *gin.Context) {
+	log.Debug().Msg("Getting albums by artist")
+
+	artistIdStr := c.Param("artistId")
+	artistId, err := strconv.Atoi(artistIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("artistIdStr", artistIdStr).Msg("Invalid artistId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid artistId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("artistId", artistId).Msg("Url parameter read successfully")
+
+	var albums, appearances []model.Album
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		albums, appearances, err = h.AlbumService.GetAllByArtistId(tx, artistId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get albums by artist")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Artist not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get albums by artist",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Msg("Albums got successfully")
+	c.JSON(http.StatusOK, getAllByArtistIdResponse{
+		Albums:      toGetAllByArtistIdResponseItems(albums),
+		Appearances: toGetAllByArtistIdResponseItems(appearances),
+	})
+}
+
+func toGetAllByArtistIdResponseItems(albums []model.Album) (items []getAllByArtistIdResponseItem) {
+	items = make([]getAllByArtistIdResponseItem, len(albums))
+	for i, album := range albums {
+		items[i] = getAllByArtistIdResponseItem{
+			AlbumId:              album.AlbumId,
+			Title:                album.Title,
+			AlbumArtistId:        album.AlbumArtistId,
+			Year:                 album.Year,
+			MusicBrainzReleaseId: album.MusicBrainzReleaseId,
+			Compilation:          album.Compilation,
+			ReleaseDate:          response.Date(album.ReleaseDate),
+			Label:                album.Label,
+		}
+	}
+	return items
+}
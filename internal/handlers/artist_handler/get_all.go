@@ -1,6 +1,7 @@
 package artist_handler
 
 import (
+	"music-metadata/internal/errors"
 	"music-metadata/internal/handlers/response"
 	"music-metadata/internal/model"
 	"net/http"
@@ -16,6 +17,8 @@ type getAllResponseItem struct {
 	ArtistId int `json:"artistId"`
 	// Name of the artist.
 	Name string `json:"name"`
+	// Name the artist is sorted by, if the files carry one.
+	SortName *string `json:"sortName"`
 }
 
 // getAllResponse represents the response model for GetAllArtists API.
@@ -31,16 +34,23 @@ type getAllResponse struct {
 // @Accept  json
 // @Produce  json
 // @Param   bestCovers   query   int     false       "Number of best covers for each artist to retrieve"
+// @Param   sort         query   string  false       "Sort by name, in reverse with a leading minus: name or -name"
 // @Success 200 {object} getAllResponse "Success response with a list of artists and optional best covers for each"
-// @Failure 400 {object} response.Error "Invalid bestCovers format"
+// @Failure 400 {object} response.Error "Invalid bestCovers format or sort"
 // @Failure 500 {object} response.Error "Internal Server Error"
 // @Router /artists [get]
 func (h *Handler) GetAll(c *gin.Context) {
 	log.Debug().Msg("Getting artists")
 
+	sort, sorted := c.GetQuery("sort")
+
 	var artists []model.Artist
 	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
-		artists, err = h.ArtistService.GetAll(tx)
+		if sorted {
+			artists, err = h.ArtistService.GetAllSorted(tx, model.ParseSortOrder(sort))
+		} else {
+			artists, err = h.ArtistService.GetAll(tx)
+		}
 		if err != nil {
 			return err
 		}
@@ -48,10 +58,17 @@ func (h *Handler) GetAll(c *gin.Context) {
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get artists")
-		c.JSON(http.StatusInternalServerError, response.Error{
-			Message: "Failed to get artists",
-			Reason:  err.Error(),
-		})
+		if _, ok := err.(errors.Invalid); ok {
+			c.JSON(http.StatusBadRequest, response.Error{
+				Message: "Invalid sort",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get artists",
+				Reason:  err.Error(),
+			})
+		}
 		return
 	}
 
@@ -60,6 +77,7 @@ func (h *Handler) GetAll(c *gin.Context) {
 		artistsResponseItems[i] = getAllResponseItem{
 			ArtistId: artist.ArtistId,
 			Name:     artist.Name,
+			SortName: artist.SortName,
 		}
 	}
 
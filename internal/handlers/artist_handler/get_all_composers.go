@@ -0,0 +1,60 @@
+package artist_handler
+
+import (
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getAllComposersResponse represents the response model for GetAllComposers API.
+type getAllComposersResponse struct {
+	// Array of artists credited as composers.
+	Composers []getAllResponseItem `json:"composers"`
+}
+
+// GetAllComposers retrieves the artists credited as composers.
+// @Summary Retrieve all composers
+// @Description Retrieves the artists credited as composer on at least one song or work.
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} getAllComposersResponse "Success response with a list of composers"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /composers [get]
+func (h *Handler) GetAllComposers(c *gin.Context) {
+	log.Debug().Msg("Getting composers")
+
+	var artists []model.Artist
+	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		artists, err = h.ArtistService.GetAllComposers(tx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get composers")
+		c.JSON(http.StatusInternalServerError, response.Error{
+			Message: "Failed to get composers",
+			Reason:  err.Error(),
+		})
+		return
+	}
+
+	composersResponseItems := make([]getAllResponseItem, len(artists))
+	for i, artist := range artists {
+		composersResponseItems[i] = getAllResponseItem{
+			ArtistId: artist.ArtistId,
+			Name:     artist.Name,
+		}
+	}
+
+	log.Debug().Msg("Composers got successfully")
+	c.JSON(http.StatusOK, getAllComposersResponse{
+		Composers: composersResponseItems,
+	})
+}
@@ -0,0 +1,122 @@
+package audit_handler
+
+import (
+	"encoding/json"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// changeSetResponseEntry represents a single changed row of a change set.
+type changeSetResponseEntry struct {
+	// Name of the table the row belongs to.
+	Table string `json:"table"`
+	// Change made to the row: insert, update or delete.
+	Operation string `json:"operation"`
+	// Primary key columns of the row.
+	RowKey json.RawMessage `json:"rowKey" swaggertype:"object"`
+	// Row before the change, null for inserted rows.
+	Before *json.RawMessage `json:"before" swaggertype:"object"`
+	// Row after the change, null for deleted rows.
+	After *json.RawMessage `json:"after" swaggertype:"object"`
+}
+
+// changeSetResponse represents a change set in the audit API responses.
+type changeSetResponse struct {
+	// Unique identifier of the change set.
+	ChangeSetId int `json:"changeSetId"`
+	// Source of the change: scan, event, edit, merge, revert or unknown.
+	Source string `json:"source"`
+	// Identifier of the change set undone by this one, if it is a revert.
+	RevertedChangeSetId *int `json:"revertedChangeSetId"`
+	// Time when the change was made.
+	CreatedAt time.Time `json:"createdAt"`
+	// Rows changed by the change set.
+	Entries []changeSetResponseEntry `json:"entries"`
+}
+
+// getSongHistoryResponse represents the response model for GetSongHistory API.
+type getSongHistoryResponse struct {
+	// Change sets that changed the song, oldest first.
+	ChangeSets []changeSetResponse `json:"changeSets"`
+}
+
+func newChangeSetResponse(changeSet model.ChangeSet) changeSetResponse {
+	entries := make([]changeSetResponseEntry, 0, len(changeSet.Entries))
+	for _, auditEntry := range changeSet.Entries {
+		entries = append(entries, changeSetResponseEntry{
+			Table:     auditEntry.TableName,
+			Operation: string(auditEntry.Operation),
+			RowKey:    auditEntry.RowKey,
+			Before:    auditEntry.Before,
+			After:     auditEntry.After,
+		})
+	}
+	return changeSetResponse{
+		ChangeSetId:         changeSet.ChangeSetId,
+		Source:              string(changeSet.Source),
+		RevertedChangeSetId: changeSet.RevertedChangeSetId,
+		CreatedAt:           changeSet.CreatedAt,
+		Entries:             entries,
+	}
+}
+
+// GetSongHistory retrieves the audit log of a song.
+// @Summary Retrieve song history
+// @Description Retrieves the change sets that changed the song, its artists, genres, overrides or splits, with the rows before and after each change. Songs removed since keep their history.
+// @Tags Songs
+// @Accept  json
+// @Produce  json
+// @Param   songId   path    int     true        "Song ID"
+// @Success 200 {object} getSongHistoryResponse
+// @Failure 400 {object} response.Error "Invalid songId format"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /songs/{songId}/history [get]
+func (h *Handler) GetSongHistory(c *gin.Context) {
+	log.Debug().Msg("Getting song history")
+
+	songIdStr := c.Param("songId")
+	songId, err := strconv.Atoi(songIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("songIdStr", songIdStr).Msg("Invalid songId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid songId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("songId", songId).Msg("Url parameter read successfully")
+
+	var changeSets []model.ChangeSet
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		changeSets, err = h.AuditService.GetSongHistory(tx, songId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song history")
+		c.JSON(http.StatusInternalServerError, response.Error{
+			Message: "Failed to get song history",
+			Reason:  err.Error(),
+		})
+		return
+	}
+
+	changeSetResponses := make([]changeSetResponse, 0, len(changeSets))
+	for _, changeSet := range changeSets {
+		changeSetResponses = append(changeSetResponses, newChangeSetResponse(changeSet))
+	}
+
+	log.Debug().Int("songId", songId).Msg("Song history got successfully")
+	c.JSON(http.StatusOK, getSongHistoryResponse{
+		ChangeSets: changeSetResponses,
+	})
+}
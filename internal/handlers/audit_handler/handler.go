@@ -0,0 +1,22 @@
+package audit_handler
+
+import (
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/audit_service"
+)
+
+type Handler struct {
+	AuditService       audit_service.Service
+	TransactionManager service.TransactionManager
+}
+
+func NewHandler(auditService audit_service.Service,
+	transactionManager service.TransactionManager,
+) (h *Handler) {
+	h = &Handler{
+		AuditService:       auditService,
+		TransactionManager: transactionManager,
+	}
+
+	return h
+}
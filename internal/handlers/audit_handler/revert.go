@@ -0,0 +1,74 @@
+package audit_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// Revert undoes a change set.
+// @Summary Revert change set
+// @Description Undoes every change of the change set in a new change set, which is returned. A change set can be reverted once and only while the rows it changed were not changed again since.
+// @Tags Changes
+// @Accept  json
+// @Produce  json
+// @Param   changeSetId   path    int     true        "Change set ID"
+// @Success 200 {object} changeSetResponse
+// @Failure 400 {object} response.Error "Invalid changeSetId format"
+// @Failure 404 {object} response.Error "Change set not found"
+// @Failure 409 {object} response.Error "Change set already reverted or changed again since"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /changes/{changeSetId}/revert [post]
+func (h *Handler) Revert(c *gin.Context) {
+	log.Debug().Msg("Reverting change set")
+
+	changeSetIdStr := c.Param("changeSetId")
+	changeSetId, err := strconv.Atoi(changeSetIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("changeSetIdStr", changeSetIdStr).Msg("Invalid changeSetId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid changeSetId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("changeSetId", changeSetId).Msg("Url parameter read successfully")
+
+	var changeSet model.ChangeSet
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		changeSet, err = h.AuditService.Revert(tx, changeSetId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to revert change set")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Change set not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Change set cannot be reverted",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to revert change set",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("changeSetId", changeSetId).Msg("Change set reverted successfully")
+	c.JSON(http.StatusOK, newChangeSetResponse(changeSet))
+}
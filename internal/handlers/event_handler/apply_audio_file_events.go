@@ -0,0 +1,131 @@
+package event_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// applyAudioFileEventsRequestItem represents a single change notification from music-files.
+type applyAudioFileEventsRequestItem struct {
+	// Kind of the change: created, updated, deleted or moved.
+	Type string `json:"type"`
+	// Identifier of the changed audio file.
+	AudioFileId int `json:"audioFileId"`
+	// Identifier the audio file had before the move. Required for moved.
+	PreviousAudioFileId *int `json:"previousAudioFileId"`
+}
+
+// applyAudioFileEventsRequest represents the request model for ApplyAudioFileEvents API.
+type applyAudioFileEventsRequest struct {
+	// Array of change notifications.
+	Events []applyAudioFileEventsRequestItem `json:"events"`
+}
+
+// applyAudioFileEventsResponse represents the response model for ApplyAudioFileEvents API.
+type applyAudioFileEventsResponse struct {
+	// Unique identifier of the scan job that applied the events.
+	ScanJobId int `json:"scanJobId"`
+	// Number of created songs.
+	CreatedCount int `json:"createdCount"`
+	// Number of removed songs.
+	RemovedCount int `json:"removedCount"`
+	// Number of songs whose audio file was moved.
+	MovedCount int `json:"movedCount"`
+	// Number of songs whose content was changed.
+	ChangedCount int `json:"changedCount"`
+	// Number of files skipped because they could not be downloaded, parsed or saved.
+	FailedCount int `json:"failedCount"`
+}
+
+// ApplyAudioFileEvents handles change notifications about audio files.
+// @Summary Apply audio file change notifications
+// @Description Reconciles only the songs of the audio files mentioned in the notifications with their current state in music-files. Sending the same notifications again changes nothing.
+// @Tags Events
+// @Accept  json
+// @Produce  json
+// @Param   request   body   applyAudioFileEventsRequest   true   "Change notifications"
+// @Success 200 {object} applyAudioFileEventsResponse
+// @Failure 400 {object} response.Error "Invalid request body"
+// @Failure 409 {object} response.Error "A scan is running"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /events/audio-files [post]
+func (h *Handler) ApplyAudioFileEvents(c *gin.Context) {
+	log.Debug().Msg("Applying audio file events")
+
+	var request applyAudioFileEventsRequest
+	err := c.ShouldBindJSON(&request)
+	if err == nil {
+		err = validateEvents(request.Events)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("countOfEvents", len(request.Events)).Msg("Request body read successfully")
+
+	audioFileIds := make([]int, 0, len(request.Events))
+	for _, event := range request.Events {
+		audioFileIds = append(audioFileIds, event.AudioFileId)
+		if event.PreviousAudioFileId != nil {
+			audioFileIds = append(audioFileIds, *event.PreviousAudioFileId)
+		}
+	}
+
+	scanJob, err := h.ScanService.ApplyEvents(audioFileIds)
+	if err == nil && scanJob.Status == model.ScanJobStatusFailed {
+		err = fmt.Errorf("scan job %d failed: %s", scanJob.ScanJobId, *scanJob.Error)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to apply audio file events")
+		if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Scan is running",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to apply audio file events",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Msg("Audio file events applied successfully")
+	c.JSON(http.StatusOK, applyAudioFileEventsResponse{
+		ScanJobId:    scanJob.ScanJobId,
+		CreatedCount: scanJob.CreatedCount,
+		RemovedCount: scanJob.RemovedCount,
+		MovedCount:   scanJob.MovedCount,
+		ChangedCount: scanJob.ChangedCount,
+		FailedCount:  scanJob.FailedCount,
+	})
+}
+
+func validateEvents(events []applyAudioFileEventsRequestItem) (err error) {
+	if len(events) == 0 {
+		return fmt.Errorf("no events")
+	}
+	for i, event := range events {
+		switch event.Type {
+		case "created", "updated", "deleted":
+		case "moved":
+			if event.PreviousAudioFileId == nil {
+				return fmt.Errorf("event %d: previousAudioFileId is required for moved", i)
+			}
+		default:
+			return fmt.Errorf("event %d: unknown type: %s", i, event.Type)
+		}
+	}
+	return nil
+}
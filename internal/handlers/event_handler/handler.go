@@ -0,0 +1,22 @@
+package event_handler
+
+import (
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/scan_service"
+)
+
+type Handler struct {
+	ScanService        scan_service.Service
+	TransactionManager service.TransactionManager
+}
+
+func NewHandler(scanService scan_service.Service,
+	transactionManager service.TransactionManager,
+) (h *Handler) {
+	h = &Handler{
+		ScanService:        scanService,
+		TransactionManager: transactionManager,
+	}
+
+	return h
+}
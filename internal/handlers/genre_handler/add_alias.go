@@ -0,0 +1,99 @@
+package genre_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// addAliasRequest represents the request model for AddGenreAlias API.
+type addAliasRequest struct {
+	// Alternative name to resolve to the genre. Matched ignoring case, Unicode form and repeated whitespace.
+	Alias string `json:"alias"`
+}
+
+// AddAlias adds an alias to a genre.
+// @Summary Add genre alias
+// @Description Makes scanned genre names matching the alias (ignoring case, Unicode form and repeated whitespace) resolve to the genre. A genre already scanned under the alias name is merged into the genre, so its songs list the genre right away.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   genreId   path   int               true   "Genre ID"
+// @Param   request   body   addAliasRequest   true   "Alias to add"
+// @Success 204 "Alias added"
+// @Failure 400 {object} response.Error "Invalid genreId format or request body"
+// @Failure 404 {object} response.Error "Genre not found"
+// @Failure 409 {object} response.Error "Alias already exists"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/{genreId}/aliases [post]
+func (h *Handler) AddAlias(c *gin.Context) {
+	log.Debug().Msg("Adding genre alias")
+
+	genreIdStr := c.Param("genreId")
+	genreId, err := strconv.Atoi(genreIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("genreIdStr", genreIdStr).Msg("Invalid genreId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid genreId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("genreId", genreId).Msg("Url parameter read successfully")
+
+	var request addAliasRequest
+	err = c.ShouldBindJSON(&request)
+	request.Alias = strings.TrimSpace(request.Alias)
+	if err == nil && len(request.Alias) == 0 {
+		err = fmt.Errorf("alias is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceEdit)
+		if err != nil {
+			return err
+		}
+		return h.SongService.AddGenreAlias(tx, genreId, request.Alias)
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to add genre alias")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Genre not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Alias already exists",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to add genre alias",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("genreId", genreId).Str("alias", request.Alias).Msg("Genre alias added successfully")
+	c.Status(http.StatusNoContent)
+}
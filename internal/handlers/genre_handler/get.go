@@ -18,6 +18,8 @@ type getResponse struct {
 	GenreId int `json:"genreId"`
 	// Name of the genre.
 	Name string `json:"name"`
+	// Identifier of the parent genre, if the genre is a subgenre.
+	ParentGenreId *int `json:"parentGenreId"`
 }
 
 // Get retrieves detailed information about a genre.
@@ -74,7 +76,8 @@ func (h *Handler) Get(c *gin.Context) {
 
 	log.Debug().Msg("Genres got successfully")
 	c.JSON(http.StatusOK, getResponse{
-		GenreId: genre.GenreId,
-		Name:    genre.Name,
+		GenreId:       genre.GenreId,
+		Name:          genre.Name,
+		ParentGenreId: genre.ParentGenreId,
 	})
 }
@@ -0,0 +1,81 @@
+package genre_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getAliasesResponse represents the response model for GetGenreAliases API.
+type getAliasesResponse struct {
+	// Alternative names that scanned genres resolve to the genre by.
+	Aliases []string `json:"aliases"`
+}
+
+// GetAliases retrieves the aliases of a genre.
+// @Summary Retrieve genre aliases
+// @Description Retrieves the alternative names that scanned genre names are resolved to the genre by.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   genreId   path   int   true   "Genre ID"
+// @Success 200 {object} getAliasesResponse
+// @Failure 400 {object} response.Error "Invalid genreId format"
+// @Failure 404 {object} response.Error "Genre not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/{genreId}/aliases [get]
+func (h *Handler) GetAliases(c *gin.Context) {
+	log.Debug().Msg("Getting genre aliases")
+
+	genreIdStr := c.Param("genreId")
+	genreId, err := strconv.Atoi(genreIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("genreIdStr", genreIdStr).Msg("Invalid genreId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid genreId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("genreId", genreId).Msg("Url parameter read successfully")
+
+	var genreAliases []model.GenreAlias
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		genreAliases, err = h.GenreService.GetAliases(tx, genreId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to get genre aliases")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Genre not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get genre aliases",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	aliases := make([]string, len(genreAliases))
+	for i, genreAlias := range genreAliases {
+		aliases[i] = genreAlias.Alias
+	}
+
+	log.Debug().Int("genreId", genreId).Msg("Genre aliases got successfully")
+	c.JSON(http.StatusOK, getAliasesResponse{
+		Aliases: aliases,
+	})
+}
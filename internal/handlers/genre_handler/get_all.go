@@ -16,6 +16,8 @@ type getAllResponseItem struct {
 	GenreId int `json:"genreId"`
 	// Name of the genre.
 	Name string `json:"name"`
+	// Identifier of the parent genre, if the genre is a subgenre.
+	ParentGenreId *int `json:"parentGenreId"`
 }
 
 // getAllResponse represents the response model for GetAllGenres API.
@@ -58,8 +60,9 @@ func (h *Handler) GetAll(c *gin.Context) {
 	genresResponseItems := make([]getAllResponseItem, len(genres))
 	for i, genre := range genres {
 		genresResponseItems[i] = getAllResponseItem{
-			GenreId: genre.GenreId,
-			Name:    genre.Name,
+			GenreId:       genre.GenreId,
+			Name:          genre.Name,
+			ParentGenreId: genre.ParentGenreId,
 		}
 	}
 
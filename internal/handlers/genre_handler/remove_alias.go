@@ -0,0 +1,70 @@
+package genre_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// RemoveAlias removes an alias from a genre.
+// @Summary Remove genre alias
+// @Description Stops resolving scanned genre names equal to the alias to the genre.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   genreId   path   int      true   "Genre ID"
+// @Param   alias     path   string   true   "Alias to remove"
+// @Success 204 "Alias removed"
+// @Failure 400 {object} response.Error "Invalid genreId format"
+// @Failure 404 {object} response.Error "Alias of the genre not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/{genreId}/aliases/{alias} [delete]
+func (h *Handler) RemoveAlias(c *gin.Context) {
+	log.Debug().Msg("Removing genre alias")
+
+	genreIdStr := c.Param("genreId")
+	genreId, err := strconv.Atoi(genreIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("genreIdStr", genreIdStr).Msg("Invalid genreId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid genreId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	alias := c.Param("alias")
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Url parameters read successfully")
+
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceEdit)
+		if err != nil {
+			return err
+		}
+		return h.GenreService.RemoveAlias(tx, genreId, alias)
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Str("alias", alias).Msg("Failed to remove genre alias")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Alias not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to remove genre alias",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Genre alias removed successfully")
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,93 @@
+package genre_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// setParentRequest represents the request model for SetGenreParent API.
+type setParentRequest struct {
+	// Identifier of the parent genre, or null to make the genre a top-level one.
+	ParentGenreId *int `json:"parentGenreId"`
+}
+
+// SetParent places a genre under another genre.
+// @Summary Set genre parent
+// @Description Places the genre under the parent genre, or at the top of the hierarchy if parentGenreId is null. A genre cannot be placed under itself or one of its subgenres.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   genreId   path   int                true   "Genre ID"
+// @Param   request   body   setParentRequest   true   "Parent genre"
+// @Success 204 "Parent set"
+// @Failure 400 {object} response.Error "Invalid genreId format or request body"
+// @Failure 404 {object} response.Error "Genre or parent genre not found"
+// @Failure 409 {object} response.Error "Genre hierarchy would contain a cycle"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/{genreId}/parent [put]
+func (h *Handler) SetParent(c *gin.Context) {
+	log.Debug().Msg("Setting genre parent")
+
+	genreIdStr := c.Param("genreId")
+	genreId, err := strconv.Atoi(genreIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("genreIdStr", genreIdStr).Msg("Invalid genreId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid genreId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("genreId", genreId).Msg("Url parameter read successfully")
+
+	var request setParentRequest
+	err = c.ShouldBindJSON(&request)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceEdit)
+		if err != nil {
+			return err
+		}
+		return h.GenreService.SetParent(tx, genreId, request.ParentGenreId)
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to set genre parent")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Genre not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Genre hierarchy would contain a cycle",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to set genre parent",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("genreId", genreId).Msg("Genre parent set successfully")
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+// Date formats a date of the response as YYYY-MM-DD
+func Date(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format(time.DateOnly)
+	return &formatted
+}
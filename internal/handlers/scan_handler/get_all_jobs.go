@@ -0,0 +1,111 @@
+package scan_handler
+
+import (
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getAllJobsResponseItem represents a single scan job in the GetAllScanJobs API response.
+type getAllJobsResponseItem struct {
+	// Unique identifier of the scan job.
+	ScanJobId int `json:"scanJobId"`
+	// Mode of the scan: full, incremental, retry, event or targeted.
+	Mode string `json:"mode"`
+	// Whether the scan only reported its changes without applying them.
+	DryRun bool `json:"dryRun"`
+	// Status of the scan job: running, succeeded or failed.
+	Status string `json:"status"`
+	// Current phase of the scan.
+	Phase string `json:"phase"`
+	// Number of files that need processing.
+	TotalFiles int `json:"totalFiles"`
+	// Number of files already processed.
+	ProcessedFiles int `json:"processedFiles"`
+	// Number of created songs.
+	CreatedCount int `json:"createdCount"`
+	// Number of removed songs.
+	RemovedCount int `json:"removedCount"`
+	// Number of songs whose audio file was moved.
+	MovedCount int `json:"movedCount"`
+	// Number of songs whose content was changed.
+	ChangedCount int `json:"changedCount"`
+	// Number of files skipped because they could not be downloaded, parsed or saved.
+	FailedCount int `json:"failedCount"`
+	// Error description if the scan job failed.
+	Error *string `json:"error"`
+	// Time when the scan job was started.
+	StartedAt time.Time `json:"startedAt"`
+	// Time when the scan job was finished.
+	FinishedAt *time.Time `json:"finishedAt"`
+	// Latest content update among the audio files seen by the scan.
+	ContentHighWaterMark *time.Time `json:"contentHighWaterMark"`
+}
+
+// getAllJobsResponse represents the response model for GetAllScanJobs API.
+type getAllJobsResponse struct {
+	// Array of scan jobs, newest first.
+	ScanJobs []getAllJobsResponseItem `json:"scanJobs"`
+}
+
+// GetAllJobs retrieves the history of scan jobs.
+// @Summary Retrieve all scan jobs
+// @Description Retrieves current and past scan jobs, newest first.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} getAllJobsResponse
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/jobs [get]
+func (h *Handler) GetAllJobs(c *gin.Context) {
+	log.Debug().Msg("Getting scan jobs")
+
+	var scanJobs []model.ScanJob
+	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		scanJobs, err = h.ScanService.GetAllJobs(tx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan jobs")
+		c.JSON(http.StatusInternalServerError, response.Error{
+			Message: "Failed to get scan jobs",
+			Reason:  err.Error(),
+		})
+		return
+	}
+
+	scanJobsResponseItems := make([]getAllJobsResponseItem, len(scanJobs))
+	for i, scanJob := range scanJobs {
+		scanJobsResponseItems[i] = getAllJobsResponseItem{
+			ScanJobId:            scanJob.ScanJobId,
+			Mode:                 string(scanJob.Mode),
+			DryRun:               scanJob.DryRun,
+			Status:               string(scanJob.Status),
+			Phase:                string(scanJob.Phase),
+			TotalFiles:           scanJob.TotalFiles,
+			ProcessedFiles:       scanJob.ProcessedFiles,
+			CreatedCount:         scanJob.CreatedCount,
+			RemovedCount:         scanJob.RemovedCount,
+			MovedCount:           scanJob.MovedCount,
+			ChangedCount:         scanJob.ChangedCount,
+			FailedCount:          scanJob.FailedCount,
+			Error:                scanJob.Error,
+			StartedAt:            scanJob.StartedAt,
+			FinishedAt:           scanJob.FinishedAt,
+			ContentHighWaterMark: scanJob.ContentHighWaterMark,
+		}
+	}
+
+	log.Debug().Msg("Scan jobs got successfully")
+	c.JSON(http.StatusOK, getAllJobsResponse{
+		ScanJobs: scanJobsResponseItems,
+	})
+}
@@ -0,0 +1,101 @@
+package scan_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getAmbiguitiesResponseItem represents a single ambiguity in the GetScanAmbiguities API response.
+type getAmbiguitiesResponseItem struct {
+	// Kind of the ambiguity: duplicate_sha256, conflicting_match or id_and_hash_changed.
+	Kind string `json:"kind"`
+	// Identifier of the audio file involved, if any.
+	AudioFileId *int `json:"audioFileId"`
+	// Identifier of the song involved, if any.
+	SongId *int `json:"songId"`
+	// SHA256 hash involved, if any.
+	Sha256 *string `json:"sha256"`
+	// Human-readable description of the ambiguity and how the scan resolved it.
+	Description string `json:"description"`
+}
+
+// getAmbiguitiesResponse represents the response model for GetScanAmbiguities API.
+type getAmbiguitiesResponse struct {
+	// Array of ambiguities found by the scan job.
+	Ambiguities []getAmbiguitiesResponseItem `json:"ambiguities"`
+}
+
+// GetAmbiguities retrieves the audio files and songs a scan job could not match unambiguously.
+// @Summary Retrieve scan ambiguities
+// @Description Retrieves the cases a scan job could not resolve unambiguously: several audio files with the same hash, files matching different songs by id and by hash, and songs that lost both id and hash.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Param   scanJobId   path    int     true        "Scan job ID"
+// @Success 200 {object} getAmbiguitiesResponse
+// @Failure 400 {object} response.Error "Invalid scanJobId format"
+// @Failure 404 {object} response.Error "Scan job not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/jobs/{scanJobId}/ambiguities [get]
+func (h *Handler) GetAmbiguities(c *gin.Context) {
+	log.Debug().Msg("Getting scan ambiguities")
+
+	scanJobIdStr := c.Param("scanJobId")
+	scanJobId, err := strconv.Atoi(scanJobIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("scanJobIdStr", scanJobIdStr).Msg("Invalid scanJobId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid scanJobId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("scanJobId", scanJobId).Msg("Url parameter read successfully")
+
+	var scanAmbiguities []model.ScanAmbiguity
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		scanAmbiguities, err = h.ScanService.GetAmbiguities(tx, scanJobId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan ambiguities")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Scan job not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get scan ambiguities",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	ambiguitiesResponseItems := make([]getAmbiguitiesResponseItem, len(scanAmbiguities))
+	for i, scanAmbiguity := range scanAmbiguities {
+		ambiguitiesResponseItems[i] = getAmbiguitiesResponseItem{
+			Kind:        string(scanAmbiguity.Kind),
+			AudioFileId: scanAmbiguity.AudioFileId,
+			SongId:      scanAmbiguity.SongId,
+			Sha256:      scanAmbiguity.Sha256,
+			Description: scanAmbiguity.Description,
+		}
+	}
+
+	log.Debug().Msg("Scan ambiguities got successfully")
+	c.JSON(http.StatusOK, getAmbiguitiesResponse{
+		Ambiguities: ambiguitiesResponseItems,
+	})
+}
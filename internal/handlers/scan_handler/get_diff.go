@@ -0,0 +1,171 @@
+package scan_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getDiffResponseSong represents a song affected by a dry-run scan.
+type getDiffResponseSong struct {
+	// Unique identifier of the song, absent for songs the scan would create.
+	SongId *int `json:"songId"`
+	// Identifier of the audio file the song would be linked to.
+	AudioFileId int `json:"audioFileId"`
+	// Identifier of the audio file the song was linked to before, only for relinked songs.
+	PreviousAudioFileId *int `json:"previousAudioFileId"`
+	// Title of the song.
+	Title *string `json:"title"`
+	// Identifier of the album. Albums created by the scan have provisional ids.
+	AlbumId *int `json:"albumId"`
+	// Identifier of the artist. Artists created by the scan have provisional ids.
+	ArtistId *int `json:"artistId"`
+	// Identifier of the genre. Genres created by the scan have provisional ids.
+	GenreId *int `json:"genreId"`
+	// Year of the song release.
+	Year *int `json:"year"`
+	// Track number of the song in the album.
+	SongNumber *int `json:"songNumber"`
+	// Disc number of the song in the album.
+	DiscNumber *int `json:"discNumber"`
+	// Lyrics of the song.
+	Lyrics *string `json:"lyrics"`
+	// SHA256 hash of the audio file.
+	Sha256 string `json:"sha256"`
+}
+
+// getDiffResponseItem represents an album, artist or genre created or removed by a dry-run scan.
+type getDiffResponseItem struct {
+	// Identifier of the item, provisional for created items.
+	Id int `json:"id"`
+	// Title of the album or name of the artist or genre.
+	Name string `json:"name"`
+}
+
+// getDiffResponse represents the response model for GetScanDiff API.
+type getDiffResponse struct {
+	// Songs the scan would create.
+	CreatedSongs []getDiffResponseSong `json:"createdSongs"`
+	// Songs the scan would remove.
+	RemovedSongs []getDiffResponseSong `json:"removedSongs"`
+	// Songs the scan would link to another audio file with the same content.
+	RelinkedSongs []getDiffResponseSong `json:"relinkedSongs"`
+	// Songs the scan would re-parse, with their new values.
+	ReparsedSongs []getDiffResponseSong `json:"reparsedSongs"`
+	// Albums the scan would create.
+	CreatedAlbums []getDiffResponseItem `json:"createdAlbums"`
+	// Albums the scan would remove.
+	RemovedAlbums []getDiffResponseItem `json:"removedAlbums"`
+	// Artists the scan would create.
+	CreatedArtists []getDiffResponseItem `json:"createdArtists"`
+	// Artists the scan would remove.
+	RemovedArtists []getDiffResponseItem `json:"removedArtists"`
+	// Genres the scan would create.
+	CreatedGenres []getDiffResponseItem `json:"createdGenres"`
+	// Genres the scan would remove.
+	RemovedGenres []getDiffResponseItem `json:"removedGenres"`
+}
+
+// GetDiff retrieves the changes planned by a dry-run scan job.
+// @Summary Retrieve scan diff
+// @Description Retrieves the songs, albums, artists and genres a dry-run scan job would create, remove, relink or re-parse. Nothing of it is applied to the database.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Param   scanJobId   path    int     true        "Scan job ID"
+// @Success 200 {object} getDiffResponse
+// @Failure 400 {object} response.Error "Invalid scanJobId format"
+// @Failure 404 {object} response.Error "Scan diff not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/jobs/{scanJobId}/diff [get]
+func (h *Handler) GetDiff(c *gin.Context) {
+	log.Debug().Msg("Getting scan diff")
+
+	scanJobIdStr := c.Param("scanJobId")
+	scanJobId, err := strconv.Atoi(scanJobIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("scanJobIdStr", scanJobIdStr).Msg("Invalid scanJobId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid scanJobId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("scanJobId", scanJobId).Msg("Url parameter read successfully")
+
+	var scanDiff model.ScanDiff
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		scanDiff, err = h.ScanService.GetDiff(tx, scanJobId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan diff")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Scan diff not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get scan diff",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Msg("Scan diff got successfully")
+	c.JSON(http.StatusOK, getDiffResponse{
+		CreatedSongs:   toDiffResponseSongs(scanDiff.CreatedSongs),
+		RemovedSongs:   toDiffResponseSongs(scanDiff.RemovedSongs),
+		RelinkedSongs:  toDiffResponseSongs(scanDiff.RelinkedSongs),
+		ReparsedSongs:  toDiffResponseSongs(scanDiff.ReparsedSongs),
+		CreatedAlbums:  toDiffResponseItems(scanDiff.CreatedAlbums),
+		RemovedAlbums:  toDiffResponseItems(scanDiff.RemovedAlbums),
+		CreatedArtists: toDiffResponseItems(scanDiff.CreatedArtists),
+		RemovedArtists: toDiffResponseItems(scanDiff.RemovedArtists),
+		CreatedGenres:  toDiffResponseItems(scanDiff.CreatedGenres),
+		RemovedGenres:  toDiffResponseItems(scanDiff.RemovedGenres),
+	})
+}
+
+func toDiffResponseSongs(songs []model.ScanDiffSong) []getDiffResponseSong {
+	responseSongs := make([]getDiffResponseSong, len(songs))
+	for i, song := range songs {
+		responseSongs[i] = getDiffResponseSong{
+			SongId:              song.SongId,
+			AudioFileId:         song.AudioFileId,
+			PreviousAudioFileId: song.PreviousAudioFileId,
+			Title:               song.Title,
+			AlbumId:             song.AlbumId,
+			ArtistId:            song.ArtistId,
+			GenreId:             song.GenreId,
+			Year:                song.Year,
+			SongNumber:          song.SongNumber,
+			DiscNumber:          song.DiscNumber,
+			Lyrics:              song.Lyrics,
+			Sha256:              song.Sha256,
+		}
+	}
+	return responseSongs
+}
+
+func toDiffResponseItems(items []model.ScanDiffItem) []getDiffResponseItem {
+	responseItems := make([]getDiffResponseItem, len(items))
+	for i, item := range items {
+		responseItems[i] = getDiffResponseItem{
+			Id:   item.Id,
+			Name: item.Name,
+		}
+	}
+	return responseItems
+}
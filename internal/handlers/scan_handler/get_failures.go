@@ -0,0 +1,78 @@
+package scan_handler
+
+import (
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getFailuresResponseItem represents a single quarantined audio file in the GetScanFailures API response.
+type getFailuresResponseItem struct {
+	// Identifier of the scan job in which the audio file failed last.
+	ScanJobId int `json:"scanJobId"`
+	// Identifier of the audio file.
+	AudioFileId int `json:"audioFileId"`
+	// Phase in which the audio file failed: download, parse or persist.
+	Phase string `json:"phase"`
+	// Description of the error.
+	Error string `json:"error"`
+	// Time of the failure.
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// getFailuresResponse represents the response model for GetScanFailures API.
+type getFailuresResponse struct {
+	// Array of quarantined audio files, latest failures first.
+	Failures []getFailuresResponseItem `json:"failures"`
+}
+
+// GetFailures retrieves the audio files that scans could not process.
+// @Summary Retrieve scan failures
+// @Description Retrieves the audio files skipped by scans because they could not be downloaded, parsed or saved. A file leaves the list once a scan processes it successfully or it is deleted.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} getFailuresResponse
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/failures [get]
+func (h *Handler) GetFailures(c *gin.Context) {
+	log.Debug().Msg("Getting scan failures")
+
+	var scanFailures []model.ScanFailure
+	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		scanFailures, err = h.ScanService.GetFailures(tx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan failures")
+		c.JSON(http.StatusInternalServerError, response.Error{
+			Message: "Failed to get scan failures",
+			Reason:  err.Error(),
+		})
+		return
+	}
+
+	failuresResponseItems := make([]getFailuresResponseItem, len(scanFailures))
+	for i, scanFailure := range scanFailures {
+		failuresResponseItems[i] = getFailuresResponseItem{
+			ScanJobId:   scanFailure.ScanJobId,
+			AudioFileId: scanFailure.AudioFileId,
+			Phase:       string(scanFailure.Phase),
+			Error:       scanFailure.Error,
+			FailedAt:    scanFailure.FailedAt,
+		}
+	}
+
+	log.Debug().Msg("Scan failures got successfully")
+	c.JSON(http.StatusOK, getFailuresResponse{
+		Failures: failuresResponseItems,
+	})
+}
@@ -0,0 +1,122 @@
+package scan_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getJobResponse represents the response model for GetScanJob API.
+type getJobResponse struct {
+	// Unique identifier of the scan job.
+	ScanJobId int `json:"scanJobId"`
+	// Mode of the scan: full, incremental, retry, event or targeted.
+	Mode string `json:"mode"`
+	// Whether the scan only reported its changes without applying them.
+	DryRun bool `json:"dryRun"`
+	// Status of the scan job: running, succeeded or failed.
+	Status string `json:"status"`
+	// Current phase of the scan: preparing, creating, removing, moving, changing, cleaning or finished.
+	Phase string `json:"phase"`
+	// Number of files that need processing.
+	TotalFiles int `json:"totalFiles"`
+	// Number of files already processed.
+	ProcessedFiles int `json:"processedFiles"`
+	// Number of created songs.
+	CreatedCount int `json:"createdCount"`
+	// Number of removed songs.
+	RemovedCount int `json:"removedCount"`
+	// Number of songs whose audio file was moved.
+	MovedCount int `json:"movedCount"`
+	// Number of songs whose content was changed.
+	ChangedCount int `json:"changedCount"`
+	// Number of files skipped because they could not be downloaded, parsed or saved.
+	FailedCount int `json:"failedCount"`
+	// Error description if the scan job failed.
+	Error *string `json:"error"`
+	// Time when the scan job was started.
+	StartedAt time.Time `json:"startedAt"`
+	// Time when the scan job was finished.
+	FinishedAt *time.Time `json:"finishedAt"`
+	// Latest content update among the audio files seen by the scan.
+	ContentHighWaterMark *time.Time `json:"contentHighWaterMark"`
+}
+
+// GetJob retrieves the state of a scan job.
+// @Summary Retrieve scan job
+// @Description Retrieves the phase, progress and per-phase counters of a scan job.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Param   scanJobId   path    int     true        "Scan job ID"
+// @Success 200 {object} getJobResponse
+// @Failure 400 {object} response.Error "Invalid scanJobId format"
+// @Failure 404 {object} response.Error "Scan job not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/jobs/{scanJobId} [get]
+func (h *Handler) GetJob(c *gin.Context) {
+	log.Debug().Msg("Getting scan job")
+
+	scanJobIdStr := c.Param("scanJobId")
+	scanJobId, err := strconv.Atoi(scanJobIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("scanJobIdStr", scanJobIdStr).Msg("Invalid scanJobId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid scanJobId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("scanJobId", scanJobId).Msg("Url parameter read successfully")
+
+	var scanJob model.ScanJob
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		scanJob, err = h.ScanService.GetJob(tx, scanJobId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan job")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Scan job not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get scan job",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Msg("Scan job got successfully")
+	c.JSON(http.StatusOK, getJobResponse{
+		ScanJobId:            scanJob.ScanJobId,
+		Mode:                 string(scanJob.Mode),
+		DryRun:               scanJob.DryRun,
+		Status:               string(scanJob.Status),
+		Phase:                string(scanJob.Phase),
+		TotalFiles:           scanJob.TotalFiles,
+		ProcessedFiles:       scanJob.ProcessedFiles,
+		CreatedCount:         scanJob.CreatedCount,
+		RemovedCount:         scanJob.RemovedCount,
+		MovedCount:           scanJob.MovedCount,
+		ChangedCount:         scanJob.ChangedCount,
+		FailedCount:          scanJob.FailedCount,
+		Error:                scanJob.Error,
+		StartedAt:            scanJob.StartedAt,
+		FinishedAt:           scanJob.FinishedAt,
+		ContentHighWaterMark: scanJob.ContentHighWaterMark,
+	})
+}
@@ -0,0 +1,55 @@
+package scan_handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// getScheduleResponse represents the response model for GetScanSchedule API.
+type getScheduleResponse struct {
+	// Whether periodic scans are configured.
+	Enabled bool `json:"enabled"`
+	// Cron expression of the schedule.
+	Expression string `json:"expression"`
+	// Mode of periodic scans: full or incremental.
+	Mode string `json:"mode"`
+	// Time when the schedule last fired since the service started.
+	LastRunAt *time.Time `json:"lastRunAt"`
+	// Identifier of the scan job started by the last run, if it was not skipped.
+	LastScanJobId *int `json:"lastScanJobId"`
+	// Whether the last run was skipped because another scan was still running.
+	LastRunSkipped bool `json:"lastRunSkipped"`
+	// Why the last run failed to start a scan, if it failed.
+	LastRunError *string `json:"lastRunError"`
+	// Time of the next run.
+	NextRunAt *time.Time `json:"nextRunAt"`
+}
+
+// GetSchedule retrieves the state of periodic scans.
+// @Summary Retrieve scan schedule
+// @Description Retrieves the configured schedule of periodic scans together with the last and the next run times.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} getScheduleResponse
+// @Router /scan/schedule [get]
+func (h *Handler) GetSchedule(c *gin.Context) {
+	log.Debug().Msg("Getting scan schedule")
+
+	schedule := h.ScanService.GetSchedule()
+
+	log.Debug().Msg("Scan schedule got successfully")
+	c.JSON(http.StatusOK, getScheduleResponse{
+		Enabled:        schedule.Enabled,
+		Expression:     schedule.Expression,
+		Mode:           string(schedule.Mode),
+		LastRunAt:      schedule.LastRunAt,
+		LastScanJobId:  schedule.LastScanJobId,
+		LastRunSkipped: schedule.LastRunSkipped,
+		LastRunError:   schedule.LastRunError,
+		NextRunAt:      schedule.NextRunAt,
+	})
+}
@@ -0,0 +1,93 @@
+package scan_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/service/scan_service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// rescanRequest represents the request model for Rescan API. Songs selected by any of the fields are rescanned.
+type rescanRequest struct {
+	// Identifiers of songs to rescan.
+	SongIds []int `json:"songIds"`
+	// Identifiers of audio files whose songs to rescan.
+	AudioFileIds []int `json:"audioFileIds"`
+	// Identifier of an album whose songs to rescan.
+	AlbumId *int `json:"albumId"`
+	// Identifier of an artist whose songs to rescan.
+	ArtistId *int `json:"artistId"`
+}
+
+// Rescan handles the request to parse the audio files of chosen songs again.
+// @Summary Rescan chosen songs
+// @Description Starts a background scan job that downloads and parses the audio files of the chosen songs again, even if their content did not change, and then removes albums, artists and genres left without songs.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Param   request   body   rescanRequest   true   "Songs to rescan"
+// @Success 202 {object} startResponse "Scan job started"
+// @Failure 400 {object} response.Error "Invalid request body"
+// @Failure 404 {object} response.Error "Song, album or artist not found"
+// @Failure 409 {object} response.Error "Another scan is already running"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/rescan [post]
+func (h *Handler) Rescan(c *gin.Context) {
+	log.Debug().Msg("Starting rescan")
+
+	var request rescanRequest
+	err := c.ShouldBindJSON(&request)
+	if err == nil && len(request.SongIds) == 0 && len(request.AudioFileIds) == 0 &&
+		request.AlbumId == nil && request.ArtistId == nil {
+		err = fmt.Errorf("no songs selected")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	scanJob, err := h.ScanService.StartRescan(scan_service.RescanTarget{
+		SongIds:      request.SongIds,
+		AudioFileIds: request.AudioFileIds,
+		AlbumId:      request.AlbumId,
+		ArtistId:     request.ArtistId,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to start rescan")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Song, album or artist not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Scan is already running",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to start rescan",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Msg("Rescan started successfully")
+	c.JSON(http.StatusAccepted, startResponse{
+		ScanJobId: scanJob.ScanJobId,
+		Mode:      string(scanJob.Mode),
+		DryRun:    scanJob.DryRun,
+		Status:    string(scanJob.Status),
+		StartedAt: scanJob.StartedAt,
+	})
+}
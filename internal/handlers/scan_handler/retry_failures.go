@@ -0,0 +1,51 @@
+package scan_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// RetryFailures handles the request to process the quarantined audio files again.
+// @Summary Retry failed audio files
+// @Description Starts a background scan job in retry mode, which processes only the audio files listed in scan failures. Files that succeed or no longer exist leave the list.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Success 202 {object} startResponse "Scan job started"
+// @Failure 409 {object} response.Error "Another scan is already running"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan/failures/retry [post]
+func (h *Handler) RetryFailures(c *gin.Context) {
+	log.Debug().Msg("Retrying scan failures")
+
+	scanJob, err := h.ScanService.Start(model.ScanModeRetry, false)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to start retry scan")
+		if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Scan is already running",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to start retry scan",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Msg("Retry scan started successfully")
+	c.JSON(http.StatusAccepted, startResponse{
+		ScanJobId: scanJob.ScanJobId,
+		Mode:      string(scanJob.Mode),
+		DryRun:    scanJob.DryRun,
+		Status:    string(scanJob.Status),
+		StartedAt: scanJob.StartedAt,
+	})
+}
@@ -0,0 +1,93 @@
+package scan_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// startResponse represents the response model for StartScan API.
+type startResponse struct {
+	// Unique identifier of the started scan job.
+	ScanJobId int `json:"scanJobId"`
+	// Mode of the scan: full, incremental, retry, event or targeted.
+	Mode string `json:"mode"`
+	// Whether the scan only reports its changes without applying them.
+	DryRun bool `json:"dryRun"`
+	// Status of the scan job.
+	Status string `json:"status"`
+	// Time when the scan job was started.
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// Start handles the request to initiate a scan for new or updated songs.
+// @Summary Initiate a scan for new or updated songs
+// @Description Starts a background job that scans the system for any new or updated songs and updates the database accordingly. The progress can be tracked by the returned scan job id.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Param   mode   query   string   false   "Scan mode: full (default) or incremental, which skips files not updated since the previous successful scan"
+// @Param   dryRun   query   bool   false   "Only compute the changes and report them as the scan job diff without applying them"
+// @Success 202 {object} startResponse "Scan job started"
+// @Failure 400 {object} response.Error "Invalid mode or dryRun"
+// @Failure 409 {object} response.Error "Another scan is already running"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /scan [post]
+func (h *Handler) Start(c *gin.Context) {
+	log.Debug().Msg("Starting scan")
+
+	mode := model.ScanMode(c.DefaultQuery("mode", string(model.ScanModeFull)))
+	if mode != model.ScanModeFull && mode != model.ScanModeIncremental {
+		err := fmt.Errorf("unknown scan mode: %s", mode)
+		log.Error().Err(err).Str("mode", string(mode)).Msg("Invalid mode")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid mode",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	dryRunStr := c.DefaultQuery("dryRun", "false")
+	dryRun, err := strconv.ParseBool(dryRunStr)
+	if err != nil {
+		log.Error().Err(err).Str("dryRunStr", dryRunStr).Msg("Invalid dryRun format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid dryRun format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Str("mode", string(mode)).Bool("dryRun", dryRun).Msg("Query parameters read successfully")
+
+	scanJob, err := h.ScanService.Start(mode, dryRun)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to start scan")
+		if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Scan is already running",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to start scan",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Msg("Scan started successfully")
+	c.JSON(http.StatusAccepted, startResponse{
+		ScanJobId: scanJob.ScanJobId,
+		Mode:      string(scanJob.Mode),
+		DryRun:    scanJob.DryRun,
+		Status:    string(scanJob.Status),
+		StartedAt: scanJob.StartedAt,
+	})
+}
@@ -0,0 +1,25 @@
+package scan_handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+)
+
+// StopSchedule stops periodic scans.
+// @Summary Stop scan schedule
+// @Description Stops periodic scans until the service restarts. A scan that is already running goes on.
+// @Tags Scan
+// @Accept  json
+// @Produce  json
+// @Success 204
+// @Router /scan/schedule [delete]
+func (h *Handler) StopSchedule(c *gin.Context) {
+	log.Debug().Msg("Stopping scan schedule")
+
+	h.ScanService.StopSchedule()
+
+	log.Debug().Msg("Scan schedule stopped successfully")
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,130 @@
+package song_handler
+
+import (
+	"encoding/json"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/song_service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// bulkUpdateRequest represents the request model for BulkUpdate API.
+type bulkUpdateRequest struct {
+	// Identifiers of the songs to update.
+	SongIds []int `json:"songIds"`
+	// Identifier of the album whose songs to update, instead of songIds.
+	AlbumId *int `json:"albumId"`
+	// Identifier of the artist whose songs to update as their main artist, instead of songIds.
+	ArtistId *int `json:"artistId"`
+	// Identifier of the genre whose songs to update, instead of songIds.
+	GenreId *int `json:"genreId"`
+	// Fields to override on every song, in the same form as in the Update API.
+	Fields updateRequest `json:"fields" swaggertype:"object"`
+}
+
+// bulkUpdateResponseItem represents the result for a single song in the response of the BulkUpdate API.
+type bulkUpdateResponseItem struct {
+	// Identifier of the song.
+	SongId int `json:"songId"`
+	// Whether the song was updated.
+	Updated bool `json:"updated"`
+	// Reason the song was not updated.
+	Reason *string `json:"reason"`
+}
+
+// bulkUpdateResponse represents the response model for BulkUpdate API.
+type bulkUpdateResponse struct {
+	// Results for every selected song.
+	Results []bulkUpdateResponseItem `json:"results"`
+}
+
+// BulkUpdate overrides the metadata of several songs at once.
+// @Summary Override metadata of several songs
+// @Description Applies the same overrides to the listed songs, to all songs of an album or genre, or to the songs an artist is the main artist of, in one transaction. Running scans do not block it. Songs that fail, for example because their file cannot be downloaded, keep their previous overrides and are reported in the results.
+// @Tags Songs
+// @Accept  json
+// @Produce  json
+// @Param   request   body   bulkUpdateRequest   true   "Songs to update and fields to override"
+// @Success 200 {object} bulkUpdateResponse "Results for every selected song"
+// @Failure 400 {object} response.Error "Invalid request body"
+// @Failure 404 {object} response.Error "Song, album, artist or genre not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /songs [patch]
+func (h *Handler) BulkUpdate(c *gin.Context) {
+	log.Debug().Msg("Bulk updating songs")
+
+	var request bulkUpdateRequest
+	err := c.ShouldBindJSON(&request)
+	var overrides []model.SongOverride
+	var resetFields []model.SongOverrideField
+	if err == nil {
+		overrides, resetFields, err = parseOverrides(map[string]json.RawMessage(request.Fields))
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var results []song_service.BulkUpdateResult
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceEdit)
+		if err != nil {
+			return err
+		}
+		results, err = h.SongService.BulkUpdateOverrides(tx, song_service.SongSelection{
+			SongIds:  request.SongIds,
+			AlbumId:  request.AlbumId,
+			ArtistId: request.ArtistId,
+			GenreId:  request.GenreId,
+		}, overrides, resetFields)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to bulk update songs")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Song, album, artist or genre not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Invalid); ok {
+			c.JSON(http.StatusBadRequest, response.Error{
+				Message: "Invalid request body",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to bulk update songs",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	responseItems := make([]bulkUpdateResponseItem, len(results))
+	for i, result := range results {
+		responseItems[i] = bulkUpdateResponseItem{
+			SongId:  result.SongId,
+			Updated: result.Err == nil,
+		}
+		if result.Err != nil {
+			reason := result.Err.Error()
+			responseItems[i].Reason = &reason
+		}
+	}
+
+	log.Debug().Int("countOfSongs", len(results)).Msg("Songs bulk updated successfully")
+	c.JSON(http.StatusOK, bulkUpdateResponse{
+		Results: responseItems,
+	})
+}
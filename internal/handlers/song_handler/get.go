@@ -12,6 +12,24 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// getResponseArtist represents an artist credited on the song in the response of the Get API.
+type getResponseArtist struct {
+	// ArtistId is the identifier of the artist.
+	ArtistId int `json:"artistId"`
+	// Role is the role of the artist on the song: main, featured, remixer, composer or conductor.
+	Role model.SongArtistRole `json:"role"`
+}
+
+// getResponseOverride represents a field of the song set by the user in the response of the Get API.
+type getResponseOverride struct {
+	// Field is the name of the overridden field, like title or year.
+	Field model.SongOverrideField `json:"field"`
+	// Value is the value set by the user, or null if the user cleared the field.
+	Value *string `json:"value"`
+	// TagValue is the value the audio file carries, as of its last scan.
+	TagValue *string `json:"tagValue"`
+}
+
 // getResponse represents a single song item in the response of the Get API.
 type getResponse struct {
 	// SongId is the unique identifier for the song.
@@ -20,6 +38,8 @@ type getResponse struct {
 	AudioFileId int `json:"audioFileId"`
 	// Title is the title of the song.
 	Title *string `json:"title"`
+	// SortTitle is the title the song is sorted by, if the file carries one.
+	SortTitle *string `json:"sortTitle"`
 	// AlbumId is the identifier of the album to which the song belongs.
 	AlbumId *int `json:"albumId"`
 	// ArtistId is the identifier of the song's artist.
@@ -34,8 +54,36 @@ type getResponse struct {
 	DiscNumber *int `json:"discNumber"`
 	// Lyrics are the lyrics of the song.
 	Lyrics *string `json:"lyrics"`
+	// WorkId is the identifier of the work the song is a movement of.
+	WorkId *int `json:"workId"`
+	// Movement is the name of the movement.
+	Movement *string `json:"movement"`
+	// MovementNumber is the number of the movement in the work.
+	MovementNumber *int `json:"movementNumber"`
+	// Bpm is the tempo of the song in beats per minute.
+	Bpm *int `json:"bpm"`
+	// Isrc is the International Standard Recording Code of the song.
+	Isrc *string `json:"isrc"`
+	// Label is the record label that released the song.
+	Label *string `json:"label"`
+	// CatalogNumber is the catalog number of the release given by the label.
+	CatalogNumber *string `json:"catalogNumber"`
+	// Comment is the comment of the file.
+	Comment *string `json:"comment"`
+	// ReleaseDate is the full release date as YYYY-MM-DD, if the tags carry the day.
+	ReleaseDate *string `json:"releaseDate"`
+	// OriginalReleaseDate is the full date of the original release as YYYY-MM-DD.
+	OriginalReleaseDate *string `json:"originalReleaseDate"`
+	// OriginalYear is the year of the original release.
+	OriginalYear *int `json:"originalYear"`
 	// Sha256 is the SHA256 hash of the song file.
 	Sha256 string `json:"sha256"`
+	// Artists are all artists credited on the song, in the order of the tags.
+	Artists []getResponseArtist `json:"artists"`
+	// GenreIds are the identifiers of all genres of the song, in the order of the tags.
+	GenreIds []int `json:"genreIds"`
+	// Overrides are the fields set by the user instead of the tags. The fields above already have them applied.
+	Overrides []getResponseOverride `json:"overrides"`
 }
 
 // Get handles the request to retrieve a specific song by its ID.
@@ -90,17 +138,58 @@ func (h *Handler) Get(c *gin.Context) {
 	}
 
 	log.Debug().Msg("Songs got successfully")
-	c.JSON(http.StatusOK, getResponse{
-		SongId:      song.SongId,
-		AudioFileId: song.AudioFileId,
-		Title:       song.Title,
-		AlbumId:     song.AlbumId,
-		ArtistId:    song.ArtistId,
-		GenreId:     song.GenreId,
-		Year:        song.Year,
-		SongNumber:  song.SongNumber,
-		DiscNumber:  song.DiscNumber,
-		Lyrics:      song.Lyrics,
-		Sha256:      song.Sha256,
-	})
+	c.JSON(http.StatusOK, newGetResponse(song))
+}
+
+func newGetResponse(song model.Song) getResponse {
+	artists := make([]getResponseArtist, len(song.Artists))
+	for i, artist := range song.Artists {
+		artists[i] = getResponseArtist{
+			ArtistId: artist.ArtistId,
+			Role:     artist.Role,
+		}
+	}
+
+	genreIds := make([]int, len(song.Genres))
+	for i, genre := range song.Genres {
+		genreIds[i] = genre.GenreId
+	}
+
+	overrides := make([]getResponseOverride, len(song.Overrides))
+	for i, override := range song.Overrides {
+		overrides[i] = getResponseOverride{
+			Field:    override.Field,
+			Value:    override.Value,
+			TagValue: override.TagValue,
+		}
+	}
+
+	return getResponse{
+		SongId:              song.SongId,
+		AudioFileId:         song.AudioFileId,
+		Title:               song.Title,
+		SortTitle:           song.SortTitle,
+		AlbumId:             song.AlbumId,
+		ArtistId:            song.ArtistId,
+		GenreId:             song.GenreId,
+		Year:                song.Year,
+		SongNumber:          song.SongNumber,
+		DiscNumber:          song.DiscNumber,
+		Lyrics:              song.Lyrics,
+		WorkId:              song.WorkId,
+		Movement:            song.Movement,
+		MovementNumber:      song.MovementNumber,
+		Bpm:                 song.Bpm,
+		Isrc:                song.Isrc,
+		Label:               song.Label,
+		CatalogNumber:       song.CatalogNumber,
+		Comment:             song.Comment,
+		ReleaseDate:         response.Date(song.ReleaseDate),
+		OriginalReleaseDate: response.Date(song.OriginalReleaseDate),
+		OriginalYear:        song.OriginalYear,
+		Sha256:              song.Sha256,
+		Artists:             artists,
+		GenreIds:            genreIds,
+		Overrides:           overrides,
+	}
 }
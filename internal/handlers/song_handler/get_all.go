@@ -1,6 +1,7 @@
 package song_handler
 
 import (
+	"music-metadata/internal/errors"
 	"music-metadata/internal/handlers/response"
 	"music-metadata/internal/model"
 	"net/http"
@@ -18,6 +19,8 @@ type getAllResponseItem struct {
 	AudioFileId int `json:"audioFileId"`
 	// Title is the title of the song.
 	Title *string `json:"title"`
+	// SortTitle is the title the song is sorted by, if the file carries one.
+	SortTitle *string `json:"sortTitle"`
 	// AlbumId is the identifier of the album to which the song belongs.
 	AlbumId *int `json:"albumId"`
 	// ArtistId is the identifier of the song's artist.
@@ -32,6 +35,28 @@ type getAllResponseItem struct {
 	DiscNumber *int `json:"discNumber"`
 	// Lyrics are the lyrics of the song.
 	Lyrics *string `json:"lyrics"`
+	// WorkId is the identifier of the work the song is a movement of.
+	WorkId *int `json:"workId"`
+	// Movement is the name of the movement.
+	Movement *string `json:"movement"`
+	// MovementNumber is the number of the movement in the work.
+	MovementNumber *int `json:"movementNumber"`
+	// Bpm is the tempo of the song in beats per minute.
+	Bpm *int `json:"bpm"`
+	// Isrc is the International Standard Recording Code of the song.
+	Isrc *string `json:"isrc"`
+	// Label is the record label that released the song.
+	Label *string `json:"label"`
+	// CatalogNumber is the catalog number of the release given by the label.
+	CatalogNumber *string `json:"catalogNumber"`
+	// Comment is the comment of the file.
+	Comment *string `json:"comment"`
+	// ReleaseDate is the full release date as YYYY-MM-DD, if the tags carry the day.
+	ReleaseDate *string `json:"releaseDate"`
+	// OriginalReleaseDate is the full date of the original release as YYYY-MM-DD.
+	OriginalReleaseDate *string `json:"originalReleaseDate"`
+	// OriginalYear is the year of the original release.
+	OriginalYear *int `json:"originalYear"`
 	// Sha256 is the SHA256 hash of the song file.
 	Sha256 string `json:"sha256"`
 }
@@ -48,15 +73,23 @@ type getAllResponse struct {
 // @Tags Songs
 // @Accept  json
 // @Produce  json
+// @Param   sort   query   string  false  "Sort by title, artist or album, in reverse with a leading minus, like -title"
 // @Success 200 {array} getAllResponseItem "Successful response with list of songs"
+// @Failure 400 {object} response.Error "Invalid sort"
 // @Failure 500 {object} response.Error "Internal Server Error"
 // @Router /songs [get]
 func (h *Handler) GetAll(c *gin.Context) {
 	log.Debug().Msg("Getting songs")
 
+	sort, sorted := c.GetQuery("sort")
+
 	var songs []model.Song
 	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
-		songs, err = h.SongService.GetAll(tx)
+		if sorted {
+			songs, err = h.SongService.GetAllSorted(tx, model.ParseSortOrder(sort))
+		} else {
+			songs, err = h.SongService.GetAll(tx)
+		}
 		if err != nil {
 			return err
 		}
@@ -64,27 +97,46 @@ func (h *Handler) GetAll(c *gin.Context) {
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get songs")
-		c.JSON(http.StatusInternalServerError, response.Error{
-			Message: "Failed to get songs",
-			Reason:  err.Error(),
-		})
+		if _, ok := err.(errors.Invalid); ok {
+			c.JSON(http.StatusBadRequest, response.Error{
+				Message: "Invalid sort",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get songs",
+				Reason:  err.Error(),
+			})
+		}
 		return
 	}
 
 	songsResponseItems := make([]getAllResponseItem, len(songs))
 	for i, song := range songs {
 		songsResponseItems[i] = getAllResponseItem{
-			SongId:      song.SongId,
-			AudioFileId: song.AudioFileId,
-			Title:       song.Title,
-			AlbumId:     song.AlbumId,
-			ArtistId:    song.ArtistId,
-			GenreId:     song.GenreId,
-			Year:        song.Year,
-			SongNumber:  song.SongNumber,
-			DiscNumber:  song.DiscNumber,
-			Lyrics:      song.Lyrics,
-			Sha256:      song.Sha256,
+			SongId:              song.SongId,
+			AudioFileId:         song.AudioFileId,
+			Title:               song.Title,
+			SortTitle:           song.SortTitle,
+			AlbumId:             song.AlbumId,
+			ArtistId:            song.ArtistId,
+			GenreId:             song.GenreId,
+			Year:                song.Year,
+			SongNumber:          song.SongNumber,
+			DiscNumber:          song.DiscNumber,
+			Lyrics:              song.Lyrics,
+			WorkId:              song.WorkId,
+			Movement:            song.Movement,
+			MovementNumber:      song.MovementNumber,
+			Bpm:                 song.Bpm,
+			Isrc:                song.Isrc,
+			Label:               song.Label,
+			CatalogNumber:       song.CatalogNumber,
+			Comment:             song.Comment,
+			ReleaseDate:         response.Date(song.ReleaseDate),
+			OriginalReleaseDate: response.Date(song.OriginalReleaseDate),
+			OriginalYear:        song.OriginalYear,
+			Sha256:              song.Sha256,
 		}
 	}
 
@@ -34,6 +34,28 @@ type getByAlbumIdResponseItem struct {
 	DiscNumber *int `json:"discNumber"`
 	// Lyrics of the song.
 	Lyrics *string `json:"lyrics"`
+	// Identifier of the work the song is a movement of.
+	WorkId *int `json:"workId"`
+	// Name of the movement.
+	Movement *string `json:"movement"`
+	// Number of the movement in the work.
+	MovementNumber *int `json:"movementNumber"`
+	// Tempo of the song in beats per minute.
+	Bpm *int `json:"bpm"`
+	// International Standard Recording Code of the song.
+	Isrc *string `json:"isrc"`
+	// Record label that released the song.
+	Label *string `json:"label"`
+	// Catalog number of the release given by the label.
+	CatalogNumber *string `json:"catalogNumber"`
+	// Comment of the file.
+	Comment *string `json:"comment"`
+	// Full release date as YYYY-MM-DD, if the tags carry the day.
+	ReleaseDate *string `json:"releaseDate"`
+	// Full date of the original release as YYYY-MM-DD.
+	OriginalReleaseDate *string `json:"originalReleaseDate"`
+	// Year of the original release.
+	OriginalYear *int `json:"originalYear"`
 	// SHA256 hash of the song file.
 	Sha256 string `json:"sha256"`
 }
@@ -98,17 +120,28 @@ func (h *Handler) GetByAlbumId(c *gin.Context) {
 	songsResponseItems := make([]getByAlbumIdResponseItem, len(songs))
 	for i, song := range songs {
 		songsResponseItems[i] = getByAlbumIdResponseItem{
-			SongId:      song.SongId,
-			AudioFileId: song.AudioFileId,
-			Title:       song.Title,
-			AlbumId:     song.AlbumId,
-			ArtistId:    song.ArtistId,
-			GenreId:     song.GenreId,
-			Year:        song.Year,
-			SongNumber:  song.SongNumber,
-			DiscNumber:  song.DiscNumber,
-			Lyrics:      song.Lyrics,
-			Sha256:      song.Sha256,
+			SongId:              song.SongId,
+			AudioFileId:         song.AudioFileId,
+			Title:               song.Title,
+			AlbumId:             song.AlbumId,
+			ArtistId:            song.ArtistId,
+			GenreId:             song.GenreId,
+			Year:                song.Year,
+			SongNumber:          song.SongNumber,
+			DiscNumber:          song.DiscNumber,
+			Lyrics:              song.Lyrics,
+			WorkId:              song.WorkId,
+			Movement:            song.Movement,
+			MovementNumber:      song.MovementNumber,
+			Bpm:                 song.Bpm,
+			Isrc:                song.Isrc,
+			Label:               song.Label,
+			CatalogNumber:       song.CatalogNumber,
+			Comment:             song.Comment,
+			ReleaseDate:         response.Date(song.ReleaseDate),
+			OriginalReleaseDate: response.Date(song.OriginalReleaseDate),
+			OriginalYear:        song.OriginalYear,
+			Sha256:              song.Sha256,
 		}
 	}
 
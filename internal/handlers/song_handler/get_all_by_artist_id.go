@@ -22,7 +22,7 @@ type getByArtistIdResponseItem struct {
 	Title *string `json:"title"`
 	// Identifier of the album to which the song belongs.
 	AlbumId *int `json:"albumId"`
-	// Identifier of the artist of the song.
+	// Identifier of the main artist of the song.
 	ArtistId *int `json:"artistId"`
 	// Genre identifier of the song.
 	GenreId *int `json:"genreId"`
@@ -34,6 +34,28 @@ type getByArtistIdResponseItem struct {
 	DiscNumber *int `json:"discNumber"`
 	// Lyrics of the song.
 	Lyrics *string `json:"lyrics"`
+	// Identifier of the work the song is a movement of.
+	WorkId *int `json:"workId"`
+	// Name of the movement.
+	Movement *string `json:"movement"`
+	// Number of the movement in the work.
+	MovementNumber *int `json:"movementNumber"`
+	// Tempo of the song in beats per minute.
+	Bpm *int `json:"bpm"`
+	// International Standard Recording Code of the song.
+	Isrc *string `json:"isrc"`
+	// Record label that released the song.
+	Label *string `json:"label"`
+	// Catalog number of the release given by the label.
+	CatalogNumber *string `json:"catalogNumber"`
+	// Comment of the file.
+	Comment *string `json:"comment"`
+	// Full release date as YYYY-MM-DD, if the tags carry the day.
+	ReleaseDate *string `json:"releaseDate"`
+	// Full date of the original release as YYYY-MM-DD.
+	OriginalReleaseDate *string `json:"originalReleaseDate"`
+	// Year of the original release.
+	OriginalYear *int `json:"originalYear"`
 	// SHA256 hash of the song file.
 	Sha256 string `json:"sha256"`
 }
@@ -46,7 +68,7 @@ type getByArtistIdResponse struct {
 
 // GetByArtistId retrieves a list of songs associated with a specific artist.
 // @Summary Retrieve songs by artist ID
-// @Description Retrieves all songs that are part of the specified artist, including detailed information about each song.
+// @Description Retrieves all songs the specified artist is credited on as a main, featured or remixing artist, including detailed information about each song.
 // @Tags Songs
 // @Accept  json
 // @Produce  json
@@ -98,17 +120,28 @@ func (h *Handler) GetByArtistId(c *gin.Context) {
 	songsResponseItems := make([]getByArtistIdResponseItem, len(songs))
 	for i, song := range songs {
 		songsResponseItems[i] = getByArtistIdResponseItem{
-			SongId:      song.SongId,
-			AudioFileId: song.AudioFileId,
-			Title:       song.Title,
-			AlbumId:     song.AlbumId,
-			ArtistId:    song.ArtistId,
-			GenreId:     song.GenreId,
-			Year:        song.Year,
-			SongNumber:  song.SongNumber,
-			DiscNumber:  song.DiscNumber,
-			Lyrics:      song.Lyrics,
-			Sha256:      song.Sha256,
+			SongId:              song.SongId,
+			AudioFileId:         song.AudioFileId,
+			Title:               song.Title,
+			AlbumId:             song.AlbumId,
+			ArtistId:            song.ArtistId,
+			GenreId:             song.GenreId,
+			Year:                song.Year,
+			SongNumber:          song.SongNumber,
+			DiscNumber:          song.DiscNumber,
+			Lyrics:              song.Lyrics,
+			WorkId:              song.WorkId,
+			Movement:            song.Movement,
+			MovementNumber:      song.MovementNumber,
+			Bpm:                 song.Bpm,
+			Isrc:                song.Isrc,
+			Label:               song.Label,
+			CatalogNumber:       song.CatalogNumber,
+			Comment:             song.Comment,
+			ReleaseDate:         response.Date(song.ReleaseDate),
+			OriginalReleaseDate: response.Date(song.OriginalReleaseDate),
+			OriginalYear:        song.OriginalYear,
+			Sha256:              song.Sha256,
 		}
 	}
 
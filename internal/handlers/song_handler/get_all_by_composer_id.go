@@ -0,0 +1,152 @@
+package song_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getByComposerIdResponseItem represents a single song item in the GetSongsByComposerId API response.
+type getByComposerIdResponseItem struct {
+	// Unique identifier for the song.
+	SongId int `json:"songId"`
+	// Identifier for the associated audio file.
+	AudioFileId int `json:"audioFileId"`
+	// Title of the song.
+	Title *string `json:"title"`
+	// Identifier of the album to which the song belongs.
+	AlbumId *int `json:"albumId"`
+	// Identifier of the main artist of the song.
+	ArtistId *int `json:"artistId"`
+	// Genre identifier of the song.
+	GenreId *int `json:"genreId"`
+	// Release year of the song.
+	Year *int `json:"year"`
+	// Track number of the song in the album.
+	SongNumber *int `json:"songNumber"`
+	// Disc number of the song in the album.
+	DiscNumber *int `json:"discNumber"`
+	// Lyrics of the song.
+	Lyrics *string `json:"lyrics"`
+	// Identifier of the work the song is a movement of.
+	WorkId *int `json:"workId"`
+	// Name of the movement.
+	Movement *string `json:"movement"`
+	// Number of the movement in the work.
+	MovementNumber *int `json:"movementNumber"`
+	// Tempo of the song in beats per minute.
+	Bpm *int `json:"bpm"`
+	// International Standard Recording Code of the song.
+	Isrc *string `json:"isrc"`
+	// Record label that released the song.
+	Label *string `json:"label"`
+	// Catalog number of the release given by the label.
+	CatalogNumber *string `json:"catalogNumber"`
+	// Comment of the file.
+	Comment *string `json:"comment"`
+	// Full release date as YYYY-MM-DD, if the tags carry the day.
+	ReleaseDate *string `json:"releaseDate"`
+	// Full date of the original release as YYYY-MM-DD.
+	OriginalReleaseDate *string `json:"originalReleaseDate"`
+	// Year of the original release.
+	OriginalYear *int `json:"originalYear"`
+	// SHA256 hash of the song file.
+	Sha256 string `json:"sha256"`
+}
+
+// getByComposerIdResponse represents the response model for GetSongsByComposerId API.
+type getByComposerIdResponse struct {
+	// Array of songs composed by a specific artist.
+	Songs []getByComposerIdResponseItem `json:"songs"`
+}
+
+// GetByComposerId retrieves a list of songs composed by a specific artist.
+// @Summary Retrieve songs by composer ID
+// @Description Retrieves all songs the specified artist is credited on as a composer, with the movements of each work kept together.
+// @Tags Songs
+// @Accept  json
+// @Produce  json
+// @Param   artistId   path   int     true   "Unique identifier of the artist"
+// @Success 200 {object} getByComposerIdResponse "Successful response with a list of songs composed by the requested artist"
+// @Failure 400 {object} response.Error "Invalid artistId format"
+// @Failure 404 {object} response.Error "Artist not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /composers/{artistId}/songs [get]
+func (h *Handler) GetByComposerId(c *gin.Context) {
+	log.Debug().Msg("Getting songs by composer")
+
+	artistIdStr := c.Param("artistId")
+	artistId, err := strconv.Atoi(artistIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("artistIdStr", artistIdStr).Msg("Invalid artistId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid artistId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("artistId", artistId).Msg("Url parameter read successfully")
+
+	var songs []model.Song
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		songs, err = h.SongService.GetAllByComposerId(tx, artistId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warn().Err(err).Int("artistId", artistId).Msg("Failed to get songs by composer")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Artist not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get songs by composer",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	songsResponseItems := make([]getByComposerIdResponseItem, len(songs))
+	for i, song := range songs {
+		songsResponseItems[i] = getByComposerIdResponseItem{
+			SongId:              song.SongId,
+			AudioFileId:         song.AudioFileId,
+			Title:               song.Title,
+			AlbumId:             song.AlbumId,
+			ArtistId:            song.ArtistId,
+			GenreId:             song.GenreId,
+			Year:                song.Year,
+			SongNumber:          song.SongNumber,
+			DiscNumber:          song.DiscNumber,
+			Lyrics:              song.Lyrics,
+			WorkId:              song.WorkId,
+			Movement:            song.Movement,
+			MovementNumber:      song.MovementNumber,
+			Bpm:                 song.Bpm,
+			Isrc:                song.Isrc,
+			Label:               song.Label,
+			CatalogNumber:       song.CatalogNumber,
+			Comment:             song.Comment,
+			ReleaseDate:         response.Date(song.ReleaseDate),
+			OriginalReleaseDate: response.Date(song.OriginalReleaseDate),
+			OriginalYear:        song.OriginalYear,
+			Sha256:              song.Sha256,
+		}
+	}
+
+	log.Debug().Msg("Songs got successfully")
+	c.JSON(http.StatusOK, getByComposerIdResponse{
+		Songs: songsResponseItems,
+	})
+}
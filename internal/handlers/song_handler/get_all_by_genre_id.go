@@ -24,7 +24,7 @@ type getByGenreIdResponseItem struct {
 	AlbumId *int `json:"albumId"`
 	// Identifier of the artist of the song.
 	ArtistId *int `json:"artistId"`
-	// Genre identifier of the song.
+	// Identifier of the first genre of the song.
 	GenreId *int `json:"genreId"`
 	// Release year of the song.
 	Year *int `json:"year"`
@@ -34,6 +34,28 @@ type getByGenreIdResponseItem struct {
 	DiscNumber *int `json:"discNumber"`
 	// Lyrics of the song.
 	Lyrics *string `json:"lyrics"`
+	// Identifier of the work the song is a movement of.
+	WorkId *int `json:"workId"`
+	// Name of the movement.
+	Movement *string `json:"movement"`
+	// Number of the movement in the work.
+	MovementNumber *int `json:"movementNumber"`
+	// Tempo of the song in beats per minute.
+	Bpm *int `json:"bpm"`
+	// International Standard Recording Code of the song.
+	Isrc *string `json:"isrc"`
+	// Record label that released the song.
+	Label *string `json:"label"`
+	// Catalog number of the release given by the label.
+	CatalogNumber *string `json:"catalogNumber"`
+	// Comment of the file.
+	Comment *string `json:"comment"`
+	// Full release date as YYYY-MM-DD, if the tags carry the day.
+	ReleaseDate *string `json:"releaseDate"`
+	// Full date of the original release as YYYY-MM-DD.
+	OriginalReleaseDate *string `json:"originalReleaseDate"`
+	// Year of the original release.
+	OriginalYear *int `json:"originalYear"`
 	// SHA256 hash of the song file.
 	Sha256 string `json:"sha256"`
 }
@@ -46,13 +68,14 @@ type getByGenreIdResponse struct {
 
 // GetByGenreId retrieves a list of songs associated with a specific genre.
 // @Summary Retrieve songs by genre ID
-// @Description Retrieves all songs that are part of the specified genre, including detailed information about each song.
+// @Description Retrieves all songs that have the specified genre among their genres, including detailed information about each song.
 // @Tags Songs
 // @Accept  json
 // @Produce  json
 // @Param   genreId   path   int     true   "Unique identifier of the genre"
+// @Param   includeSubgenres   query   bool   false   "Also return the songs of all subgenres of the genre"
 // @Success 200 {object} getByGenreIdResponse "Successful response with a list of songs belonging to the requested genre"
-// @Failure 400 {object} response.Error "Invalid genreId format"
+// @Failure 400 {object} response.Error "Invalid genreId or includeSubgenres format"
 // @Failure 404 {object} response.Error "Genre not found"
 // @Failure 500 {object} response.Error "Internal Server Error"
 // @Router /genres/{genreId}/songs [get]
@@ -69,11 +92,21 @@ func (h *Handler) GetByGenreId(c *gin.Context) {
 		})
 		return
 	}
-	log.Debug().Int("genreId", genreId).Msg("Url parameter read successfully")
+	includeSubgenresStr := c.DefaultQuery("includeSubgenres", "false")
+	includeSubgenres, err := strconv.ParseBool(includeSubgenresStr)
+	if err != nil {
+		log.Error().Err(err).Str("includeSubgenresStr", includeSubgenresStr).Msg("Invalid includeSubgenres format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid includeSubgenres format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("genreId", genreId).Bool("includeSubgenres", includeSubgenres).Msg("Url parameters read successfully")
 
 	var songs []model.Song
 	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
-		songs, err = h.SongService.GetAllByGenreId(tx, genreId)
+		songs, err = h.SongService.GetAllByGenreId(tx, genreId, includeSubgenres)
 		if err != nil {
 			return err
 		}
@@ -98,17 +131,28 @@ func (h *Handler) GetByGenreId(c *gin.Context) {
 	songsResponseItems := make([]getByGenreIdResponseItem, len(songs))
 	for i, song := range songs {
 		songsResponseItems[i] = getByGenreIdResponseItem{
-			SongId:      song.SongId,
-			AudioFileId: song.AudioFileId,
-			Title:       song.Title,
-			AlbumId:     song.AlbumId,
-			ArtistId:    song.ArtistId,
-			GenreId:     song.GenreId,
-			Year:        song.Year,
-			SongNumber:  song.SongNumber,
-			DiscNumber:  song.DiscNumber,
-			Lyrics:      song.Lyrics,
-			Sha256:      song.Sha256,
+			SongId:              song.SongId,
+			AudioFileId:         song.AudioFileId,
+			Title:               song.Title,
+			AlbumId:             song.AlbumId,
+			ArtistId:            song.ArtistId,
+			GenreId:             song.GenreId,
+			Year:                song.Year,
+			SongNumber:          song.SongNumber,
+			DiscNumber:          song.DiscNumber,
+			Lyrics:              song.Lyrics,
+			WorkId:              song.WorkId,
+			Movement:            song.Movement,
+			MovementNumber:      song.MovementNumber,
+			Bpm:                 song.Bpm,
+			Isrc:                song.Isrc,
+			Label:               song.Label,
+			CatalogNumber:       song.CatalogNumber,
+			Comment:             song.Comment,
+			ReleaseDate:         response.Date(song.ReleaseDate),
+			OriginalReleaseDate: response.Date(song.OriginalReleaseDate),
+			OriginalYear:        song.OriginalYear,
+			Sha256:              song.Sha256,
 		}
 	}
 
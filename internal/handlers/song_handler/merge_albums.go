@@ -0,0 +1,122 @@
+package song_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// mergeAlbumsRequest represents the request model for MergeAlbums API.
+type mergeAlbumsRequest struct {
+	// Identifiers of the albums to merge into the album of the path.
+	AlbumIds []int `json:"albumIds"`
+}
+
+// albumResponse represents an album returned by the MergeAlbums and SplitAlbum APIs.
+type albumResponse struct {
+	// Unique identifier for the album.
+	AlbumId int `json:"albumId"`
+	// Title of the album.
+	Title string `json:"title"`
+	// Identifier of the album artist, if known.
+	AlbumArtistId *int `json:"albumArtistId"`
+	// Release year of the album, if known.
+	Year *int `json:"year"`
+	// MusicBrainz release identifier, if the files carry one.
+	MusicBrainzReleaseId *string `json:"musicBrainzReleaseId"`
+	// Whether the album is a compilation of various artists.
+	Compilation bool `json:"compilation"`
+}
+
+// MergeAlbums merges albums into an album.
+// @Summary Merge albums
+// @Description Moves the songs of the source albums to the target album. The source albums are removed and their identities become aliases of the target album, so later scans keep the merge.
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   albumId   path   int   true   "Album ID to merge into"
+// @Param   request   body   mergeAlbumsRequest   true   "Albums to merge"
+// @Success 200 {object} albumResponse "Merged album"
+// @Failure 400 {object} response.Error "Invalid albumId format or request body"
+// @Failure 404 {object} response.Error "Album not found"
+// @Failure 409 {object} response.Error "Album merged into itself"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /albums/{albumId}/merge [post]
+func (h *Handler) MergeAlbums(c *gin.Context) {
+	log.Debug().Msg("Merging albums")
+
+	albumIdStr := c.Param("albumId")
+	albumId, err := strconv.Atoi(albumIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("albumIdStr", albumIdStr).Msg("Invalid albumId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid albumId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("albumId", albumId).Msg("Url parameter read successfully")
+
+	var request mergeAlbumsRequest
+	err = c.ShouldBindJSON(&request)
+	if err == nil && len(request.AlbumIds) == 0 {
+		err = fmt.Errorf("albumIds is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var album model.Album
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		album, err = h.SongService.MergeAlbums(tx, albumId, request.AlbumIds)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to merge albums")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Album not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Album merged into itself",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to merge albums",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Albums merged successfully")
+	c.JSON(http.StatusOK, albumResponse{
+		AlbumId:              album.AlbumId,
+		Title:                album.Title,
+		AlbumArtistId:        album.AlbumArtistId,
+		Year:                 album.Year,
+		MusicBrainzReleaseId: album.MusicBrainzReleaseId,
+		Compilation:          album.Compilation,
+	})
+}
@@ -0,0 +1,110 @@
+package song_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// mergeArtistsRequest represents the request model for MergeArtists API.
+type mergeArtistsRequest struct {
+	// Identifiers of the artists to merge into the artist of the path.
+	ArtistIds []int `json:"artistIds"`
+}
+
+// artistResponse represents an artist returned by the MergeArtists and SplitArtist APIs.
+type artistResponse struct {
+	// Unique identifier for the artist.
+	ArtistId int `json:"artistId"`
+	// Name of the artist.
+	Name string `json:"name"`
+}
+
+// MergeArtists merges artists into an artist.
+// @Summary Merge artists
+// @Description Moves the songs of the source artists to the target artist and makes the target artist the album artist of their albums, merging albums that then share an identity. The source artists are removed and their names become aliases of the target artist, so later scans keep the merge.
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param   artistId   path   int   true   "Artist ID to merge into"
+// @Param   request   body   mergeArtistsRequest   true   "Artists to merge"
+// @Success 200 {object} artistResponse "Merged artist"
+// @Failure 400 {object} response.Error "Invalid artistId format or request body"
+// @Failure 404 {object} response.Error "Artist not found"
+// @Failure 409 {object} response.Error "Artist merged into itself"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /artists/{artistId}/merge [post]
+func (h *Handler) MergeArtists(c *gin.Context) {
+	log.Debug().Msg("Merging artists")
+
+	artistIdStr := c.Param("artistId")
+	artistId, err := strconv.Atoi(artistIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("artistIdStr", artistIdStr).Msg("Invalid artistId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid artistId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("artistId", artistId).Msg("Url parameter read successfully")
+
+	var request mergeArtistsRequest
+	err = c.ShouldBindJSON(&request)
+	if err == nil && len(request.ArtistIds) == 0 {
+		err = fmt.Errorf("artistIds is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var artist model.Artist
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		artist, err = h.SongService.MergeArtists(tx, artistId, request.ArtistIds)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to merge artists")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Artist not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Artist merged into itself",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to merge artists",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("artistId", artistId).Msg("Artists merged successfully")
+	c.JSON(http.StatusOK, artistResponse{
+		ArtistId: artist.ArtistId,
+		Name:     artist.Name,
+	})
+}
@@ -0,0 +1,113 @@
+package song_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// mergeGenresRequest represents the request model for MergeGenres API.
+type mergeGenresRequest struct {
+	// Identifiers of the genres to merge into the genre of the path.
+	GenreIds []int `json:"genreIds"`
+}
+
+// genreResponse represents a genre returned by the MergeGenres and SplitGenre APIs.
+type genreResponse struct {
+	// Unique identifier for the genre.
+	GenreId int `json:"genreId"`
+	// Name of the genre.
+	Name string `json:"name"`
+	// Identifier of the parent genre, if the genre is a subgenre.
+	ParentGenreId *int `json:"parentGenreId"`
+}
+
+// MergeGenres merges genres into a genre.
+// @Summary Merge genres
+// @Description Moves the songs of the source genres to the target genre and places their subgenres under it. The source genres are removed and their names become aliases of the target genre, so later scans keep the merge.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   genreId   path   int   true   "Genre ID to merge into"
+// @Param   request   body   mergeGenresRequest   true   "Genres to merge"
+// @Success 200 {object} genreResponse "Merged genre"
+// @Failure 400 {object} response.Error "Invalid genreId format or request body"
+// @Failure 404 {object} response.Error "Genre not found"
+// @Failure 409 {object} response.Error "Genre merged into itself"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/{genreId}/merge [post]
+func (h *Handler) MergeGenres(c *gin.Context) {
+	log.Debug().Msg("Merging genres")
+
+	genreIdStr := c.Param("genreId")
+	genreId, err := strconv.Atoi(genreIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("genreIdStr", genreIdStr).Msg("Invalid genreId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid genreId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("genreId", genreId).Msg("Url parameter read successfully")
+
+	var request mergeGenresRequest
+	err = c.ShouldBindJSON(&request)
+	if err == nil && len(request.GenreIds) == 0 {
+		err = fmt.Errorf("genreIds is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var genre model.Genre
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		genre, err = h.SongService.MergeGenres(tx, genreId, request.GenreIds)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to merge genres")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Genre not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Genre merged into itself",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to merge genres",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("genreId", genreId).Msg("Genres merged successfully")
+	c.JSON(http.StatusOK, genreResponse{
+		GenreId:       genre.GenreId,
+		Name:          genre.Name,
+		ParentGenreId: genre.ParentGenreId,
+	})
+}
@@ -0,0 +1,76 @@
+package song_handler
+
+import (
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// normalizeGenresResponseItem represents a legacy genre merged by the NormalizeGenres API.
+type normalizeGenresResponseItem struct {
+	// Identifier of the removed legacy genre.
+	GenreId int `json:"genreId"`
+	// Legacy name of the genre, like "(17)Rock".
+	Name string `json:"name"`
+	// Identifiers of the canonical genres the songs of the legacy genre got instead.
+	CanonicalGenreIds []int `json:"canonicalGenreIds"`
+}
+
+// normalizeGenresResponse represents the response model for NormalizeGenres API.
+type normalizeGenresResponse struct {
+	// Array of merged legacy genres.
+	Genres []normalizeGenresResponseItem `json:"genres"`
+}
+
+// NormalizeGenres merges genres with legacy ID3 names into canonical genres.
+// @Summary Normalize legacy genres
+// @Description Merges genres stored with ID3v1 numbers or parenthesized references, like "17", "(17)" or "(17)Rock", into canonical genres such as "Rock". Meant to be run once for data scanned before genre names were normalized.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} normalizeGenresResponse "Successful response with the merged genres"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/normalize [post]
+func (h *Handler) NormalizeGenres(c *gin.Context) {
+	log.Debug().Msg("Normalizing genres")
+
+	var normalizations []model.GenreNormalization
+	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		normalizations, err = h.SongService.NormalizeGenres(tx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to normalize genres")
+		c.JSON(http.StatusInternalServerError, response.Error{
+			Message: "Failed to normalize genres",
+			Reason:  err.Error(),
+		})
+		return
+	}
+
+	responseItems := make([]normalizeGenresResponseItem, len(normalizations))
+	for i, normalization := range normalizations {
+		responseItems[i] = normalizeGenresResponseItem{
+			GenreId:           normalization.GenreId,
+			Name:              normalization.Name,
+			CanonicalGenreIds: normalization.CanonicalGenreIds,
+		}
+	}
+
+	log.Debug().Msg("Genres normalized successfully")
+	c.JSON(http.StatusOK, normalizeGenresResponse{
+		Genres: responseItems,
+	})
+}
@@ -0,0 +1,113 @@
+package song_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// splitAlbumRequest represents the request model for SplitAlbum API.
+type splitAlbumRequest struct {
+	// Identifiers of the songs to move off the album.
+	SongIds []int `json:"songIds"`
+	// Title of the new album. Must not match an existing album or album alias of the same album artist and year.
+	Title string `json:"title"`
+}
+
+// SplitAlbum moves songs off an album to a new album.
+// @Summary Split album
+// @Description Moves the songs off the album to a new album with the title and the album artist and year of the album, as when two releases share an identity. The songs stay on the new album through later scans.
+// @Tags Albums
+// @Accept  json
+// @Produce  json
+// @Param   albumId   path   int   true   "Album ID to split"
+// @Param   request   body   splitAlbumRequest   true   "Songs to move and the new album"
+// @Success 200 {object} albumResponse "New album"
+// @Failure 400 {object} response.Error "Invalid albumId format or request body"
+// @Failure 404 {object} response.Error "Album or song not found"
+// @Failure 409 {object} response.Error "Album already exists or song does not belong to the album"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /albums/{albumId}/split [post]
+func (h *Handler) SplitAlbum(c *gin.Context) {
+	log.Debug().Msg("Splitting album")
+
+	albumIdStr := c.Param("albumId")
+	albumId, err := strconv.Atoi(albumIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("albumIdStr", albumIdStr).Msg("Invalid albumId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid albumId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("albumId", albumId).Msg("Url parameter read successfully")
+
+	var request splitAlbumRequest
+	err = c.ShouldBindJSON(&request)
+	request.Title = strings.TrimSpace(request.Title)
+	if err == nil && len(request.SongIds) == 0 {
+		err = fmt.Errorf("songIds is empty")
+	}
+	if err == nil && len(request.Title) == 0 {
+		err = fmt.Errorf("title is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var album model.Album
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		album, err = h.SongService.SplitAlbum(tx, albumId, request.SongIds, request.Title)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to split album")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Album or song not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Failed to split album",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to split album",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("albumId", albumId).Int("splitAlbumId", album.AlbumId).Msg("Album split successfully")
+	c.JSON(http.StatusOK, albumResponse{
+		AlbumId:              album.AlbumId,
+		Title:                album.Title,
+		AlbumArtistId:        album.AlbumArtistId,
+		Year:                 album.Year,
+		MusicBrainzReleaseId: album.MusicBrainzReleaseId,
+		Compilation:          album.Compilation,
+	})
+}
@@ -0,0 +1,109 @@
+package song_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// splitArtistRequest represents the request model for SplitArtist API.
+type splitArtistRequest struct {
+	// Identifiers of the songs to move off the artist.
+	SongIds []int `json:"songIds"`
+	// Name of the new artist. Must not match an existing artist or artist alias.
+	Name string `json:"name"`
+}
+
+// SplitArtist moves songs off an artist to a new artist.
+// @Summary Split artist
+// @Description Moves the songs off the artist to a new artist, as when two artists share a name. The songs keep crediting the new artist through later scans. Albums keep their album artist.
+// @Tags Artists
+// @Accept  json
+// @Produce  json
+// @Param   artistId   path   int   true   "Artist ID to split"
+// @Param   request   body   splitArtistRequest   true   "Songs to move and the new artist"
+// @Success 200 {object} artistResponse "New artist"
+// @Failure 400 {object} response.Error "Invalid artistId format or request body"
+// @Failure 404 {object} response.Error "Artist or song not found"
+// @Failure 409 {object} response.Error "Artist already exists or song does not belong to the artist"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /artists/{artistId}/split [post]
+func (h *Handler) SplitArtist(c *gin.Context) {
+	log.Debug().Msg("Splitting artist")
+
+	artistIdStr := c.Param("artistId")
+	artistId, err := strconv.Atoi(artistIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("artistIdStr", artistIdStr).Msg("Invalid artistId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid artistId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("artistId", artistId).Msg("Url parameter read successfully")
+
+	var request splitArtistRequest
+	err = c.ShouldBindJSON(&request)
+	request.Name = strings.TrimSpace(request.Name)
+	if err == nil && len(request.SongIds) == 0 {
+		err = fmt.Errorf("songIds is empty")
+	}
+	if err == nil && len(request.Name) == 0 {
+		err = fmt.Errorf("name is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var artist model.Artist
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		artist, err = h.SongService.SplitArtist(tx, artistId, request.SongIds, request.Name)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to split artist")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Artist or song not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Failed to split artist",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to split artist",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("artistId", artistId).Int("splitArtistId", artist.ArtistId).Msg("Artist split successfully")
+	c.JSON(http.StatusOK, artistResponse{
+		ArtistId: artist.ArtistId,
+		Name:     artist.Name,
+	})
+}
@@ -0,0 +1,110 @@
+package song_handler
+
+import (
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// splitGenreRequest represents the request model for SplitGenre API.
+type splitGenreRequest struct {
+	// Identifiers of the songs to move off the genre.
+	SongIds []int `json:"songIds"`
+	// Name of the new genre. Must not match an existing genre or genre alias.
+	Name string `json:"name"`
+}
+
+// SplitGenre moves songs off a genre to a new genre.
+// @Summary Split genre
+// @Description Moves the songs off the genre to a new genre. The songs keep listing the new genre through later scans.
+// @Tags Genres
+// @Accept  json
+// @Produce  json
+// @Param   genreId   path   int   true   "Genre ID to split"
+// @Param   request   body   splitGenreRequest   true   "Songs to move and the new genre"
+// @Success 200 {object} genreResponse "New genre"
+// @Failure 400 {object} response.Error "Invalid genreId format or request body"
+// @Failure 404 {object} response.Error "Genre or song not found"
+// @Failure 409 {object} response.Error "Genre already exists or song does not belong to the genre"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /genres/{genreId}/split [post]
+func (h *Handler) SplitGenre(c *gin.Context) {
+	log.Debug().Msg("Splitting genre")
+
+	genreIdStr := c.Param("genreId")
+	genreId, err := strconv.Atoi(genreIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("genreIdStr", genreIdStr).Msg("Invalid genreId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid genreId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("genreId", genreId).Msg("Url parameter read successfully")
+
+	var request splitGenreRequest
+	err = c.ShouldBindJSON(&request)
+	request.Name = strings.TrimSpace(request.Name)
+	if err == nil && len(request.SongIds) == 0 {
+		err = fmt.Errorf("songIds is empty")
+	}
+	if err == nil && len(request.Name) == 0 {
+		err = fmt.Errorf("name is empty")
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var genre model.Genre
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceMerge)
+		if err != nil {
+			return err
+		}
+		genre, err = h.SongService.SplitGenre(tx, genreId, request.SongIds, request.Name)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to split genre")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Genre or song not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Failed to split genre",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to split genre",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("genreId", genreId).Int("splitGenreId", genre.GenreId).Msg("Genre split successfully")
+	c.JSON(http.StatusOK, genreResponse{
+		GenreId:       genre.GenreId,
+		Name:          genre.Name,
+		ParentGenreId: genre.ParentGenreId,
+	})
+}
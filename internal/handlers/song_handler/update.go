@@ -0,0 +1,141 @@
+package song_handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// updateRequest represents the request model for Update API. Keys are the fields to override: title,
+// artist, album, albumArtist, genre and lyrics take a string, an empty string clearing the field, while
+// year, songNumber and discNumber take a positive integer. A null value drops the override, so the field
+// follows the tags again. Fields left out keep their current overrides.
+type updateRequest map[string]json.RawMessage
+
+// Update overrides the metadata of a song.
+// @Summary Override song metadata
+// @Description Sets fields of the song to values that replace its tags. Overridden artists, albums and genres are resolved like tags, and later scans keep the overrides. The song is read again from its audio file to apply them.
+// @Tags Songs
+// @Accept  json
+// @Produce  json
+// @Param   songId    path   int             true   "Unique identifier of the song"
+// @Param   request   body   object          true   "Fields to override, or null to drop an override"
+// @Success 200 {object} getResponse "Song with the overrides applied"
+// @Failure 400 {object} response.Error "Invalid songId format or request body"
+// @Failure 404 {object} response.Error "Song not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /songs/{songId} [patch]
+func (h *Handler) Update(c *gin.Context) {
+	log.Debug().Msg("Updating song")
+
+	songIdStr := c.Param("songId")
+	songId, err := strconv.Atoi(songIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("songIdStr", songIdStr).Msg("Invalid songId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid songId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("songId", songId).Msg("Url parameter read successfully")
+
+	var request updateRequest
+	err = c.ShouldBindJSON(&request)
+	var overrides []model.SongOverride
+	var resetFields []model.SongOverrideField
+	if err == nil {
+		overrides, resetFields, err = parseOverrides(request)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid request body")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid request body",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Interface("request", request).Msg("Request body read successfully")
+
+	var song model.Song
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceEdit)
+		if err != nil {
+			return err
+		}
+		song, err = h.SongService.UpdateOverrides(tx, songId, overrides, resetFields)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to update song")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Song not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Invalid); ok {
+			c.JSON(http.StatusBadRequest, response.Error{
+				Message: "Invalid request body",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to update song",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("songId", songId).Msg("Song updated successfully")
+	c.JSON(http.StatusOK, newGetResponse(song))
+}
+
+// parseOverrides reads the fields of the request into overrides to set and fields to reset
+func parseOverrides(request map[string]json.RawMessage) (overrides []model.SongOverride, resetFields []model.SongOverrideField, err error) {
+	if len(request) == 0 {
+		return nil, nil, fmt.Errorf("no fields to override")
+	}
+
+	for key, raw := range request {
+		field := model.SongOverrideField(key)
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			resetFields = append(resetFields, field)
+			continue
+		}
+
+		var value *string
+		if field.IsNumeric() {
+			var number int
+			if err = json.Unmarshal(raw, &number); err != nil {
+				return nil, nil, fmt.Errorf("field %q must be an integer: %w", key, err)
+			}
+			text := strconv.Itoa(number)
+			value = &text
+		} else {
+			var text string
+			if err = json.Unmarshal(raw, &text); err != nil {
+				return nil, nil, fmt.Errorf("field %q must be a string: %w", key, err)
+			}
+			if text = strings.TrimSpace(text); len(text) > 0 {
+				value = &text
+			}
+		}
+		overrides = append(overrides, model.SongOverride{
+			Field: field,
+			Value: value,
+		})
+	}
+	return overrides, resetFields, nil
+}
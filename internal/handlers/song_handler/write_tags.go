@@ -0,0 +1,76 @@
+package song_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// WriteTags writes the overrides of a song into its audio file.
+// @Summary Write song tags
+// @Description Writes the overridden fields of the song into the tags of its audio file (ID3v2.4 for MP3, Vorbis comments for FLAC, Ogg Vorbis and Opus, atoms for MP4) and uploads the file to music-files, so other players see them too. The song keeps its overrides and takes the hash of the new content.
+// @Tags Songs
+// @Accept  json
+// @Produce  json
+// @Param   songId   path    int     true        "Unique identifier of the song"
+// @Success 200 {object} getResponse "Song read from the written file"
+// @Failure 400 {object} response.Error "Invalid songId format"
+// @Failure 404 {object} response.Error "Song or audio file not found"
+// @Failure 409 {object} response.Error "Tags of the audio file cannot be written"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /songs/{songId}/write-tags [post]
+func (h *Handler) WriteTags(c *gin.Context) {
+	log.Debug().Msg("Writing song tags")
+
+	songIdStr := c.Param("songId")
+	songId, err := strconv.Atoi(songIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("songIdStr", songIdStr).Msg("Invalid songId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid songId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("songId", songId).Msg("Url parameter read successfully")
+
+	var song model.Song
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		err = service.StartChangeSet(tx, model.ChangeSourceEdit)
+		if err != nil {
+			return err
+		}
+		song, err = h.SongService.WriteTags(tx, songId)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to write song tags")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Song or audio file not found",
+				Reason:  err.Error(),
+			})
+		} else if _, ok := err.(errors.Conflict); ok {
+			c.JSON(http.StatusConflict, response.Error{
+				Message: "Tags of the audio file cannot be written",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to write song tags",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Int("songId", songId).Msg("Song tags written successfully")
+	c.JSON(http.StatusOK, newGetResponse(song))
+}
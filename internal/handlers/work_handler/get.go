@@ -0,0 +1,82 @@
+package work_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getResponse represents the response model for GetWork API.
+type getResponse struct {
+	// Unique identifier for the work.
+	WorkId int `json:"workId"`
+	// Title of the work.
+	Title string `json:"title"`
+	// Identifier of the artist who composed the work.
+	ComposerId *int `json:"composerId"`
+}
+
+// Get retrieves detailed information about a work.
+// @Summary Retrieve work details
+// @Description Retrieves detailed information about a work, like a symphony, whose movements are songs.
+// @Tags Works
+// @Accept  json
+// @Produce  json
+// @Param   workId   path    int     true    "Work ID"
+// @Success 200 {object} getResponse
+// @Failure 400 {object} response.Error "Invalid workId format"
+// @Failure 404 {object} response.Error "Work not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /works/{workId} [get]
+func (h *Handler) Get(c *gin.Context) {
+	log.Debug().Msg("Getting work")
+
+	workIdStr := c.Param("workId")
+	workId, err := strconv.Atoi(workIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("workIdStr", workIdStr).Msg("Invalid workId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid workId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("workId", workId).Msg("Url parameter read successfully")
+
+	var work model.Work
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		work, err = h.WorkService.Get(tx, workId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get work")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Work not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get work",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Msg("Work got successfully")
+	c.JSON(http.StatusOK, getResponse{
+		WorkId:     work.WorkId,
+		Title:      work.Title,
+		ComposerId: work.ComposerId,
+	})
+}
@@ -0,0 +1,74 @@
+package work_handler
+
+import (
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// getAllResponseItem represents a single work item in the GetAllWorks API response.
+type getAllResponseItem struct {
+	// Unique identifier for the work.
+	WorkId int `json:"workId"`
+	// Title of the work.
+	Title string `json:"title"`
+	// Identifier of the artist who composed the work.
+	ComposerId *int `json:"composerId"`
+}
+
+// getAllResponse represents the response model for GetAllWorks API.
+type getAllResponse struct {
+	// Array of works.
+	Works []getAllResponseItem `json:"works"`
+}
+
+// GetAll retrieves a list of all works.
+// @Summary Retrieve all works
+// @Description Retrieves a list of all works, like symphonies or sonatas, whose movements are songs.
+// @Tags Works
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} getAllResponse "Success response with a list of works"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /works [get]
+func (h *Handler) GetAll(c *gin.Context) {
+	log.Debug().Msg("Getting works")
+
+	var works []model.Work
+	err := h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		works, err = h.WorkService.GetAll(tx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get works")
+		c.JSON(http.StatusInternalServerError, response.Error{
+			Message: "Failed to get works",
+			Reason:  err.Error(),
+		})
+		return
+	}
+
+	log.Debug().Msg("Works got successfully")
+	c.JSON(http.StatusOK, getAllResponse{
+		Works: newGetAllResponseItems(works),
+	})
+}
+
+func newGetAllResponseItems(works []model.Work) []getAllResponseItem {
+	worksResponseItems := make([]getAllResponseItem, len(works))
+	for i, work := range works {
+		worksResponseItems[i] = getAllResponseItem{
+			WorkId:     work.WorkId,
+			Title:      work.Title,
+			ComposerId: work.ComposerId,
+		}
+	}
+	return worksResponseItems
+}
@@ -0,0 +1,70 @@
+package work_handler
+
+import (
+	"music-metadata/internal/errors"
+	"music-metadata/internal/handlers/response"
+	"music-metadata/internal/model"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// GetAllByComposerId retrieves the works of a composer.
+// @Summary Retrieve works by composer
+// @Description Retrieves the works the specified artist composed.
+// @Tags Works
+// @Accept  json
+// @Produce  json
+// @Param   artistId   path   int     true   "Unique identifier of the composer"
+// @Success 200 {object} getAllResponse "Success response with a list of works of the composer"
+// @Failure 400 {object} response.Error "Invalid artistId format"
+// @Failure 404 {object} response.Error "Artist not found"
+// @Failure 500 {object} response.Error "Internal Server Error"
+// @Router /composers/{artistId}/works [get]
+func (h *Handler) GetAllByComposerId(c *gin.Context) {
+	log.Debug().Msg("Getting works by composer")
+
+	artistIdStr := c.Param("artistId")
+	artistId, err := strconv.Atoi(artistIdStr)
+	if err != nil {
+		log.Error().Err(err).Str("artistIdStr", artistIdStr).Msg("Invalid artistId format")
+		c.JSON(http.StatusBadRequest, response.Error{
+			Message: "Invalid artistId format",
+			Reason:  err.Error(),
+		})
+		return
+	}
+	log.Debug().Int("artistId", artistId).Msg("Url parameter read successfully")
+
+	var works []model.Work
+	err = h.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		works, err = h.WorkService.GetAllByComposerId(tx, artistId)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warn().Err(err).Int("artistId", artistId).Msg("Failed to get works by composer")
+		if _, ok := err.(errors.NotFound); ok {
+			c.JSON(http.StatusNotFound, response.Error{
+				Message: "Artist not found",
+				Reason:  err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, response.Error{
+				Message: "Failed to get works by composer",
+				Reason:  err.Error(),
+			})
+		}
+		return
+	}
+
+	log.Debug().Msg("Works got successfully")
+	c.JSON(http.StatusOK, getAllResponse{
+		Works: newGetAllResponseItems(works),
+	})
+}
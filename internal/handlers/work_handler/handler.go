@@ -0,0 +1,22 @@
+package work_handler
+
+import (
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/work_service"
+)
+
+type Handler struct {
+	WorkService        work_service.Service
+	TransactionManager service.TransactionManager
+}
+
+func NewHandler(workService work_service.Service,
+	transactionManager service.TransactionManager,
+) (h *Handler) {
+	h = &Handler{
+		WorkService:        workService,
+		TransactionManager: transactionManager,
+	}
+
+	return h
+}
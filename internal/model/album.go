@@ -1,6 +1,22 @@
 package model
 
+import "time"
+
+// Album is identified by its MusicBrainz release id when the files carry one, otherwise by title,
+// album artist and year together, so albums sharing a title are kept apart. Titles are compared by
+// TitleKey, and Title is the spelling most songs of the album use
 type Album struct {
-	AlbumId int    `db:"album_id"`
-	Title   string `db:"title"`
+	AlbumId              int     `db:"album_id"`
+	Title                string  `db:"title"`
+	TitleKey             string  `db:"title_key"`
+	AlbumArtistId        *int    `db:"album_artist_id"`
+	Year                 *int    `db:"year"`
+	MusicBrainzReleaseId *string `db:"musicbrainz_release_id"`
+	Compilation          bool    `db:"compilation"`
+	// SortTitle is the sort title from the tags, SortKey the key listings are ordered by
+	SortTitle *string `db:"sort_title"`
+	SortKey   string  `db:"sort_key"`
+	// ReleaseDate and Label are the ones most songs of the album carry
+	ReleaseDate *time.Time `db:"release_date"`
+	Label       *string    `db:"label"`
 }
@@ -0,0 +1,13 @@
+package model
+
+// AlbumAlias keeps the identity of an album merged into another one. Files scanned with that identity
+// resolve to AlbumId
+type AlbumAlias struct {
+	AlbumAliasId         int     `db:"album_alias_id"`
+	Title                string  `db:"title"`
+	TitleKey             string  `db:"title_key"`
+	AlbumArtistId        *int    `db:"album_artist_id"`
+	Year                 *int    `db:"year"`
+	MusicBrainzReleaseId *string `db:"musicbrainz_release_id"`
+	AlbumId              int     `db:"album_id"`
+}
@@ -1,6 +1,12 @@
 package model
 
+// Artist is matched by NameKey, so differently written names of one artist share a row. Name is the
+// spelling the artist is credited with most often. SortName is the sort name from the tags, like
+// "Beatles, The", and SortKey the key listings are ordered by
 type Artist struct {
-	ArtistId int    `db:"artist_id"`
-	Name     string `db:"name"`
+	ArtistId int     `db:"artist_id"`
+	Name     string  `db:"name"`
+	NameKey  string  `db:"name_key"`
+	SortName *string `db:"sort_name"`
+	SortKey  string  `db:"sort_key"`
 }
@@ -0,0 +1,9 @@
+package model
+
+// ArtistAlias is another spelling of an artist, usually the name of an artist merged into it. Scanned
+// artist names matching an alias by MatchingKey resolve to its artist
+type ArtistAlias struct {
+	Alias    string `db:"alias"`
+	AliasKey string `db:"alias_key"`
+	ArtistId int    `db:"artist_id"`
+}
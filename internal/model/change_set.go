@@ -0,0 +1,50 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ChangeSource string
+
+const (
+	ChangeSourceScan   ChangeSource = "scan"
+	ChangeSourceEvent  ChangeSource = "event"
+	ChangeSourceEdit   ChangeSource = "edit"
+	ChangeSourceMerge  ChangeSource = "merge"
+	ChangeSourceRevert ChangeSource = "revert"
+	// ChangeSourceUnknown marks changes made without a source, like manual changes in the database
+	ChangeSourceUnknown ChangeSource = "unknown"
+)
+
+// ChangeSet groups the changes of one operation in the audit log. RevertedChangeSetId is set for change
+// sets undoing another one
+type ChangeSet struct {
+	ChangeSetId         int          `db:"change_set_id"`
+	Source              ChangeSource `db:"source"`
+	RevertedChangeSetId *int         `db:"reverted_change_set_id"`
+	CreatedAt           time.Time    `db:"created_at"`
+	Entries             []AuditEntry `db:"-"`
+}
+
+type AuditOperation string
+
+const (
+	AuditOperationInsert AuditOperation = "insert"
+	AuditOperationUpdate AuditOperation = "update"
+	AuditOperationDelete AuditOperation = "delete"
+)
+
+// AuditEntry keeps a row of a table as JSON before and after a change. Before is nil for inserted rows
+// and After for deleted ones. RowKey holds the primary key columns of the row, and PreviousRowKey the ones
+// it had before an update that changed them
+type AuditEntry struct {
+	AuditEntryId   int              `db:"audit_entry_id"`
+	ChangeSetId    int              `db:"change_set_id"`
+	TableName      string           `db:"table_name"`
+	RowKey         json.RawMessage  `db:"row_key"`
+	PreviousRowKey *json.RawMessage `db:"previous_row_key"`
+	Operation      AuditOperation   `db:"operation"`
+	Before         *json.RawMessage `db:"before"`
+	After          *json.RawMessage `db:"after"`
+}
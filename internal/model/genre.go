@@ -1,6 +1,10 @@
 package model
 
+// Genre is matched by NameKey, so differently written names of one genre share a row. Name is the
+// spelling the genre is listed with most often
 type Genre struct {
-	GenreId int    `db:"genre_id"`
-	Name    string `db:"name"`
+	GenreId       int    `db:"genre_id"`
+	Name          string `db:"name"`
+	NameKey       string `db:"name_key"`
+	ParentGenreId *int   `db:"parent_genre_id"`
 }
@@ -0,0 +1,9 @@
+package model
+
+// GenreAlias is another spelling of a genre, like "Hip Hop" for "Hip-Hop". Scanned genre names
+// matching an alias by MatchingKey resolve to its genre
+type GenreAlias struct {
+	Alias    string `db:"alias"`
+	AliasKey string `db:"alias_key"`
+	GenreId  int    `db:"genre_id"`
+}
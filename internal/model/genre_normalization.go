@@ -0,0 +1,8 @@
+package model
+
+// GenreNormalization records a genre with a legacy name, like "(17)Rock", merged into canonical genres
+type GenreNormalization struct {
+	GenreId           int
+	Name              string
+	CanonicalGenreIds []int
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+var folder = cases.Fold()
+
+// MatchingKey reduces a name to the form names are compared in: Unicode NFKC, case folded and with
+// whitespace runs collapsed into single spaces. Spellings like "Beyoncé" in NFC and NFD, "the beatles"
+// and "The Beatles" or fullwidth "ＡＢＣ" and "ABC" share a key
+func MatchingKey(value string) string {
+	key := norm.NFKC.String(value)
+	key = norm.NFKC.String(folder.String(key))
+	return strings.Join(strings.Fields(key), " ")
+}
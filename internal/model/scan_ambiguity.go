@@ -0,0 +1,23 @@
+package model
+
+type ScanAmbiguityKind string
+
+const (
+	// ScanAmbiguityDuplicateSha256 marks an audio file skipped because another file has the same content
+	ScanAmbiguityDuplicateSha256 ScanAmbiguityKind = "duplicate_sha256"
+	// ScanAmbiguityConflictingMatch marks an audio file matching one song by id and another one by hash
+	ScanAmbiguityConflictingMatch ScanAmbiguityKind = "conflicting_match"
+	// ScanAmbiguityIdAndHashChanged marks a song that lost both its audio file id and hash
+	// while a new audio file has one of its previous hashes, so it may have been moved and modified at once
+	ScanAmbiguityIdAndHashChanged ScanAmbiguityKind = "id_and_hash_changed"
+)
+
+type ScanAmbiguity struct {
+	ScanAmbiguityId int               `db:"scan_ambiguity_id"`
+	ScanJobId       int               `db:"scan_job_id"`
+	Kind            ScanAmbiguityKind `db:"kind"`
+	AudioFileId     *int              `db:"audio_file_id"`
+	SongId          *int              `db:"song_id"`
+	Sha256          *string           `db:"sha_256"`
+	Description     string            `db:"description"`
+}
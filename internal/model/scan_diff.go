@@ -0,0 +1,35 @@
+package model
+
+// ScanDiff describes what a scan would change. It is stored as JSON, hence the json tags
+type ScanDiff struct {
+	CreatedSongs   []ScanDiffSong `json:"createdSongs"`
+	RemovedSongs   []ScanDiffSong `json:"removedSongs"`
+	RelinkedSongs  []ScanDiffSong `json:"relinkedSongs"`
+	ReparsedSongs  []ScanDiffSong `json:"reparsedSongs"`
+	CreatedAlbums  []ScanDiffItem `json:"createdAlbums"`
+	RemovedAlbums  []ScanDiffItem `json:"removedAlbums"`
+	CreatedArtists []ScanDiffItem `json:"createdArtists"`
+	RemovedArtists []ScanDiffItem `json:"removedArtists"`
+	CreatedGenres  []ScanDiffItem `json:"createdGenres"`
+	RemovedGenres  []ScanDiffItem `json:"removedGenres"`
+}
+
+type ScanDiffSong struct {
+	SongId              *int    `json:"songId"`
+	AudioFileId         int     `json:"audioFileId"`
+	PreviousAudioFileId *int    `json:"previousAudioFileId"`
+	Title               *string `json:"title"`
+	AlbumId             *int    `json:"albumId"`
+	ArtistId            *int    `json:"artistId"`
+	GenreId             *int    `json:"genreId"`
+	Year                *int    `json:"year"`
+	SongNumber          *int    `json:"songNumber"`
+	DiscNumber          *int    `json:"discNumber"`
+	Lyrics              *string `json:"lyrics"`
+	Sha256              string  `json:"sha256"`
+}
+
+type ScanDiffItem struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type ScanFailurePhase string
+
+const (
+	// ScanFailurePhaseDownload marks an audio file that could not be fetched from the file service
+	ScanFailurePhaseDownload ScanFailurePhase = "download"
+	// ScanFailurePhaseParse marks an audio file whose tags could not be read
+	ScanFailurePhaseParse ScanFailurePhase = "parse"
+	// ScanFailurePhasePersist marks an audio file whose song could not be saved
+	ScanFailurePhasePersist ScanFailurePhase = "persist"
+)
+
+// ScanFailure is the latest failure of an audio file. The file stays quarantined until a scan processes it successfully
+type ScanFailure struct {
+	ScanFailureId int              `db:"scan_failure_id"`
+	ScanJobId     int              `db:"scan_job_id"`
+	AudioFileId   int              `db:"audio_file_id"`
+	Phase         ScanFailurePhase `db:"phase"`
+	Error         string           `db:"error"`
+	FailedAt      time.Time        `db:"failed_at"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+type ScanJobStatus string
+
+const (
+	ScanJobStatusRunning   ScanJobStatus = "running"
+	ScanJobStatusSucceeded ScanJobStatus = "succeeded"
+	ScanJobStatusFailed    ScanJobStatus = "failed"
+)
+
+type ScanMode string
+
+const (
+	ScanModeFull        ScanMode = "full"
+	ScanModeIncremental ScanMode = "incremental"
+	// ScanModeRetry processes only the audio files that failed in previous scans
+	ScanModeRetry ScanMode = "retry"
+	// ScanModeEvent processes only the audio files music-files reported as changed
+	ScanModeEvent ScanMode = "event"
+	// ScanModeTargeted parses the audio files of chosen songs again, even if their content did not change
+	ScanModeTargeted ScanMode = "targeted"
+)
+
+type ScanPhase string
+
+const (
+	ScanPhasePreparing ScanPhase = "preparing"
+	ScanPhaseCreating  ScanPhase = "creating"
+	ScanPhaseRemoving  ScanPhase = "removing"
+	ScanPhaseMoving    ScanPhase = "moving"
+	ScanPhaseChanging  ScanPhase = "changing"
+	ScanPhaseCleaning  ScanPhase = "cleaning"
+	ScanPhaseFinished  ScanPhase = "finished"
+)
+
+type ScanJob struct {
+	ScanJobId            int           `db:"scan_job_id"`
+	Mode                 ScanMode      `db:"mode"`
+	DryRun               bool          `db:"dry_run"`
+	Status               ScanJobStatus `db:"status"`
+	Phase                ScanPhase     `db:"phase"`
+	TotalFiles           int           `db:"total_files"`
+	ProcessedFiles       int           `db:"processed_files"`
+	CreatedCount         int           `db:"created_count"`
+	RemovedCount         int           `db:"removed_count"`
+	MovedCount           int           `db:"moved_count"`
+	ChangedCount         int           `db:"changed_count"`
+	FailedCount          int           `db:"failed_count"`
+	Error                *string       `db:"error"`
+	StartedAt            time.Time     `db:"started_at"`
+	FinishedAt           *time.Time    `db:"finished_at"`
+	ContentHighWaterMark *time.Time    `db:"content_high_water_mark"`
+}
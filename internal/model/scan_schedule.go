@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// ScanSchedule describes periodic scans. It is kept in memory, so the last run is forgotten on restart
+type ScanSchedule struct {
+	Enabled    bool
+	Expression string
+	Mode       ScanMode
+	// LastRunAt is when the schedule last fired, whether a scan was started or skipped
+	LastRunAt      *time.Time
+	LastScanJobId  *int
+	LastRunSkipped bool
+	// LastRunError is why the last run failed to start a scan, unless it was skipped
+	LastRunError *string
+	NextRunAt    *time.Time
+}
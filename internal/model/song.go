@@ -1,15 +1,48 @@
 package model
 
+import "time"
+
 type Song struct {
-	SongId      int     `db:"song_id"`
-	AudioFileId int     `db:"audio_file_id"`
-	Title       *string `db:"title"`
-	AlbumId     *int    `db:"album_id"`
-	ArtistId    *int    `db:"artist_id"`
-	GenreId     *int    `db:"genre_id"`
-	Year        *int    `db:"year"`
-	SongNumber  *int    `db:"song_number"`
-	DiscNumber  *int    `db:"disc_number"`
-	Lyrics      *string `db:"lyrics"`
-	Sha256      string  `db:"sha_256"`
+	SongId         int        `db:"song_id"`
+	AudioFileId    int        `db:"audio_file_id"`
+	Title          *string    `db:"title"`
+	AlbumId        *int       `db:"album_id"`
+	AlbumTitle     *string    `db:"album_title"`
+	ArtistId       *int       `db:"artist_id"`
+	GenreId        *int       `db:"genre_id"`
+	Year           *int       `db:"year"`
+	SongNumber     *int       `db:"song_number"`
+	DiscNumber     *int       `db:"disc_number"`
+	Lyrics         *string    `db:"lyrics"`
+	WorkId         *int       `db:"work_id"`
+	Movement       *string    `db:"movement"`
+	MovementNumber *int       `db:"movement_number"`
+	SortTitle      *string    `db:"sort_title"`
+	SortKey        *string    `db:"sort_key"`
+	Bpm            *int       `db:"bpm"`
+	Isrc           *string    `db:"isrc"`
+	Label          *string    `db:"label"`
+	CatalogNumber  *string    `db:"catalog_number"`
+	Comment        *string    `db:"comment"`
+	ReleaseDate    *time.Time `db:"release_date"`
+	// OriginalReleaseDate is the full date of the first release, OriginalYear its year even if the tags
+	// carry no full date
+	OriginalReleaseDate *time.Time `db:"original_release_date"`
+	OriginalYear        *int       `db:"original_year"`
+	// ArtistSortName, AlbumArtistSortName and AlbumSortTitle are the sort tags of the file. The sort names
+	// of artists and albums are the ones most of their songs carry
+	ArtistSortName      *string `db:"artist_sort_name"`
+	AlbumArtistSortName *string `db:"album_artist_sort_name"`
+	AlbumSortTitle      *string `db:"album_sort_title"`
+	// Compilation tells whether the file belongs to a compilation, which makes its album one
+	Compilation       bool       `db:"compilation"`
+	Sha256            string     `db:"sha_256"`
+	LastContentUpdate *time.Time `db:"last_content_update"`
+	// Artists credits every artist of the song. ArtistId is the first main artist among them
+	Artists []SongArtist `db:"-"`
+	// Genres lists every genre of the song. GenreId is the first of them
+	Genres []SongGenre `db:"-"`
+	// Overrides are the fields set by the user instead of the tags. The other fields hold the values
+	// with the overrides applied
+	Overrides []SongOverride `db:"-"`
 }
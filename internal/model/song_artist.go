@@ -0,0 +1,23 @@
+package model
+
+type SongArtistRole string
+
+const (
+	SongArtistRoleMain     SongArtistRole = "main"
+	SongArtistRoleFeatured SongArtistRole = "featured"
+	SongArtistRoleRemixer  SongArtistRole = "remixer"
+	// Composers and conductors are credited besides the performing roles, so an artist can be both the
+	// main artist and the composer of a song
+	SongArtistRoleComposer  SongArtistRole = "composer"
+	SongArtistRoleConductor SongArtistRole = "conductor"
+)
+
+// SongArtist credits an artist on a song. Position keeps the order the artists are credited in the tags
+// and CreditedName the spelling they are credited with
+type SongArtist struct {
+	SongId       int            `db:"song_id"`
+	ArtistId     int            `db:"artist_id"`
+	Role         SongArtistRole `db:"role"`
+	Position     int            `db:"position"`
+	CreditedName string         `db:"credited_name"`
+}
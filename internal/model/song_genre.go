@@ -0,0 +1,10 @@
+package model
+
+// SongGenre assigns a genre to a song. Position keeps the order the genres are listed in the tags
+// and ListedName the spelling they are listed with
+type SongGenre struct {
+	SongId     int    `db:"song_id"`
+	GenreId    int    `db:"genre_id"`
+	Position   int    `db:"position"`
+	ListedName string `db:"listed_name"`
+}
@@ -0,0 +1,48 @@
+package model
+
+type SongOverrideField string
+
+const (
+	SongOverrideFieldTitle       SongOverrideField = "title"
+	SongOverrideFieldArtist      SongOverrideField = "artist"
+	SongOverrideFieldAlbum       SongOverrideField = "album"
+	SongOverrideFieldAlbumArtist SongOverrideField = "albumArtist"
+	SongOverrideFieldGenre       SongOverrideField = "genre"
+	SongOverrideFieldYear        SongOverrideField = "year"
+	SongOverrideFieldSongNumber  SongOverrideField = "songNumber"
+	SongOverrideFieldDiscNumber  SongOverrideField = "discNumber"
+	SongOverrideFieldLyrics      SongOverrideField = "lyrics"
+)
+
+// SongOverrideFields lists every field of a song that can be overridden
+var SongOverrideFields = []SongOverrideField{
+	SongOverrideFieldTitle,
+	SongOverrideFieldArtist,
+	SongOverrideFieldAlbum,
+	SongOverrideFieldAlbumArtist,
+	SongOverrideFieldGenre,
+	SongOverrideFieldYear,
+	SongOverrideFieldSongNumber,
+	SongOverrideFieldDiscNumber,
+	SongOverrideFieldLyrics,
+}
+
+// IsNumeric tells whether values of the field are positive integers rather than text
+func (f SongOverrideField) IsNumeric() bool {
+	switch f {
+	case SongOverrideFieldYear, SongOverrideFieldSongNumber, SongOverrideFieldDiscNumber:
+		return true
+	default:
+		return false
+	}
+}
+
+// SongOverride replaces a tag of the song with a value set by the user. Scans read the song as if the
+// file carried Value, so it is resolved into artists, albums and genres like any tag. A nil Value
+// clears the tag. TagValue keeps what the file itself carries, as of the last scan
+type SongOverride struct {
+	SongId   int               `db:"song_id"`
+	Field    SongOverrideField `db:"field"`
+	Value    *string           `db:"value"`
+	TagValue *string           `db:"tag_value"`
+}
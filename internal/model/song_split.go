@@ -0,0 +1,18 @@
+package model
+
+type SongSplitKind string
+
+const (
+	SongSplitKindArtist SongSplitKind = "artist"
+	SongSplitKindAlbum  SongSplitKind = "album"
+	SongSplitKindGenre  SongSplitKind = "genre"
+)
+
+// SongSplit moves a song split off an artist, album or genre: whenever the tags of the song resolve
+// to FromId, the song gets ToId instead
+type SongSplit struct {
+	SongId int           `db:"song_id"`
+	Kind   SongSplitKind `db:"kind"`
+	FromId int           `db:"from_id"`
+	ToId   int           `db:"to_id"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import "strings"
+
+// SortKey is the form names and titles are sorted by: the matching key without a leading article, so that
+// "The Beatles" sorts as "beatles". Values that are nothing but an article keep it
+func SortKey(value string, articles []string) string {
+	key := MatchingKey(value)
+	for _, article := range articles {
+		prefix := MatchingKey(article)
+		if !strings.HasSuffix(prefix, "'") {
+			prefix += " "
+		}
+		if rest, found := strings.CutPrefix(key, prefix); found && len(strings.TrimSpace(rest)) > 0 {
+			return strings.TrimSpace(rest)
+		}
+	}
+	return key
+}
@@ -0,0 +1,29 @@
+package model
+
+import "strings"
+
+const (
+	SortFieldName   = "name"
+	SortFieldTitle  = "title"
+	SortFieldArtist = "artist"
+	SortFieldAlbum  = "album"
+	SortFieldYear   = "year"
+)
+
+// ArtistSortFields, AlbumSortFields and SongSortFields are the fields the listings can be sorted by
+var (
+	ArtistSortFields = []string{SortFieldName}
+	AlbumSortFields  = []string{SortFieldTitle, SortFieldArtist, SortFieldYear}
+	SongSortFields   = []string{SortFieldTitle, SortFieldArtist, SortFieldAlbum}
+)
+
+// SortOrder orders a listing by the sort keys of a field, like "title", or in reverse for "-title"
+type SortOrder struct {
+	Field      string
+	Descending bool
+}
+
+func ParseSortOrder(value string) SortOrder {
+	field, descending := strings.CutPrefix(strings.TrimSpace(value), "-")
+	return SortOrder{Field: field, Descending: descending}
+}
@@ -0,0 +1,10 @@
+package model
+
+// Work is a composition like a symphony, whose movements are songs that may come from several albums.
+// It is identified by TitleKey and composer together, and Title is the spelling it was first found with
+type Work struct {
+	WorkId     int    `db:"work_id"`
+	Title      string `db:"title"`
+	TitleKey   string `db:"title_key"`
+	ComposerId *int   `db:"composer_id"`
+}
@@ -0,0 +1,19 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) Delete(tx *sqlx.Tx, albumId int) (err error) {
+	log.Debug().Int("albumId", albumId).Msg("Deleting album")
+
+	err = s.AlbumRepo.Delete(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to delete album")
+		return err
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Album deleted successfully")
+	return nil
+}
@@ -0,0 +1,42 @@
+package album_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// GetAllByArtistId returns the albums the artist is the album artist of and, separately, the albums
+// of other album artists the artist only appears on with some songs
+func (s Service) GetAllByArtistId(tx *sqlx.Tx, artistId int) (albums []model.Album, appearances []model.Album, err error) {
+	log.Debug().Int("artistId", artistId).Msg("Getting albums by artist")
+
+	exists, err := s.ArtistService.IsExists(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to check artist existence")
+		return make([]model.Album, 0), make([]model.Album, 0), err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("artist with id=%d", artistId)}
+		log.Error().Err(err).Int("artistId", artistId).Msg("Artist not found")
+		return make([]model.Album, 0), make([]model.Album, 0), err
+	}
+
+	albums, err = s.AlbumRepo.ReadAllByAlbumArtistId(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get albums by album artist")
+		return make([]model.Album, 0), make([]model.Album, 0), err
+	}
+
+	appearances, err = s.AlbumRepo.ReadAllByTrackArtistId(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get albums by track artist")
+		return make([]model.Album, 0), make([]model.Album, 0), err
+	}
+
+	log.Debug().Int("artistId", artistId).Int("countOfAlbums", len(albums)).Int("countOfAppearances", len(appearances)).
+		Msg("Albums by artist got successfully")
+	return albums, appearances, nil
+}
@@ -0,0 +1,21 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetAllByTitle returns every album with the title. Albums of different artists or years may share it
+func (s Service) GetAllByTitle(tx *sqlx.Tx, title string) (albums []model.Album, err error) {
+	log.Debug().Str("title", title).Msg("Getting albums by title")
+
+	albums, err = s.AlbumRepo.ReadAllByTitle(tx, title)
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to get albums")
+		return make([]model.Album, 0), err
+	}
+
+	log.Debug().Str("title", title).Int("countOfAlbum", len(albums)).Msg("Albums got successfully")
+	return albums, nil
+}
@@ -0,0 +1,27 @@
+package album_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"slices"
+)
+
+func (s Service) GetAllSorted(tx *sqlx.Tx, order model.SortOrder) (albums []model.Album, err error) {
+	log.Debug().Str("sort", order.Field).Bool("descending", order.Descending).Msg("Getting all albums sorted")
+
+	if !slices.Contains(model.AlbumSortFields, order.Field) {
+		return make([]model.Album, 0), errors.Invalid{Message: fmt.Sprintf("albums cannot be sorted by %q", order.Field)}
+	}
+
+	albums, err = s.AlbumRepo.ReadAllSorted(tx, order)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sorted albums")
+		return make([]model.Album, 0), err
+	}
+
+	log.Debug().Int("count", len(albums)).Msg("Sorted albums got successfully")
+	return albums, nil
+}
@@ -0,0 +1,27 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetByAlias returns the album an album with the identity was merged into
+func (s Service) GetByAlias(tx *sqlx.Tx, identity model.Album) (album model.Album, err error) {
+	log.Debug().Interface("identity", identity).Msg("Getting album by alias")
+
+	albumAlias, err := s.AlbumAliasRepo.ReadByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to get album alias")
+		return model.Album{}, err
+	}
+
+	album, err = s.AlbumRepo.Read(tx, albumAlias.AlbumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumAlias.AlbumId).Msg("Failed to get album")
+		return model.Album{}, err
+	}
+
+	log.Debug().Interface("album", album).Msg("Album got by alias successfully")
+	return album, nil
+}
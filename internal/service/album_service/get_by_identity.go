@@ -0,0 +1,22 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetByIdentity returns the album with the same MusicBrainz release id or, without one, the same
+// title, album artist and year as identity
+func (s Service) GetByIdentity(tx *sqlx.Tx, identity model.Album) (album model.Album, err error) {
+	log.Debug().Interface("identity", identity).Msg("Getting album by identity")
+
+	album, err = s.AlbumRepo.ReadByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to get album")
+		return model.Album{}, err
+	}
+
+	log.Debug().Interface("album", album).Msg("Album got successfully")
+	return album, nil
+}
@@ -0,0 +1,20 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) IsExistsByIdentity(tx *sqlx.Tx, identity model.Album) (exists bool, err error) {
+	log.Debug().Interface("identity", identity).Msg("Checking album existence")
+
+	exists, err = s.AlbumRepo.IsExistsByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to check album existence")
+		return false, err
+	}
+
+	log.Debug().Interface("identity", identity).Bool("exists", exists).Msg("Album existence checked successfully")
+	return exists, nil
+}
@@ -0,0 +1,41 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// MoveAliases hands the aliases of fromAlbum over to toAlbum and makes the identity of fromAlbum one
+// of them, so files of an album merged into toAlbum keep resolving to it
+func (s Service) MoveAliases(tx *sqlx.Tx, fromAlbum model.Album, toAlbum model.Album) (err error) {
+	log.Debug().Int("fromAlbumId", fromAlbum.AlbumId).Int("toAlbumId", toAlbum.AlbumId).Msg("Moving album aliases")
+
+	err = s.AlbumAliasRepo.UpdateAlbumId(tx, fromAlbum.AlbumId, toAlbum.AlbumId)
+	if err != nil {
+		log.Error().Err(err).Int("fromAlbumId", fromAlbum.AlbumId).Msg("Failed to move album aliases")
+		return err
+	}
+
+	exists, err := s.AlbumAliasRepo.IsExistsByIdentity(tx, fromAlbum)
+	if err != nil {
+		log.Error().Err(err).Int("fromAlbumId", fromAlbum.AlbumId).Msg("Failed to check album alias existence")
+		return err
+	}
+	if !exists {
+		_, err = s.AlbumAliasRepo.Create(tx, model.AlbumAlias{
+			Title:                fromAlbum.Title,
+			AlbumArtistId:        fromAlbum.AlbumArtistId,
+			Year:                 fromAlbum.Year,
+			MusicBrainzReleaseId: fromAlbum.MusicBrainzReleaseId,
+			AlbumId:              toAlbum.AlbumId,
+		})
+		if err != nil {
+			log.Error().Err(err).Int("fromAlbumId", fromAlbum.AlbumId).Msg("Failed to create album alias")
+			return err
+		}
+	}
+
+	log.Debug().Int("fromAlbumId", fromAlbum.AlbumId).Int("toAlbumId", toAlbum.AlbumId).Msg("Album aliases moved successfully")
+	return nil
+}
@@ -0,0 +1,21 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReplaceAliasAlbumArtist credits the album aliases of fromArtistId to toArtistId, as when the artist
+// is merged into another one
+func (s Service) ReplaceAliasAlbumArtist(tx *sqlx.Tx, fromArtistId int, toArtistId int) (err error) {
+	log.Debug().Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Replacing album artist of album aliases")
+
+	err = s.AlbumAliasRepo.ReplaceAlbumArtistId(tx, fromArtistId, toArtistId)
+	if err != nil {
+		log.Error().Err(err).Int("fromArtistId", fromArtistId).Msg("Failed to replace album artist of album aliases")
+		return err
+	}
+
+	log.Debug().Int("fromArtistId", fromArtistId).Int("toArtistId", toArtistId).Msg("Album artist of album aliases replaced successfully")
+	return nil
+}
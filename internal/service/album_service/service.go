@@ -1,17 +1,24 @@
 package album_service
 
 import (
+	"music-metadata/internal/database/repository/album_alias_repo"
 	"music-metadata/internal/database/repository/album_repo"
+	"music-metadata/internal/service/artist_service"
 )
 
 type Service struct {
-	AlbumRepo album_repo.Repo
+	AlbumRepo      album_repo.Repo
+	AlbumAliasRepo album_alias_repo.Repo
+
+	ArtistService artist_service.Service
 }
 
-func NewService(albumRepo album_repo.Repo) (s *Service) {
+func NewService(albumRepo album_repo.Repo, albumAliasRepo album_alias_repo.Repo, artistService artist_service.Service) (s *Service) {
 
 	s = &Service{
-		AlbumRepo: albumRepo,
+		AlbumRepo:      albumRepo,
+		AlbumAliasRepo: albumAliasRepo,
+		ArtistService:  artistService,
 	}
 
 	return s
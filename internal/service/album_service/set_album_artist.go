@@ -0,0 +1,19 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) SetAlbumArtist(tx *sqlx.Tx, albumId int, albumArtistId *int) (err error) {
+	log.Debug().Int("albumId", albumId).Interface("albumArtistId", albumArtistId).Msg("Setting album artist")
+
+	err = s.AlbumRepo.UpdateAlbumArtistId(tx, albumId, albumArtistId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to set album artist")
+		return err
+	}
+
+	log.Debug().Int("albumId", albumId).Interface("albumArtistId", albumArtistId).Msg("Album artist set successfully")
+	return nil
+}
@@ -0,0 +1,19 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateCompilationFromSongs(tx *sqlx.Tx, albumId int) (err error) {
+	log.Debug().Int("albumId", albumId).Msg("Updating album compilation flag from its songs")
+
+	err = s.AlbumRepo.UpdateCompilationFromSongs(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to update album compilation flag")
+		return err
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Album compilation flag updated successfully")
+	return nil
+}
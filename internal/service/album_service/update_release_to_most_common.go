@@ -0,0 +1,19 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateReleaseToMostCommon(tx *sqlx.Tx, albumId int) (err error) {
+	log.Debug().Int("albumId", albumId).Msg("Updating album release to the most common one")
+
+	err = s.AlbumRepo.UpdateReleaseToMostCommon(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to update album release")
+		return err
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Album release updated successfully")
+	return nil
+}
@@ -0,0 +1,19 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateSort(tx *sqlx.Tx, albumId int, sortTitle *string, sortKey string) (err error) {
+	log.Debug().Int("albumId", albumId).Msg("Updating album sort title")
+
+	err = s.AlbumRepo.UpdateSort(tx, albumId, sortTitle, sortKey)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to update album sort title")
+		return err
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Album sort title updated successfully")
+	return nil
+}
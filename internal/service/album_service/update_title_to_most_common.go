@@ -0,0 +1,19 @@
+package album_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateTitleToMostCommon(tx *sqlx.Tx, albumId int) (err error) {
+	log.Debug().Int("albumId", albumId).Msg("Updating album title to the most common spelling")
+
+	err = s.AlbumRepo.UpdateTitleToMostCommon(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to update album title")
+		return err
+	}
+
+	log.Debug().Int("albumId", albumId).Msg("Album title updated successfully")
+	return nil
+}
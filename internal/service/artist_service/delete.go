@@ -0,0 +1,19 @@
+package artist_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) Delete(tx *sqlx.Tx, artistId int) (err error) {
+	log.Debug().Int("artistId", artistId).Msg("Deleting artist")
+
+	err = s.ArtistRepo.Delete(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to delete artist")
+		return err
+	}
+
+	log.Debug().Int("artistId", artistId).Msg("Artist deleted successfully")
+	return nil
+}
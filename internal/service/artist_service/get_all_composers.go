@@ -0,0 +1,20 @@
+package artist_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAllComposers(tx *sqlx.Tx) (artists []model.Artist, err error) {
+	log.Debug().Msg("Getting all composers")
+
+	artists, err = s.ArtistRepo.ReadAllComposers(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get composers")
+		return make([]model.Artist, 0), err
+	}
+
+	log.Debug().Int("countOfComposers", len(artists)).Msg("Composers got successfully")
+	return artists, nil
+}
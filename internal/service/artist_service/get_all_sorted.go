@@ -0,0 +1,27 @@
+package artist_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"slices"
+)
+
+func (s Service) GetAllSorted(tx *sqlx.Tx, order model.SortOrder) (artists []model.Artist, err error) {
+	log.Debug().Str("sort", order.Field).Bool("descending", order.Descending).Msg("Getting all artists sorted")
+
+	if !slices.Contains(model.ArtistSortFields, order.Field) {
+		return make([]model.Artist, 0), errors.Invalid{Message: fmt.Sprintf("artists cannot be sorted by %q", order.Field)}
+	}
+
+	artists, err = s.ArtistRepo.ReadAllSorted(tx, order)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sorted artists")
+		return make([]model.Artist, 0), err
+	}
+
+	log.Debug().Int("count", len(artists)).Msg("Sorted artists got successfully")
+	return artists, nil
+}
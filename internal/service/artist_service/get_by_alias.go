@@ -0,0 +1,27 @@
+package artist_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetByAlias returns the artist the alias resolves to
+func (s Service) GetByAlias(tx *sqlx.Tx, alias string) (artist model.Artist, err error) {
+	log.Debug().Str("alias", alias).Msg("Getting artist by alias")
+
+	artistAlias, err := s.ArtistAliasRepo.ReadByAlias(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to get artist alias")
+		return model.Artist{}, err
+	}
+
+	artist, err = s.ArtistRepo.Read(tx, artistAlias.ArtistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistAlias.ArtistId).Msg("Failed to get artist")
+		return model.Artist{}, err
+	}
+
+	log.Debug().Interface("artist", artist).Msg("Artist got by alias successfully")
+	return artist, nil
+}
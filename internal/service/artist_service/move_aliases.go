@@ -0,0 +1,40 @@
+package artist_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// MoveAliases hands the aliases of fromArtist over to toArtist and makes the name of fromArtist one
+// of them, so scanned names of an artist merged into toArtist keep resolving to it
+func (s Service) MoveAliases(tx *sqlx.Tx, fromArtist model.Artist, toArtist model.Artist) (err error) {
+	log.Debug().Int("fromArtistId", fromArtist.ArtistId).Int("toArtistId", toArtist.ArtistId).Msg("Moving artist aliases")
+
+	err = s.ArtistAliasRepo.UpdateArtistId(tx, fromArtist.ArtistId, toArtist.ArtistId)
+	if err != nil {
+		log.Error().Err(err).Int("fromArtistId", fromArtist.ArtistId).Msg("Failed to move artist aliases")
+		return err
+	}
+
+	if fromArtist.NameKey != toArtist.NameKey {
+		exists, err := s.ArtistAliasRepo.IsExistsByAlias(tx, fromArtist.Name)
+		if err != nil {
+			log.Error().Err(err).Str("alias", fromArtist.Name).Msg("Failed to check artist alias existence")
+			return err
+		}
+		if !exists {
+			err = s.ArtistAliasRepo.Create(tx, model.ArtistAlias{
+				Alias:    fromArtist.Name,
+				ArtistId: toArtist.ArtistId,
+			})
+			if err != nil {
+				log.Error().Err(err).Str("alias", fromArtist.Name).Msg("Failed to create artist alias")
+				return err
+			}
+		}
+	}
+
+	log.Debug().Int("fromArtistId", fromArtist.ArtistId).Int("toArtistId", toArtist.ArtistId).Msg("Artist aliases moved successfully")
+	return nil
+}
@@ -1,17 +1,20 @@
 package artist_service
 
 import (
+	"music-metadata/internal/database/repository/artist_alias_repo"
 	"music-metadata/internal/database/repository/artist_repo"
 )
 
 type Service struct {
-	ArtistRepo artist_repo.Repo
+	ArtistRepo      artist_repo.Repo
+	ArtistAliasRepo artist_alias_repo.Repo
 }
 
-func NewService(artistRepo artist_repo.Repo) (s *Service) {
+func NewService(artistRepo artist_repo.Repo, artistAliasRepo artist_alias_repo.Repo) (s *Service) {
 
 	s = &Service{
-		ArtistRepo: artistRepo,
+		ArtistRepo:      artistRepo,
+		ArtistAliasRepo: artistAliasRepo,
 	}
 
 	return s
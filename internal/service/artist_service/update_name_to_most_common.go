@@ -0,0 +1,19 @@
+package artist_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateNameToMostCommon(tx *sqlx.Tx, artistId int) (err error) {
+	log.Debug().Int("artistId", artistId).Msg("Updating artist name to the most common spelling")
+
+	err = s.ArtistRepo.UpdateNameToMostCommon(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to update artist name")
+		return err
+	}
+
+	log.Debug().Int("artistId", artistId).Msg("Artist name updated successfully")
+	return nil
+}
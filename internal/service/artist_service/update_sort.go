@@ -0,0 +1,19 @@
+package artist_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateSort(tx *sqlx.Tx, artistId int, sortName *string, sortKey string) (err error) {
+	log.Debug().Int("artistId", artistId).Msg("Updating artist sort name")
+
+	err = s.ArtistRepo.UpdateSort(tx, artistId, sortName, sortKey)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to update artist sort name")
+		return err
+	}
+
+	log.Debug().Int("artistId", artistId).Msg("Artist sort name updated successfully")
+	return nil
+}
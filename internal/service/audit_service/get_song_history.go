@@ -0,0 +1,36 @@
+package audit_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetSongHistory returns the change sets that changed the song, its artists, genres, overrides or splits,
+// oldest first, each with only the entries concerning the song. Songs removed since keep their history
+func (s Service) GetSongHistory(tx *sqlx.Tx, songId int) (changeSets []model.ChangeSet, err error) {
+	log.Debug().Int("songId", songId).Msg("Getting song history")
+
+	auditEntries, err := s.AuditRepo.ReadAllEntriesBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get audit entries of song")
+		return make([]model.ChangeSet, 0), err
+	}
+
+	changeSets = make([]model.ChangeSet, 0)
+	for _, auditEntry := range auditEntries {
+		if len(changeSets) == 0 || changeSets[len(changeSets)-1].ChangeSetId != auditEntry.ChangeSetId {
+			changeSet, err := s.AuditRepo.ReadChangeSet(tx, auditEntry.ChangeSetId)
+			if err != nil {
+				log.Error().Err(err).Int("changeSetId", auditEntry.ChangeSetId).Msg("Failed to get change set")
+				return make([]model.ChangeSet, 0), err
+			}
+			changeSets = append(changeSets, changeSet)
+		}
+		changeSet := &changeSets[len(changeSets)-1]
+		changeSet.Entries = append(changeSet.Entries, auditEntry)
+	}
+
+	log.Debug().Int("songId", songId).Int("countOfChangeSets", len(changeSets)).Msg("Song history got successfully")
+	return changeSets, nil
+}
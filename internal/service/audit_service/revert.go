@@ -0,0 +1,84 @@
+package audit_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// Revert undoes every change of the change set, newest first, in a new change set of its own. A change set
+// can be reverted once and only while the rows it changed were not changed again since
+func (s Service) Revert(tx *sqlx.Tx, changeSetId int) (changeSet model.ChangeSet, err error) {
+	log.Debug().Int("changeSetId", changeSetId).Msg("Reverting change set")
+
+	exists, err := s.AuditRepo.IsChangeSetExists(tx, changeSetId)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to check change set existence")
+		return model.ChangeSet{}, err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("change set with id=%d", changeSetId)}
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Change set not found")
+		return model.ChangeSet{}, err
+	}
+
+	reverted, err := s.AuditRepo.IsChangeSetReverted(tx, changeSetId)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to check change set revert")
+		return model.ChangeSet{}, err
+	}
+	if reverted {
+		err = errors.Conflict{Message: fmt.Sprintf("change set with id=%d is already reverted", changeSetId)}
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Change set already reverted")
+		return model.ChangeSet{}, err
+	}
+	changed, err := s.AuditRepo.IsChangedAfter(tx, changeSetId)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to check later changes")
+		return model.ChangeSet{}, err
+	}
+	if changed {
+		err = errors.Conflict{Message: fmt.Sprintf("rows of change set with id=%d were changed again since", changeSetId)}
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Change set overridden by later changes")
+		return model.ChangeSet{}, err
+	}
+
+	auditEntries, err := s.AuditRepo.ReadAllEntriesByChangeSetId(tx, changeSetId)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to get audit entries of change set")
+		return model.ChangeSet{}, err
+	}
+
+	revertChangeSetId, err := s.AuditRepo.CreateChangeSet(tx, model.ChangeSet{
+		Source:              model.ChangeSourceRevert,
+		RevertedChangeSetId: &changeSetId,
+	})
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", changeSetId).Msg("Failed to create revert change set")
+		return model.ChangeSet{}, err
+	}
+
+	for i := len(auditEntries) - 1; i >= 0; i-- {
+		err = s.AuditRepo.RevertEntry(tx, auditEntries[i])
+		if err != nil {
+			log.Error().Err(err).Int("auditEntryId", auditEntries[i].AuditEntryId).Msg("Failed to revert audit entry")
+			return model.ChangeSet{}, err
+		}
+	}
+
+	changeSet, err = s.AuditRepo.ReadChangeSet(tx, revertChangeSetId)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", revertChangeSetId).Msg("Failed to get revert change set")
+		return model.ChangeSet{}, err
+	}
+	changeSet.Entries, err = s.AuditRepo.ReadAllEntriesByChangeSetId(tx, revertChangeSetId)
+	if err != nil {
+		log.Error().Err(err).Int("changeSetId", revertChangeSetId).Msg("Failed to get audit entries of revert change set")
+		return model.ChangeSet{}, err
+	}
+
+	log.Info().Int("changeSetId", changeSetId).Int("revertChangeSetId", revertChangeSetId).Msg("Change set reverted successfully")
+	return changeSet, nil
+}
@@ -0,0 +1,23 @@
+package audit_service
+
+import (
+	"music-metadata/internal/database/repository/audit_repo"
+	"music-metadata/internal/service"
+)
+
+type Service struct {
+	AuditRepo audit_repo.Repo
+
+	TransactionManager service.TransactionManager
+}
+
+func NewService(auditRepo audit_repo.Repo,
+	transactionManager service.TransactionManager) (s *Service) {
+
+	s = &Service{
+		AuditRepo:          auditRepo,
+		TransactionManager: transactionManager,
+	}
+
+	return s
+}
@@ -0,0 +1,44 @@
+package audit_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"time"
+)
+
+// cleanupInterval is how often expired change sets are deleted
+const cleanupInterval = 24 * time.Hour
+
+// StartCleanup deletes the change sets older than the retention now and then daily in the background.
+// A zero retention keeps the audit log whole
+func (s Service) StartCleanup(retention time.Duration) {
+	log.Debug().Dur("retention", retention).Msg("Starting audit log cleanup")
+
+	if retention <= 0 {
+		log.Info().Msg("Audit log cleanup is disabled")
+		return
+	}
+
+	go func() {
+		for {
+			s.deleteExpired(retention)
+			time.Sleep(cleanupInterval)
+		}
+	}()
+
+	log.Info().Dur("retention", retention).Msg("Audit log cleanup started successfully")
+}
+
+func (s Service) deleteExpired(retention time.Duration) {
+	err := s.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		deleted, err := s.AuditRepo.DeleteAllChangeSetsBefore(tx, time.Now().Add(-retention))
+		if err != nil {
+			return err
+		}
+		log.Info().Int64("count", deleted).Msg("Expired change sets deleted")
+		return nil
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to delete expired change sets")
+	}
+}
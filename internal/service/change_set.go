@@ -0,0 +1,19 @@
+package service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// StartChangeSet makes the following changes of tx a new change set of the audit log with the source.
+// The change set is only created with the first change, so operations changing nothing leave no trace
+func StartChangeSet(tx *sqlx.Tx, source model.ChangeSource) (err error) {
+	_, err = tx.Exec(`SELECT set_config('music_metadata.change_source', $1, TRUE),
+		set_config('music_metadata.change_set_id', '', TRUE)`, string(source))
+	if err != nil {
+		log.Error().Err(err).Str("source", string(source)).Msg("Failed to start a change set")
+		return err
+	}
+	return nil
+}
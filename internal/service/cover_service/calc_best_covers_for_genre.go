@@ -21,7 +21,7 @@ func (s Service) CalcBestCoversForGenre(tx *sqlx.Tx, genreId int) (bestCovers []
 		return make([]int, 0), err
 	}
 
-	songs, err := s.SongService.GetAllByGenreId(tx, genreId)
+	songs, err := s.SongService.GetAllByGenreId(tx, genreId, false)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get genre's songs")
 		return make([]int, 0), err
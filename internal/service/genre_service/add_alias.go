@@ -0,0 +1,49 @@
+package genre_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// AddAlias makes scanned genre names equal to the alias resolve to the genre. Genres that were
+// already scanned under the alias name are merged by the song service
+func (s Service) AddAlias(tx *sqlx.Tx, genreId int, alias string) (err error) {
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Adding genre alias")
+
+	exists, err := s.IsExists(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to check existence")
+		return err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("genre with id=%d", genreId)}
+		log.Error().Err(err).Int("genreId", genreId).Msg("Genre not found")
+		return err
+	}
+
+	exists, err = s.GenreAliasRepo.IsExistsByAlias(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to check genre alias existence")
+		return err
+	}
+	if exists {
+		err = errors.Conflict{Message: fmt.Sprintf("genre alias %q already exists", alias)}
+		log.Error().Err(err).Str("alias", alias).Msg("Genre alias already exists")
+		return err
+	}
+
+	err = s.GenreAliasRepo.Create(tx, model.GenreAlias{
+		Alias:   alias,
+		GenreId: genreId,
+	})
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Str("alias", alias).Msg("Failed to create genre alias")
+		return err
+	}
+
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Genre alias added successfully")
+	return nil
+}
@@ -0,0 +1,19 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) Delete(tx *sqlx.Tx, genreId int) (err error) {
+	log.Debug().Int("genreId", genreId).Msg("Deleting genre")
+
+	err = s.GenreRepo.Delete(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to delete genre")
+		return err
+	}
+
+	log.Debug().Int("genreId", genreId).Msg("Genre deleted successfully")
+	return nil
+}
@@ -0,0 +1,33 @@
+package genre_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAliases(tx *sqlx.Tx, genreId int) (genreAliases []model.GenreAlias, err error) {
+	log.Debug().Int("genreId", genreId).Msg("Getting genre aliases")
+
+	exists, err := s.IsExists(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to check existence")
+		return make([]model.GenreAlias, 0), err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("genre with id=%d", genreId)}
+		log.Error().Err(err).Int("genreId", genreId).Msg("Genre not found")
+		return make([]model.GenreAlias, 0), err
+	}
+
+	genreAliases, err = s.GenreAliasRepo.ReadAllByGenreId(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to get genre aliases")
+		return make([]model.GenreAlias, 0), err
+	}
+
+	log.Debug().Int("genreId", genreId).Int("countOfAliases", len(genreAliases)).Msg("Genre aliases got successfully")
+	return genreAliases, nil
+}
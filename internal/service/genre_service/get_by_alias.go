@@ -0,0 +1,27 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetByAlias returns the genre the alias resolves to
+func (s Service) GetByAlias(tx *sqlx.Tx, alias string) (genre model.Genre, err error) {
+	log.Debug().Str("alias", alias).Msg("Getting genre by alias")
+
+	genreAlias, err := s.GenreAliasRepo.ReadByAlias(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to get genre alias")
+		return model.Genre{}, err
+	}
+
+	genre, err = s.GenreRepo.Read(tx, genreAlias.GenreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreAlias.GenreId).Msg("Failed to get genre")
+		return model.Genre{}, err
+	}
+
+	log.Debug().Interface("genre", genre).Msg("Genre got by alias successfully")
+	return genre, nil
+}
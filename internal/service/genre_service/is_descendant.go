@@ -0,0 +1,31 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// isDescendant tells whether the genre is a subgenre of ancestorId at any depth. The walk stops at a genre
+// it has already visited, so a hierarchy that contains a cycle does not hang it
+func (s Service) isDescendant(tx *sqlx.Tx, genreId int, ancestorId int) (descendant bool, err error) {
+	genre, err := s.GenreRepo.Read(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to get genre")
+		return false, err
+	}
+
+	visited := map[int]bool{genreId: true}
+	for parentId := genre.ParentGenreId; parentId != nil && !visited[*parentId]; {
+		if *parentId == ancestorId {
+			return true, nil
+		}
+		visited[*parentId] = true
+		parent, err := s.GenreRepo.Read(tx, *parentId)
+		if err != nil {
+			log.Error().Err(err).Int("genreId", *parentId).Msg("Failed to get parent genre")
+			return false, err
+		}
+		parentId = parent.ParentGenreId
+	}
+	return false, nil
+}
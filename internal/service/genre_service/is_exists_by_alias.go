@@ -0,0 +1,19 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) IsExistsByAlias(tx *sqlx.Tx, alias string) (exists bool, err error) {
+	log.Debug().Str("alias", alias).Msg("Checking genre alias existence")
+
+	exists, err = s.GenreAliasRepo.IsExistsByAlias(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to check genre alias existence")
+		return false, err
+	}
+
+	log.Debug().Str("alias", alias).Bool("exists", exists).Msg("Genre alias existence checked successfully")
+	return exists, nil
+}
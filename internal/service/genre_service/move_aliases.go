@@ -0,0 +1,40 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// MoveAliases hands the aliases of fromGenre over to toGenre and makes the name of fromGenre one
+// of them, so scanned names of a genre merged into toGenre keep resolving to it
+func (s Service) MoveAliases(tx *sqlx.Tx, fromGenre model.Genre, toGenre model.Genre) (err error) {
+	log.Debug().Int("fromGenreId", fromGenre.GenreId).Int("toGenreId", toGenre.GenreId).Msg("Moving genre aliases")
+
+	err = s.GenreAliasRepo.UpdateGenreId(tx, fromGenre.GenreId, toGenre.GenreId)
+	if err != nil {
+		log.Error().Err(err).Int("fromGenreId", fromGenre.GenreId).Msg("Failed to move genre aliases")
+		return err
+	}
+
+	if fromGenre.NameKey != toGenre.NameKey {
+		exists, err := s.GenreAliasRepo.IsExistsByAlias(tx, fromGenre.Name)
+		if err != nil {
+			log.Error().Err(err).Str("alias", fromGenre.Name).Msg("Failed to check genre alias existence")
+			return err
+		}
+		if !exists {
+			err = s.GenreAliasRepo.Create(tx, model.GenreAlias{
+				Alias:   fromGenre.Name,
+				GenreId: toGenre.GenreId,
+			})
+			if err != nil {
+				log.Error().Err(err).Str("alias", fromGenre.Name).Msg("Failed to create genre alias")
+				return err
+			}
+		}
+	}
+
+	log.Debug().Int("fromGenreId", fromGenre.GenreId).Int("toGenreId", toGenre.GenreId).Msg("Genre aliases moved successfully")
+	return nil
+}
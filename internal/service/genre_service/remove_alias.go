@@ -0,0 +1,42 @@
+package genre_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+)
+
+func (s Service) RemoveAlias(tx *sqlx.Tx, genreId int, alias string) (err error) {
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Removing genre alias")
+
+	exists, err := s.GenreAliasRepo.IsExistsByAlias(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to check genre alias existence")
+		return err
+	}
+	notFound := errors.NotFound{Resource: fmt.Sprintf("alias %q of genre with id=%d", alias, genreId)}
+	if !exists {
+		log.Error().Err(notFound).Str("alias", alias).Msg("Genre alias not found")
+		return notFound
+	}
+
+	genreAlias, err := s.GenreAliasRepo.ReadByAlias(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to get genre alias")
+		return err
+	}
+	if genreAlias.GenreId != genreId {
+		log.Error().Err(notFound).Str("alias", alias).Int("genreId", genreAlias.GenreId).Msg("Genre alias belongs to another genre")
+		return notFound
+	}
+
+	err = s.GenreAliasRepo.Delete(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to delete genre alias")
+		return err
+	}
+
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Genre alias removed successfully")
+	return nil
+}
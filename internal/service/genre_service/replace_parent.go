@@ -0,0 +1,40 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// ReplaceParent places the subgenres of fromGenreId under toGenreId, as when the genre is merged into
+// another one. If toGenreId is itself a subgenre of fromGenreId, it first takes the place of fromGenreId
+// in the hierarchy, so that it does not end up under one of its own subgenres
+func (s Service) ReplaceParent(tx *sqlx.Tx, fromGenreId int, toGenreId int) (err error) {
+	log.Debug().Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Replacing parent genre")
+
+	descendant, err := s.isDescendant(tx, toGenreId, fromGenreId)
+	if err != nil {
+		log.Error().Err(err).Int("toGenreId", toGenreId).Msg("Failed to check genre hierarchy")
+		return err
+	}
+	if descendant {
+		fromGenre, err := s.GenreRepo.Read(tx, fromGenreId)
+		if err != nil {
+			log.Error().Err(err).Int("fromGenreId", fromGenreId).Msg("Failed to get genre")
+			return err
+		}
+		err = s.GenreRepo.UpdateParent(tx, toGenreId, fromGenre.ParentGenreId)
+		if err != nil {
+			log.Error().Err(err).Int("toGenreId", toGenreId).Msg("Failed to update genre parent")
+			return err
+		}
+	}
+
+	err = s.GenreRepo.ReplaceParentGenreId(tx, fromGenreId, toGenreId)
+	if err != nil {
+		log.Error().Err(err).Int("fromGenreId", fromGenreId).Msg("Failed to replace parent genre")
+		return err
+	}
+
+	log.Debug().Int("fromGenreId", fromGenreId).Int("toGenreId", toGenreId).Msg("Parent genre replaced successfully")
+	return nil
+}
@@ -1,15 +1,20 @@
 package genre_service
 
-import "music-metadata/internal/database/repository/genre_repo"
+import (
+	"music-metadata/internal/database/repository/genre_alias_repo"
+	"music-metadata/internal/database/repository/genre_repo"
+)
 
 type Service struct {
-	GenreRepo genre_repo.Repo
+	GenreRepo      genre_repo.Repo
+	GenreAliasRepo genre_alias_repo.Repo
 }
 
-func NewService(genreRepo genre_repo.Repo) (s *Service) {
+func NewService(genreRepo genre_repo.Repo, genreAliasRepo genre_alias_repo.Repo) (s *Service) {
 
 	s = &Service{
-		GenreRepo: genreRepo,
+		GenreRepo:      genreRepo,
+		GenreAliasRepo: genreAliasRepo,
 	}
 
 	return s
@@ -0,0 +1,55 @@
+package genre_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+)
+
+// SetParent places the genre under another genre, or at the top of the hierarchy if parentGenreId is nil.
+// A genre cannot be placed under itself or under one of its subgenres
+func (s Service) SetParent(tx *sqlx.Tx, genreId int, parentGenreId *int) (err error) {
+	log.Debug().Int("genreId", genreId).Interface("parentGenreId", parentGenreId).Msg("Setting genre parent")
+
+	for _, id := range []*int{&genreId, parentGenreId} {
+		if id == nil {
+			continue
+		}
+		exists, err := s.IsExists(tx, *id)
+		if err != nil {
+			log.Error().Err(err).Int("genreId", *id).Msg("Failed to check existence")
+			return err
+		}
+		if !exists {
+			err = errors.NotFound{Resource: fmt.Sprintf("genre with id=%d", *id)}
+			log.Error().Err(err).Int("genreId", *id).Msg("Genre not found")
+			return err
+		}
+	}
+
+	if parentGenreId != nil {
+		descendant := *parentGenreId == genreId
+		if !descendant {
+			descendant, err = s.isDescendant(tx, *parentGenreId, genreId)
+			if err != nil {
+				log.Error().Err(err).Int("genreId", genreId).Msg("Failed to check genre hierarchy")
+				return err
+			}
+		}
+		if descendant {
+			err = errors.Conflict{Message: fmt.Sprintf("genre with id=%d cannot be placed under its own subgenre", genreId)}
+			log.Error().Err(err).Int("genreId", genreId).Msg("Genre hierarchy would contain a cycle")
+			return err
+		}
+	}
+
+	err = s.GenreRepo.UpdateParent(tx, genreId, parentGenreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to update genre parent")
+		return err
+	}
+
+	log.Debug().Int("genreId", genreId).Interface("parentGenreId", parentGenreId).Msg("Genre parent set successfully")
+	return nil
+}
@@ -0,0 +1,19 @@
+package genre_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) UpdateNameToMostCommon(tx *sqlx.Tx, genreId int) (err error) {
+	log.Debug().Int("genreId", genreId).Msg("Updating genre name to the most common spelling")
+
+	err = s.GenreRepo.UpdateNameToMostCommon(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to update genre name")
+		return err
+	}
+
+	log.Debug().Int("genreId", genreId).Msg("Genre name updated successfully")
+	return nil
+}
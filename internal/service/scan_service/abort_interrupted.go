@@ -0,0 +1,20 @@
+package scan_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// AbortInterrupted marks jobs that were left running by a previous process as failed
+func (s Service) AbortInterrupted(tx *sqlx.Tx) (err error) {
+	log.Debug().Msg("Aborting interrupted scan jobs")
+
+	err = s.ScanJobRepo.FailRunning(tx, "scan was interrupted by service restart")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to abort interrupted scan jobs")
+		return err
+	}
+
+	log.Debug().Msg("Interrupted scan jobs aborted successfully")
+	return nil
+}
@@ -0,0 +1,25 @@
+package scan_service
+
+import (
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// ApplyEvents reconciles the songs of the audio files music-files reported as changed. It runs a scan job
+// in event mode and waits for it, so the caller learns whether the changes were applied. Applying the same
+// changes twice does nothing the second time, because the songs are compared with the current audio files
+func (s Service) ApplyEvents(audioFileIds []int) (scanJob model.ScanJob, err error) {
+	log.Debug().Ints("audioFileIds", audioFileIds).Msg("Applying audio file events")
+
+	s.state.events.Lock()
+	defer s.state.events.Unlock()
+
+	scanJob, err = s.begin(model.ScanModeEvent, false)
+	if err != nil {
+		return model.ScanJob{}, err
+	}
+	scanJob = s.run(scanJob.ScanJobId, audioFileIds)
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Str("status", string(scanJob.Status)).Msg("Audio file events applied")
+	return scanJob, nil
+}
@@ -0,0 +1,30 @@
+package scan_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAllJobs(tx *sqlx.Tx) (scanJobs []model.ScanJob, err error) {
+	log.Debug().Msg("Getting all scan jobs")
+
+	scanJobs, err = s.ScanJobRepo.ReadAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get all scan jobs")
+		return make([]model.ScanJob, 0), err
+	}
+
+	s.state.mutex.Lock()
+	if s.state.active != nil {
+		for i := range scanJobs {
+			if scanJobs[i].ScanJobId == s.state.active.ScanJobId {
+				scanJobs[i] = *s.state.active
+			}
+		}
+	}
+	s.state.mutex.Unlock()
+
+	log.Debug().Int("countOfScanJobs", len(scanJobs)).Msg("All scan jobs got successfully")
+	return scanJobs, nil
+}
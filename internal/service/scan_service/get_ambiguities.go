@@ -0,0 +1,33 @@
+package scan_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAmbiguities(tx *sqlx.Tx, scanJobId int) (scanAmbiguities []model.ScanAmbiguity, err error) {
+	log.Debug().Int("scanJobId", scanJobId).Msg("Getting scan ambiguities")
+
+	exists, err := s.ScanJobRepo.IsExists(tx, scanJobId)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to check scan job existence")
+		return make([]model.ScanAmbiguity, 0), err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("scan job with id=%d", scanJobId)}
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Scan job not found")
+		return make([]model.ScanAmbiguity, 0), err
+	}
+
+	scanAmbiguities, err = s.ScanAmbiguityRepo.ReadAllByScanJobId(tx, scanJobId)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to get scan ambiguities")
+		return make([]model.ScanAmbiguity, 0), err
+	}
+
+	log.Debug().Int("scanJobId", scanJobId).Int("countOfScanAmbiguities", len(scanAmbiguities)).Msg("Scan ambiguities got successfully")
+	return scanAmbiguities, nil
+}
@@ -0,0 +1,34 @@
+package scan_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// GetDiff returns the changes planned by a dry-run scan job
+func (s Service) GetDiff(tx *sqlx.Tx, scanJobId int) (scanDiff model.ScanDiff, err error) {
+	log.Debug().Int("scanJobId", scanJobId).Msg("Getting scan diff")
+
+	exists, err := s.ScanDiffRepo.IsExists(tx, scanJobId)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to check scan diff existence")
+		return model.ScanDiff{}, err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("scan diff of scan job with id=%d", scanJobId)}
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Scan diff not found")
+		return model.ScanDiff{}, err
+	}
+
+	scanDiff, err = s.ScanDiffRepo.Read(tx, scanJobId)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to get scan diff")
+		return model.ScanDiff{}, err
+	}
+
+	log.Debug().Int("scanJobId", scanJobId).Msg("Scan diff got successfully")
+	return scanDiff, nil
+}
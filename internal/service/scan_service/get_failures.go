@@ -0,0 +1,20 @@
+package scan_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetFailures(tx *sqlx.Tx) (scanFailures []model.ScanFailure, err error) {
+	log.Debug().Msg("Getting scan failures")
+
+	scanFailures, err = s.ScanFailureRepo.ReadAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get scan failures")
+		return make([]model.ScanFailure, 0), err
+	}
+
+	log.Debug().Int("countOfScanFailures", len(scanFailures)).Msg("Scan failures got successfully")
+	return scanFailures, nil
+}
@@ -0,0 +1,42 @@
+package scan_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetJob(tx *sqlx.Tx, scanJobId int) (scanJob model.ScanJob, err error) {
+	log.Debug().Int("scanJobId", scanJobId).Msg("Getting scan job")
+
+	s.state.mutex.Lock()
+	if s.state.active != nil && s.state.active.ScanJobId == scanJobId {
+		scanJob = *s.state.active
+		s.state.mutex.Unlock()
+		log.Debug().Interface("scanJob", scanJob).Msg("Active scan job got successfully")
+		return scanJob, nil
+	}
+	s.state.mutex.Unlock()
+
+	exists, err := s.ScanJobRepo.IsExists(tx, scanJobId)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to check scan job existence")
+		return model.ScanJob{}, err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("scan job with id=%d", scanJobId)}
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Scan job not found")
+		return model.ScanJob{}, err
+	}
+
+	scanJob, err = s.ScanJobRepo.Read(tx, scanJobId)
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Failed to get scan job")
+		return model.ScanJob{}, err
+	}
+
+	log.Debug().Interface("scanJob", scanJob).Msg("Scan job got successfully")
+	return scanJob, nil
+}
@@ -0,0 +1,17 @@
+package scan_service
+
+import (
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetSchedule() (schedule model.ScanSchedule) {
+	log.Debug().Msg("Getting scan schedule")
+
+	s.state.mutex.Lock()
+	schedule = s.state.schedule
+	s.state.mutex.Unlock()
+
+	log.Debug().Interface("schedule", schedule).Msg("Scan schedule got successfully")
+	return schedule
+}
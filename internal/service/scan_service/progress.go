@@ -0,0 +1,52 @@
+package scan_service
+
+import (
+	"music-metadata/internal/model"
+)
+
+// jobProgress tracks the active scan job in memory and persists it on every phase change
+type jobProgress struct {
+	service Service
+}
+
+func (p jobProgress) PhaseStarted(phase model.ScanPhase) {
+	p.service.state.mutex.Lock()
+	p.service.state.active.Phase = phase
+	scanJob := *p.service.state.active
+	p.service.state.mutex.Unlock()
+
+	p.service.persist(scanJob)
+}
+
+func (p jobProgress) FilesPlanned(total int) {
+	p.service.state.mutex.Lock()
+	defer p.service.state.mutex.Unlock()
+
+	p.service.state.active.TotalFiles = total
+}
+
+func (p jobProgress) FileProcessed() {
+	p.service.state.mutex.Lock()
+	defer p.service.state.mutex.Unlock()
+
+	active := p.service.state.active
+	active.ProcessedFiles++
+	switch active.Phase {
+	case model.ScanPhaseCreating:
+		active.CreatedCount++
+	case model.ScanPhaseRemoving:
+		active.RemovedCount++
+	case model.ScanPhaseMoving:
+		active.MovedCount++
+	case model.ScanPhaseChanging:
+		active.ChangedCount++
+	}
+}
+
+func (p jobProgress) FileFailed() {
+	p.service.state.mutex.Lock()
+	defer p.service.state.mutex.Unlock()
+
+	p.service.state.active.ProcessedFiles++
+	p.service.state.active.FailedCount++
+}
@@ -0,0 +1,208 @@
+package scan_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/song_service"
+	"time"
+)
+
+// run executes the active scan job and returns it finished. audioFileIds limit a scan in event or targeted mode
+func (s Service) run(scanJobId int, audioFileIds []int) (scanJob model.ScanJob) {
+	log.Info().Int("scanJobId", scanJobId).Msg("Scan job running")
+
+	progress := jobProgress{service: s}
+
+	s.state.mutex.Lock()
+	mode := s.state.active.Mode
+	dryRun := s.state.active.DryRun
+	s.state.mutex.Unlock()
+
+	result, err := s.runScan(scanJobId, mode, dryRun, audioFileIds, progress)
+
+	s.state.mutex.Lock()
+	finishedAt := time.Now().UTC()
+	s.state.active.FinishedAt = &finishedAt
+	if err != nil {
+		reason := err.Error()
+		s.state.active.Status = model.ScanJobStatusFailed
+		s.state.active.Error = &reason
+	} else {
+		s.state.active.Status = model.ScanJobStatusSucceeded
+		s.state.active.ContentHighWaterMark = result.ContentHighWaterMark
+	}
+	scanJob = *s.state.active
+	s.state.mutex.Unlock()
+
+	s.persist(scanJob)
+
+	s.state.mutex.Lock()
+	s.state.active = nil
+	s.state.mutex.Unlock()
+
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJobId).Msg("Scan job failed")
+		return scanJob
+	}
+	log.Info().Int("scanJobId", scanJobId).Msg("Scan job finished successfully")
+	return scanJob
+}
+
+func (s Service) runScan(scanJobId int, mode model.ScanMode, dryRun bool, audioFileIds []int,
+	progress jobProgress) (result song_service.ScanResult, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("scan panicked: %v", p)
+		}
+	}()
+
+	withTransaction := s.TransactionManager.WithTransaction
+	if dryRun {
+		withTransaction = s.TransactionManager.WithRolledBackTransaction
+	}
+
+	err = withTransaction(func(tx *sqlx.Tx) (err error) {
+		source := model.ChangeSourceScan
+		if mode == model.ScanModeEvent {
+			source = model.ChangeSourceEvent
+		}
+		err = service.StartChangeSet(tx, source)
+		if err != nil {
+			return err
+		}
+
+		options := song_service.ScanOptions{
+			Mode:         mode,
+			DryRun:       dryRun,
+			AudioFileIds: audioFileIds,
+		}
+		if mode == model.ScanModeIncremental {
+			options.ContentUpdatedSince, err = s.ScanJobRepo.ReadContentHighWaterMark(tx)
+			if err != nil {
+				return err
+			}
+		}
+		if mode == model.ScanModeRetry {
+			scanFailures, err := s.ScanFailureRepo.ReadAll(tx)
+			if err != nil {
+				return err
+			}
+			options.AudioFileIds = make([]int, len(scanFailures))
+			for i, scanFailure := range scanFailures {
+				options.AudioFileIds[i] = scanFailure.AudioFileId
+			}
+		}
+
+		if mode == model.ScanModeTargeted {
+			result, err = s.rescan(tx, audioFileIds, progress)
+		} else {
+			result, err = s.SongService.Scan(tx, options, progress)
+		}
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			return nil
+		}
+		return s.saveReport(tx, scanJobId, result, false)
+	})
+	if err != nil || !dryRun {
+		return result, err
+	}
+
+	// The changes of a dry run are rolled back, so its report is saved separately
+	err = s.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		return s.saveReport(tx, scanJobId, result, true)
+	})
+	return result, err
+}
+
+// rescan parses the audio files of the songs again. Songs removed since the job was requested are skipped
+func (s Service) rescan(tx *sqlx.Tx, audioFileIds []int, progress jobProgress) (result song_service.ScanResult, err error) {
+	songs := make([]model.Song, 0, len(audioFileIds))
+	for _, audioFileId := range audioFileIds {
+		song, err := s.SongService.GetByAudioFileId(tx, audioFileId)
+		if _, ok := err.(errors.NotFound); ok {
+			continue
+		}
+		if err != nil {
+			return song_service.ScanResult{}, err
+		}
+		songs = append(songs, song)
+	}
+
+	return s.SongService.Rescan(tx, songs, progress)
+}
+
+// saveReport stores ambiguities, failures and the diff of the scan. Only a scan that was not rolled back
+// touches the quarantine, because a dry run neither persists its successes nor is meant to have effects
+func (s Service) saveReport(tx *sqlx.Tx, scanJobId int, result song_service.ScanResult, dryRun bool) (err error) {
+	for _, ambiguity := range result.Ambiguities {
+		ambiguity.ScanJobId = scanJobId
+		_, err = s.ScanAmbiguityRepo.Create(tx, ambiguity)
+		if err != nil {
+			return err
+		}
+	}
+	if result.Diff != nil {
+		err = s.ScanDiffRepo.Create(tx, scanJobId, *result.Diff)
+		if err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+	err = s.releaseResolved(tx, result.ResolvedAudioFileIds)
+	if err != nil {
+		return err
+	}
+	for _, failure := range result.Failures {
+		failure.ScanJobId = scanJobId
+		_, err = s.ScanFailureRepo.Save(tx, failure)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s Service) releaseResolved(tx *sqlx.Tx, resolvedAudioFileIds []int) (err error) {
+	scanFailures, err := s.ScanFailureRepo.ReadAll(tx)
+	if err != nil {
+		return err
+	}
+	if len(scanFailures) == 0 {
+		return nil
+	}
+
+	resolved := make(map[int]bool, len(resolvedAudioFileIds))
+	for _, audioFileId := range resolvedAudioFileIds {
+		resolved[audioFileId] = true
+	}
+	for _, scanFailure := range scanFailures {
+		if !resolved[scanFailure.AudioFileId] {
+			continue
+		}
+		err = s.ScanFailureRepo.DeleteByAudioFileId(tx, scanFailure.AudioFileId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s Service) persist(scanJob model.ScanJob) {
+	err := s.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		return s.ScanJobRepo.Update(tx, scanJob.ScanJobId, scanJob)
+	})
+	if err != nil {
+		log.Error().Err(err).Int("scanJobId", scanJob.ScanJobId).Msg("Failed to persist scan job")
+	}
+}
@@ -0,0 +1,56 @@
+package scan_service
+
+import (
+	"music-metadata/internal/database/repository/scan_ambiguity_repo"
+	"music-metadata/internal/database/repository/scan_diff_repo"
+	"music-metadata/internal/database/repository/scan_failure_repo"
+	"music-metadata/internal/database/repository/scan_job_repo"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"music-metadata/internal/service/song_service"
+	"sync"
+)
+
+type Service struct {
+	ScanJobRepo       scan_job_repo.Repo
+	ScanAmbiguityRepo scan_ambiguity_repo.Repo
+	ScanDiffRepo      scan_diff_repo.Repo
+	ScanFailureRepo   scan_failure_repo.Repo
+
+	SongService song_service.Service
+
+	TransactionManager service.TransactionManager
+
+	state *state
+}
+
+// state is shared between copies of the Service, so only one scan can run per process
+type state struct {
+	mutex    sync.Mutex
+	active   *model.ScanJob
+	schedule model.ScanSchedule
+	// stopSchedule is closed to stop the running schedule
+	stopSchedule chan struct{}
+	// events serializes the application of change notifications, so they wait for each other instead of conflicting
+	events sync.Mutex
+}
+
+func NewService(scanJobRepo scan_job_repo.Repo,
+	scanAmbiguityRepo scan_ambiguity_repo.Repo,
+	scanDiffRepo scan_diff_repo.Repo,
+	scanFailureRepo scan_failure_repo.Repo,
+	songService song_service.Service,
+	transactionManager service.TransactionManager) (s *Service) {
+
+	s = &Service{
+		ScanJobRepo:        scanJobRepo,
+		ScanAmbiguityRepo:  scanAmbiguityRepo,
+		ScanDiffRepo:       scanDiffRepo,
+		ScanFailureRepo:    scanFailureRepo,
+		SongService:        songService,
+		TransactionManager: transactionManager,
+		state:              &state{},
+	}
+
+	return s
+}
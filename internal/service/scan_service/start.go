@@ -0,0 +1,54 @@
+package scan_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"time"
+)
+
+func (s Service) Start(mode model.ScanMode, dryRun bool) (scanJob model.ScanJob, err error) {
+	log.Debug().Str("mode", string(mode)).Bool("dryRun", dryRun).Msg("Starting scan job")
+
+	scanJob, err = s.begin(mode, dryRun)
+	if err != nil {
+		return model.ScanJob{}, err
+	}
+	go s.run(scanJob.ScanJobId, nil)
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Msg("Scan job started successfully")
+	return scanJob, nil
+}
+
+// begin creates a scan job and makes it the active one, unless another scan is running
+func (s Service) begin(mode model.ScanMode, dryRun bool) (scanJob model.ScanJob, err error) {
+	s.state.mutex.Lock()
+	defer s.state.mutex.Unlock()
+
+	if s.state.active != nil {
+		err = errors.Conflict{Message: "scan is already running"}
+		log.Warn().Err(err).Int("activeScanJobId", s.state.active.ScanJobId).Msg("Scan is already running")
+		return model.ScanJob{}, err
+	}
+
+	scanJob = model.ScanJob{
+		Mode:      mode,
+		DryRun:    dryRun,
+		Status:    model.ScanJobStatusRunning,
+		Phase:     model.ScanPhasePreparing,
+		StartedAt: time.Now().UTC(),
+	}
+	err = s.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		scanJob.ScanJobId, err = s.ScanJobRepo.Create(tx, scanJob)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create scan job")
+		return model.ScanJob{}, err
+	}
+
+	active := scanJob
+	s.state.active = &active
+	return scanJob, nil
+}
@@ -0,0 +1,84 @@
+package scan_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// RescanTarget selects the songs of a targeted rescan. Songs selected by any of the fields are rescanned
+type RescanTarget struct {
+	SongIds      []int
+	AudioFileIds []int
+	AlbumId      *int
+	ArtistId     *int
+}
+
+// StartRescan starts a scan job that downloads and parses the audio files of the selected songs again,
+// even if their content did not change. All selected songs, albums and artists must exist
+func (s Service) StartRescan(target RescanTarget) (scanJob model.ScanJob, err error) {
+	log.Debug().Interface("target", target).Msg("Starting rescan job")
+
+	var audioFileIds []int
+	err = s.TransactionManager.WithTransaction(func(tx *sqlx.Tx) (err error) {
+		audioFileIds, err = s.resolveRescanTarget(tx, target)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to resolve rescan target")
+		return model.ScanJob{}, err
+	}
+
+	scanJob, err = s.begin(model.ScanModeTargeted, false)
+	if err != nil {
+		return model.ScanJob{}, err
+	}
+	go s.run(scanJob.ScanJobId, audioFileIds)
+
+	log.Debug().Int("scanJobId", scanJob.ScanJobId).Int("countOfAudioFiles", len(audioFileIds)).Msg("Rescan job started successfully")
+	return scanJob, nil
+}
+
+func (s Service) resolveRescanTarget(tx *sqlx.Tx, target RescanTarget) (audioFileIds []int, err error) {
+	selected := make(map[int]bool)
+	audioFileIds = make([]int, 0)
+	add := func(songs ...model.Song) {
+		for _, song := range songs {
+			if !selected[song.AudioFileId] {
+				selected[song.AudioFileId] = true
+				audioFileIds = append(audioFileIds, song.AudioFileId)
+			}
+		}
+	}
+
+	for _, songId := range target.SongIds {
+		song, err := s.SongService.Get(tx, songId)
+		if err != nil {
+			return nil, err
+		}
+		add(song)
+	}
+	for _, audioFileId := range target.AudioFileIds {
+		song, err := s.SongService.GetByAudioFileId(tx, audioFileId)
+		if err != nil {
+			return nil, err
+		}
+		add(song)
+	}
+	if target.AlbumId != nil {
+		songs, err := s.SongService.GetAllByAlbumId(tx, *target.AlbumId)
+		if err != nil {
+			return nil, err
+		}
+		add(songs...)
+	}
+	if target.ArtistId != nil {
+		songs, err := s.SongService.GetAllByArtistId(tx, *target.ArtistId)
+		if err != nil {
+			return nil, err
+		}
+		add(songs...)
+	}
+
+	return audioFileIds, nil
+}
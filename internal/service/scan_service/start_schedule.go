@@ -0,0 +1,104 @@
+package scan_service
+
+import (
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/cron"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"time"
+)
+
+// StartSchedule runs scans periodically in the background, replacing the schedule started before. An empty
+// expression leaves the schedule disabled. A run is skipped if the previous scan is still going
+func (s Service) StartSchedule(expression string, mode model.ScanMode) (err error) {
+	log.Debug().Str("expression", expression).Str("mode", string(mode)).Msg("Starting scan schedule")
+
+	if len(expression) == 0 {
+		log.Info().Msg("Scan schedule is disabled")
+		return nil
+	}
+
+	schedule, err := cron.Parse(expression)
+	if err != nil {
+		log.Error().Err(err).Str("expression", expression).Msg("Failed to parse scan schedule")
+		return err
+	}
+
+	stop := make(chan struct{})
+	s.state.mutex.Lock()
+	if s.state.stopSchedule != nil {
+		close(s.state.stopSchedule)
+	}
+	s.state.stopSchedule = stop
+	s.state.schedule = model.ScanSchedule{
+		Enabled:    true,
+		Expression: expression,
+		Mode:       mode,
+	}
+	s.state.mutex.Unlock()
+
+	go s.runSchedule(schedule, mode, stop)
+
+	log.Info().Str("expression", expression).Str("mode", string(mode)).Msg("Scan schedule started successfully")
+	return nil
+}
+
+func (s Service) runSchedule(schedule cron.Schedule, mode model.ScanMode, stop chan struct{}) {
+	for {
+		nextRunAt := schedule.Next(time.Now())
+
+		s.state.mutex.Lock()
+		if s.state.stopSchedule != stop {
+			s.state.mutex.Unlock()
+			return
+		}
+		if nextRunAt.IsZero() {
+			s.state.schedule.NextRunAt = nil
+		} else {
+			nextRunAtUtc := nextRunAt.UTC()
+			s.state.schedule.NextRunAt = &nextRunAtUtc
+		}
+		s.state.mutex.Unlock()
+
+		if nextRunAt.IsZero() {
+			log.Warn().Msg("Scan schedule has no more runs")
+			return
+		}
+		timer := time.NewTimer(time.Until(nextRunAt))
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runScheduled(mode)
+	}
+}
+
+func (s Service) runScheduled(mode model.ScanMode) {
+	log.Info().Str("mode", string(mode)).Msg("Running scheduled scan")
+
+	scanJob, err := s.Start(mode, false)
+
+	s.state.mutex.Lock()
+	defer s.state.mutex.Unlock()
+
+	ranAt := time.Now().UTC()
+	s.state.schedule.LastRunAt = &ranAt
+	s.state.schedule.LastRunSkipped = false
+	s.state.schedule.LastRunError = nil
+	if err != nil {
+		s.state.schedule.LastScanJobId = nil
+		if _, ok := err.(errors.Conflict); ok {
+			s.state.schedule.LastRunSkipped = true
+			log.Info().Msg("Scheduled scan skipped because the previous scan is still running")
+		} else {
+			reason := err.Error()
+			s.state.schedule.LastRunError = &reason
+			log.Error().Err(err).Msg("Failed to start scheduled scan")
+		}
+		return
+	}
+	s.state.schedule.LastScanJobId = &scanJob.ScanJobId
+}
@@ -0,0 +1,22 @@
+package scan_service
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+// StopSchedule stops periodic scans until the service restarts. A scan already running goes on
+func (s Service) StopSchedule() {
+	log.Debug().Msg("Stopping scan schedule")
+
+	s.state.mutex.Lock()
+	defer s.state.mutex.Unlock()
+
+	if s.state.stopSchedule != nil {
+		close(s.state.stopSchedule)
+		s.state.stopSchedule = nil
+	}
+	s.state.schedule.Enabled = false
+	s.state.schedule.NextRunAt = nil
+
+	log.Info().Msg("Scan schedule stopped successfully")
+}
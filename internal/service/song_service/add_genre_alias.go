@@ -0,0 +1,47 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// AddGenreAlias makes scanned genre names equal to the alias resolve to the genre. A genre already scanned
+// under the alias name is merged into the genre like in MergeGenres, so its songs list the genre right away
+func (s *Service) AddGenreAlias(tx *sqlx.Tx, genreId int, alias string) (err error) {
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Adding genre alias")
+
+	err = s.GenreService.AddAlias(tx, genreId, alias)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Str("alias", alias).Msg("Failed to add genre alias")
+		return err
+	}
+
+	exists, err := s.GenreService.IsExistsByName(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to check existence of genre named as alias")
+		return err
+	}
+	if !exists {
+		log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Genre alias added successfully")
+		return nil
+	}
+	aliasGenre, err := s.GenreService.GetByName(tx, alias)
+	if err != nil {
+		log.Error().Err(err).Str("alias", alias).Msg("Failed to get genre named as alias")
+		return err
+	}
+	if aliasGenre.GenreId != genreId {
+		err = s.mergeGenre(tx, model.GenreNormalization{
+			GenreId:           aliasGenre.GenreId,
+			CanonicalGenreIds: []int{genreId},
+		})
+		if err != nil {
+			log.Error().Err(err).Int("genreId", aliasGenre.GenreId).Msg("Failed to merge genre named as alias")
+			return err
+		}
+	}
+
+	log.Debug().Int("genreId", genreId).Str("alias", alias).Msg("Genre alias added successfully")
+	return nil
+}
@@ -0,0 +1,334 @@
+package song_service
+
+import (
+	"github.com/dhowden/tag"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// artistCredit is an artist name read from the tags with the role the artist has on the song
+type artistCredit struct {
+	name string
+	role model.SongArtistRole
+}
+
+// artistParser splits artist tags like "A & B feat. C" into separate artists
+type artistParser struct {
+	separators *regexp.Regexp
+	featuring  *regexp.Regexp
+	// exceptions are the matching keys of names that contain a separator but are never split
+	exceptions map[string]bool
+}
+
+func newArtistParser(separators []string, featuringMarkers []string, exceptions []string) artistParser {
+	// Multi-value tags keep their values separated by NUL characters
+	quotedSeparators := []string{"\x00"}
+	for _, separator := range separators {
+		quotedSeparators = append(quotedSeparators, regexp.QuoteMeta(separator))
+	}
+
+	parser := artistParser{
+		separators: regexp.MustCompile("(?i)" + strings.Join(quotedSeparators, "|")),
+		exceptions: make(map[string]bool, len(exceptions)),
+	}
+	for _, exception := range exceptions {
+		parser.exceptions[model.MatchingKey(exception)] = true
+	}
+
+	if len(featuringMarkers) > 0 {
+		markers := append([]string(nil), featuringMarkers...)
+		sort.Slice(markers, func(i, j int) bool { return len(markers[i]) > len(markers[j]) })
+		quotedMarkers := make([]string, len(markers))
+		for i, marker := range markers {
+			quotedMarkers[i] = regexp.QuoteMeta(strings.TrimSpace(marker))
+		}
+		parser.featuring = regexp.MustCompile(`(?i)(?:\s*([(\[])\s*|\s+)(?:` + strings.Join(quotedMarkers, "|") + `)\s+`)
+	}
+
+	return parser
+}
+
+// splitFeaturing cuts the featured artists off value. With bracketedOnly, only "(feat. B)" and
+// "[feat. B]" are recognized, as titles are not expected to contain a bare marker
+func (p artistParser) splitFeaturing(value string, bracketedOnly bool) (head string, featured string) {
+	if p.featuring == nil {
+		return value, ""
+	}
+
+	for _, loc := range p.featuring.FindAllStringSubmatchIndex(value, -1) {
+		bracketed := loc[2] >= 0
+		if loc[0] == 0 || (bracketedOnly && !bracketed) {
+			continue
+		}
+
+		head = value[:loc[0]]
+		featured = value[loc[1]:]
+		if bracketed {
+			closing := ")"
+			if value[loc[2]] == '[' {
+				closing = "]"
+			}
+			if i := strings.Index(featured, closing); i >= 0 {
+				head += featured[i+1:]
+				featured = featured[:i]
+			}
+		}
+		return strings.TrimSpace(head), strings.TrimSpace(featured)
+	}
+
+	return value, ""
+}
+
+// split cuts value at the separators. Neighbouring parts that together make up one of the exceptions,
+// like "Simon & Garfunkel", stay a single name
+func (p artistParser) split(value string) (names []string) {
+	type part struct {
+		start int
+		end   int
+	}
+	parts := make([]part, 0)
+	start := 0
+	for _, loc := range p.separators.FindAllStringIndex(value, -1) {
+		parts = append(parts, part{start: start, end: loc[0]})
+		start = loc[1]
+	}
+	parts = append(parts, part{start: start, end: len(value)})
+
+	names = make([]string, 0)
+	for i := 0; i < len(parts); i++ {
+		last := i
+		for j := len(parts) - 1; j > i; j-- {
+			if p.exceptions[model.MatchingKey(value[parts[i].start:parts[j].end])] {
+				last = j
+				break
+			}
+		}
+		name := strings.TrimSpace(value[parts[i].start:parts[last].end])
+		if len(name) > 0 {
+			names = append(names, name)
+		}
+		i = last
+	}
+	return names
+}
+
+// creditGroup is the group of roles an artist is credited once in on a song. Main, featured and remixing
+// artists are credited with the first of these roles, while composers and conductors are credited besides
+func creditGroup(role model.SongArtistRole) model.SongArtistRole {
+	switch role {
+	case model.SongArtistRoleComposer, model.SongArtistRoleConductor:
+		return role
+	default:
+		return model.SongArtistRoleMain
+	}
+}
+
+// artistCreditKey tells apart the credits of an artist on a song
+type artistCreditKey struct {
+	artistId int
+	group    model.SongArtistRole
+}
+
+// getArtistCredits reads the main, featured and remixing artists of the song, followed by its composers
+// and conductors. An artist is credited once per role group, with the first role and spelling found
+func (s *Service) getArtistCredits(metadata tag.Metadata) (credits []artistCredit) {
+	credits = make([]artistCredit, 0)
+	type nameCreditKey struct {
+		name  string
+		group model.SongArtistRole
+	}
+	seen := make(map[nameCreditKey]bool)
+	add := func(names []string, role model.SongArtistRole) {
+		for _, name := range names {
+			key := nameCreditKey{name: model.MatchingKey(name), group: creditGroup(role)}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			credits = append(credits, artistCredit{name: name, role: role})
+		}
+	}
+
+	artistValues := getVorbisValues(metadata, "artist")
+	if len(artistValues) == 0 {
+		artistValues = []string{metadata.Artist()}
+	}
+	featured := make([]string, 0)
+	for _, value := range artistValues {
+		main, featuredInValue := s.artistParser.splitFeaturing(value, false)
+		add(s.artistParser.split(main), model.SongArtistRoleMain)
+		featured = append(featured, s.artistParser.split(featuredInValue)...)
+	}
+	add(featured, model.SongArtistRoleFeatured)
+
+	// MusicBrainz Picard lists every artist of the song in the multi-value ARTISTS tag. Its values are
+	// complete names, so only the value separator applies
+	for _, value := range getRawValues(metadata, "ARTISTS") {
+		names := make([]string, 0)
+		for _, name := range strings.Split(value, "\x00") {
+			if name = strings.TrimSpace(name); len(name) > 0 {
+				names = append(names, name)
+			}
+		}
+		add(names, model.SongArtistRoleMain)
+	}
+
+	_, featuredInTitle := s.artistParser.splitFeaturing(metadata.Title(), true)
+	add(s.artistParser.split(featuredInTitle), model.SongArtistRoleFeatured)
+
+	for _, value := range getRawValues(metadata, "TPE4", "TP4", "REMIXER") {
+		add(s.artistParser.split(value), model.SongArtistRoleRemixer)
+	}
+
+	// Composers come from TCOM in ID3v2, COMPOSER in Vorbis comments and ©wrt in MP4
+	composerValues := getVorbisValues(metadata, "composer")
+	if len(composerValues) == 0 {
+		composerValues = []string{metadata.Composer()}
+	}
+	for _, value := range composerValues {
+		add(s.artistParser.split(value), model.SongArtistRoleComposer)
+	}
+
+	for _, value := range getRawValues(metadata, "TPE3", "TP3", "CONDUCTOR") {
+		add(s.artistParser.split(value), model.SongArtistRoleConductor)
+	}
+
+	return credits
+}
+
+// getOrCreateArtists resolves the credits into artists, following the splits of the song. The first
+// main artist is returned separately as the primary artist of the song
+func (s *Service) getOrCreateArtists(tx *sqlx.Tx, credits []artistCredit, splits songSplits) (artistId *int, artists []model.SongArtist, err error) {
+	artists = make([]model.SongArtist, 0, len(credits))
+	seen := make(map[artistCreditKey]bool)
+	for _, credit := range credits {
+		artist, err := s.getOrCreateArtistByName(tx, credit.name)
+		if err != nil {
+			log.Error().Err(err).Str("name", credit.name).Msg("Failed to get artist")
+			return nil, nil, err
+		}
+		if splitArtistId := splits.resolve(model.SongSplitKindArtist, artist.ArtistId); splitArtistId != artist.ArtistId {
+			splitArtist, err := s.ArtistService.Get(tx, splitArtistId)
+			if err != nil {
+				log.Error().Err(err).Int("artistId", splitArtistId).Msg("Failed to get artist the song was split off to")
+				return nil, nil, err
+			}
+			artist = &splitArtist
+		}
+		key := artistCreditKey{artistId: artist.ArtistId, group: creditGroup(credit.role)}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		artists = append(artists, model.SongArtist{
+			ArtistId:     artist.ArtistId,
+			Role:         credit.role,
+			Position:     len(artists),
+			CreditedName: spelling(credit.name, artist.NameKey, artist.Name),
+		})
+		if artistId == nil && credit.role == model.SongArtistRoleMain {
+			artistId = &artist.ArtistId
+		}
+	}
+	return artistId, artists, nil
+}
+
+// saveSongArtists replaces the artists credited on the song. The names and sort names of the artists
+// credited before and after follow the ones their songs now carry most often
+func (s *Service) saveSongArtists(tx *sqlx.Tx, songId int, artists []model.SongArtist) (err error) {
+	previousArtists, err := s.SongArtistRepo.ReadAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song artists")
+		return err
+	}
+	if isSameSongArtists(previousArtists, songId, artists) {
+		return nil
+	}
+
+	err = s.SongArtistRepo.DeleteAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to delete song artists")
+		return err
+	}
+
+	for _, artist := range artists {
+		artist.SongId = songId
+		err = s.SongArtistRepo.Create(tx, artist)
+		if err != nil {
+			log.Error().Err(err).Int("songId", songId).Int("artistId", artist.ArtistId).Msg("Failed to create song artist")
+			return err
+		}
+	}
+
+	updated := make(map[int]bool)
+	for _, artist := range append(previousArtists, artists...) {
+		if updated[artist.ArtistId] {
+			continue
+		}
+		updated[artist.ArtistId] = true
+		err = s.ArtistService.UpdateNameToMostCommon(tx, artist.ArtistId)
+		if err != nil {
+			log.Error().Err(err).Int("artistId", artist.ArtistId).Msg("Failed to update artist name")
+			return err
+		}
+		err = s.updateArtistSort(tx, artist.ArtistId)
+		if err != nil {
+			log.Error().Err(err).Int("artistId", artist.ArtistId).Msg("Failed to update artist sort name")
+			return err
+		}
+	}
+	return nil
+}
+
+// getRawValues reads tags missing from tag.Metadata by name: ID3v2 frames, TXXX descriptions,
+// Vorbis comments and MP4 custom atoms. Multi-value tags come back as values separated by NUL
+func getRawValues(metadata tag.Metadata, names ...string) (values []string) {
+	values = make([]string, 0)
+	for key, raw := range metadata.Raw() {
+		for _, name := range names {
+			switch value := raw.(type) {
+			case string:
+				if strings.EqualFold(key, name) {
+					values = append(values, value)
+				}
+			case []string:
+				if strings.EqualFold(key, name) {
+					values = append(values, strings.Join(value, "\x00"))
+				}
+			case *tag.Comm:
+				if strings.HasPrefix(key, "TXX") && strings.EqualFold(value.Description, name) {
+					values = append(values, value.Text)
+				}
+			case []byte:
+				// The tag package keeps frames it does not know, like MVNM and MVIN, undecoded
+				if strings.EqualFold(key, name) {
+					values = append(values, readID3v2Text(id3v2Frame{id: key, data: value}))
+				}
+			}
+		}
+	}
+	return values
+}
+
+// isSameSongArtists tells whether the song already has exactly these artists. Rescans of unchanged files
+// then leave the artists, and the audit log, alone
+func isSameSongArtists(previousArtists []model.SongArtist, songId int, artists []model.SongArtist) bool {
+	if len(previousArtists) != len(artists) {
+		return false
+	}
+	previous := make(map[model.SongArtist]bool, len(previousArtists))
+	for _, artist := range previousArtists {
+		previous[artist] = true
+	}
+	for _, artist := range artists {
+		artist.SongId = songId
+		if !previous[artist] {
+			return false
+		}
+	}
+	return true
+}
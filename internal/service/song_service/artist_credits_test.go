@@ -0,0 +1,77 @@
+package song_service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newDefaultArtistParser() artistParser {
+	return newArtistParser([]string{";", " & ", " / ", " vs. ", " vs "},
+		[]string{"feat.", "feat", "ft.", "ft", "featuring"},
+		[]string{"Simon & Garfunkel", "Earth, Wind & Fire", "Crosby, Stills, Nash & Young"})
+}
+
+func TestArtistParserSplit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "Artist", want: []string{"Artist"}},
+		{value: "", want: []string{}},
+		{value: "A & B", want: []string{"A", "B"}},
+		{value: "A;B; C", want: []string{"A", "B", "C"}},
+		{value: "A / B vs. C vs D", want: []string{"A", "B", "C", "D"}},
+		{value: "A VS. B", want: []string{"A", "B"}},
+		{value: "A\x00B", want: []string{"A", "B"}},
+		{value: "A & ", want: []string{"A"}},
+		{value: "AC/DC", want: []string{"AC/DC"}},
+		{value: "Simon & Garfunkel", want: []string{"Simon & Garfunkel"}},
+		{value: "simon  & garfunkel", want: []string{"simon  & garfunkel"}},
+		{value: "Earth, Wind & Fire", want: []string{"Earth, Wind & Fire"}},
+		{value: "Earth, Wind & Fire & Simon & Garfunkel", want: []string{"Earth, Wind & Fire", "Simon & Garfunkel"}},
+		{value: "A & Simon & Garfunkel", want: []string{"A", "Simon & Garfunkel"}},
+		{value: "Crosby, Stills, Nash & Young; A", want: []string{"Crosby, Stills, Nash & Young", "A"}},
+		{value: "Paul Simon & Garfunkel", want: []string{"Paul Simon", "Garfunkel"}},
+		{value: "Simon & Garfunkel\x00A", want: []string{"Simon & Garfunkel", "A"}},
+	}
+	parser := newDefaultArtistParser()
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := parser.split(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("split(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArtistParserSplitFeaturing(t *testing.T) {
+	tests := []struct {
+		value         string
+		bracketedOnly bool
+		wantHead      string
+		wantFeatured  string
+	}{
+		{value: "A", wantHead: "A"},
+		{value: "A feat. B", wantHead: "A", wantFeatured: "B"},
+		{value: "A ft B & C", wantHead: "A", wantFeatured: "B & C"},
+		{value: "A Featuring B", wantHead: "A", wantFeatured: "B"},
+		{value: "A (feat. B)", wantHead: "A", wantFeatured: "B"},
+		{value: "Song [ft. B] (Remix)", wantHead: "Song (Remix)", wantFeatured: "B"},
+		{value: "Song (feat. B", wantHead: "Song", wantFeatured: "B"},
+		{value: "Song feat. B", bracketedOnly: true, wantHead: "Song feat. B"},
+		{value: "Song (feat. B)", bracketedOnly: true, wantHead: "Song", wantFeatured: "B"},
+		{value: "feat. B", wantHead: "feat. B"},
+		{value: "Aftermath", wantHead: "Aftermath"},
+		{value: "Left Feat", wantHead: "Left Feat"},
+	}
+	parser := newDefaultArtistParser()
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			head, featured := parser.splitFeaturing(tt.value, tt.bracketedOnly)
+			if head != tt.wantHead || featured != tt.wantFeatured {
+				t.Errorf("splitFeaturing(%q, %v) = (%q, %q), want (%q, %q)",
+					tt.value, tt.bracketedOnly, head, featured, tt.wantHead, tt.wantFeatured)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+)
+
+// SongSelection picks the songs of a bulk update: the listed songs or all songs of an album, the songs an
+// artist is the main artist of or all songs of a genre. Exactly one of them is set
+type SongSelection struct {
+	SongIds  []int
+	AlbumId  *int
+	ArtistId *int
+	GenreId  *int
+}
+
+// BulkUpdateResult reports the update of a single song. Err is nil if the song was updated
+type BulkUpdateResult struct {
+	SongId int
+	Err    error
+}
+
+// BulkUpdateOverrides applies the same overrides to every selected song, like UpdateOverrides does for
+// one. A song that fails, for example because its file cannot be downloaded, keeps its previous
+// overrides and is reported in the results, while the other songs are updated
+func (s *Service) BulkUpdateOverrides(tx *sqlx.Tx, selection SongSelection, overrides []model.SongOverride,
+	resetFields []model.SongOverrideField) (results []BulkUpdateResult, err error) {
+	log.Debug().Interface("selection", selection).Interface("overrides", overrides).Interface("resetFields", resetFields).
+		Msg("Bulk updating song overrides")
+
+	err = validateSongOverrides(overrides, resetFields)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid song overrides")
+		return make([]BulkUpdateResult, 0), err
+	}
+
+	songs, err := s.selectSongs(tx, selection)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to select songs")
+		return make([]BulkUpdateResult, 0), err
+	}
+
+	results = make([]BulkUpdateResult, 0, len(songs))
+	for _, song := range songs {
+		err = service.WithSavepoint(tx, func() (err error) {
+			return s.updateOverrides(tx, song, overrides, resetFields)
+		})
+		if err != nil {
+			log.Warn().Err(err).Int("songId", song.SongId).Msg("Failed to update song overrides")
+		}
+		results = append(results, BulkUpdateResult{
+			SongId: song.SongId,
+			Err:    err,
+		})
+	}
+
+	err = s.removeUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary items")
+		return make([]BulkUpdateResult, 0), err
+	}
+
+	log.Info().Int("countOfSongs", len(songs)).Msg("Song overrides bulk updated successfully")
+	return results, nil
+}
+
+func (s *Service) selectSongs(tx *sqlx.Tx, selection SongSelection) (songs []model.Song, err error) {
+	selectors := 0
+	if len(selection.SongIds) > 0 {
+		selectors++
+	}
+	for _, id := range []*int{selection.AlbumId, selection.ArtistId, selection.GenreId} {
+		if id != nil {
+			selectors++
+		}
+	}
+	if selectors != 1 {
+		return make([]model.Song, 0), errors.Invalid{Message: "exactly one of songIds, albumId, artistId and genreId must be given"}
+	}
+
+	switch {
+	case selection.AlbumId != nil:
+		return s.GetAllByAlbumId(tx, *selection.AlbumId)
+	case selection.ArtistId != nil:
+		return s.GetAllByMainArtistId(tx, *selection.ArtistId)
+	case selection.GenreId != nil:
+		return s.GetAllByGenreId(tx, *selection.GenreId, false)
+	}
+
+	songs = make([]model.Song, 0, len(selection.SongIds))
+	seen := make(map[int]bool)
+	for _, songId := range selection.SongIds {
+		if seen[songId] {
+			continue
+		}
+		seen[songId] = true
+		song, err := s.Get(tx, songId)
+		if err != nil {
+			return make([]model.Song, 0), err
+		}
+		songs = append(songs, song)
+	}
+	return songs, nil
+}
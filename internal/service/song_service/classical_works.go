@@ -0,0 +1,85 @@
+package song_service
+
+import (
+	"github.com/dhowden/tag"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"strconv"
+	"strings"
+)
+
+// getOrCreateWork resolves the work the song is a movement of by its title and the first composer of the
+// song, creating the work if there is none yet
+func (s *Service) getOrCreateWork(tx *sqlx.Tx, metadata tag.Metadata, artists []model.SongArtist) (work *model.Work, err error) {
+	title := getWorkTitle(metadata)
+	if title == nil {
+		return nil, nil
+	}
+
+	identity := model.Work{Title: *title}
+	for _, artist := range artists {
+		if artist.Role == model.SongArtistRoleComposer {
+			identity.ComposerId = &artist.ArtistId
+			break
+		}
+	}
+
+	exists, err := s.WorkService.IsExistsByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Str("title", *title).Msg("Failed to check work existence")
+		return nil, err
+	}
+
+	var resolvedWork model.Work
+	if exists {
+		resolvedWork, err = s.WorkService.GetByIdentity(tx, identity)
+	} else {
+		resolvedWork, err = s.WorkService.Create(tx, identity)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("title", *title).Msg("Failed to get work")
+		return nil, err
+	}
+	return &resolvedWork, nil
+}
+
+// getWorkTitle reads the work: TXXX:WORK in ID3v2, WORK in Vorbis comments and ©wrk in MP4. The TIT1 frame
+// iTunes writes the work to is left out, as other players keep the grouping in it
+func getWorkTitle(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "WORK", "\xa9wrk")
+}
+
+// getMovement reads the name of the movement: MVNM in ID3v2, MOVEMENTNAME in Vorbis comments and ©mvn in MP4
+func getMovement(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "MVNM", "MOVEMENTNAME", "\xa9mvn")
+}
+
+// getMovementNumber reads the number of the movement: MVIN in ID3v2, which may carry the count of
+// movements after a slash, MOVEMENT in Vorbis comments and ©mvi in MP4
+func getMovementNumber(metadata tag.Metadata) *int {
+	value := getFirstRawValue(metadata, "MVIN", "MOVEMENT", "\xa9mvi")
+	if value == nil {
+		return nil
+	}
+	number, _, _ := strings.Cut(*value, "/")
+	movementNumber, err := strconv.Atoi(strings.TrimSpace(number))
+	if err != nil || movementNumber == 0 {
+		return nil
+	}
+	return &movementNumber
+}
+
+// getFirstRawValue returns the first value of the first of the tags the file has, in the order of names
+func getFirstRawValue(metadata tag.Metadata, names ...string) *string {
+	for _, name := range names {
+		for _, value := range getRawValues(metadata, name) {
+			value, _, _ = strings.Cut(value, "\x00")
+			value = strings.TrimSpace(value)
+			if len(value) > 0 {
+				return &value
+			}
+		}
+	}
+	return nil
+}
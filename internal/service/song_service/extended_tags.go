@@ -0,0 +1,148 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/dhowden/tag"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// getBpm reads the tempo: TBPM in ID3v2, BPM in Vorbis comments and tmpo in MP4. Fractional tempos are
+// rounded
+func getBpm(metadata tag.Metadata) *int {
+	value := getFirstRawValue(metadata, "TBPM", "TBP", "BPM", "tmpo")
+	if value == nil {
+		return nil
+	}
+	tempo, err := strconv.ParseFloat(strings.Replace(*value, ",", ".", 1), 64)
+	if err != nil || tempo < 1 || tempo > math.MaxInt32 {
+		return nil
+	}
+	bpm := int(math.Round(tempo))
+	return &bpm
+}
+
+// getIsrc reads the ISRC: TSRC in ID3v2 and ISRC in Vorbis comments and MP4
+func getIsrc(metadata tag.Metadata) *string {
+	value := getFirstRawValue(metadata, "TSRC", "TRC", "ISRC")
+	if value == nil {
+		return nil
+	}
+	isrc := strings.ToUpper(strings.ReplaceAll(*value, "-", ""))
+	return &isrc
+}
+
+// getLabel reads the record label: TPUB in ID3v2, LABEL, ORGANIZATION or PUBLISHER in Vorbis comments
+// and LABEL in MP4
+func getLabel(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "TPUB", "TPB", "LABEL", "ORGANIZATION", "PUBLISHER")
+}
+
+// getCatalogNumber reads the catalog number: TXXX:CATALOGNUMBER in ID3v2 and CATALOGNUMBER in Vorbis
+// comments and MP4
+func getCatalogNumber(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "CATALOGNUMBER")
+}
+
+// getComment reads the comment. ID3v2 files may have several COMM frames, stored as COMM, COMM_0, COMM_1
+// and so on, so they are read from the frames: the one without a description wins, and the ones iTunes
+// keeps its normalization and gapless data in, described iTunNORM, iTunSMPB and the like, are skipped
+func getComment(metadata tag.Metadata) *string {
+	comment := metadata.Comment()
+	if frames := getCommentFrames(metadata); len(frames) > 0 {
+		comment = ""
+		for _, frame := range frames {
+			if strings.HasPrefix(frame.Description, "iTun") || len(strings.TrimSpace(frame.Text)) == 0 {
+				continue
+			}
+			if len(frame.Description) == 0 {
+				comment = frame.Text
+				break
+			}
+			if len(comment) == 0 {
+				comment = frame.Text
+			}
+		}
+	}
+	comment = strings.TrimSpace(comment)
+	if len(comment) == 0 {
+		return nil
+	}
+	return &comment
+}
+
+// getCommentFrames returns the COMM frames of ID3v2.3 and ID3v2.4 or the COM frames of ID3v2.2 in the
+// order of the file
+func getCommentFrames(metadata tag.Metadata) (frames []*tag.Comm) {
+	frames = make([]*tag.Comm, 0)
+	raw := metadata.Raw()
+	for _, name := range []string{"COMM", "COM"} {
+		frame, ok := raw[name].(*tag.Comm)
+		for i := 0; ok; i++ {
+			frames = append(frames, frame)
+			frame, ok = raw[name+"_"+strconv.Itoa(i)].(*tag.Comm)
+		}
+	}
+	return frames
+}
+
+// getReleaseDate reads the full release date: TDRC or TDRL in ID3v2.4, TYER with TDAT in ID3v2.3, DATE in
+// Vorbis comments and ©day in MP4
+func getReleaseDate(metadata tag.Metadata) *time.Time {
+	for _, name := range []string{"TDRC", "TDRL", "DATE", "\xa9day"} {
+		if value := getFirstRawValue(metadata, name); value != nil {
+			if _, date := parseDate(*value); date != nil {
+				return date
+			}
+		}
+	}
+
+	// TDAT holds the day and month as DDMM
+	year := getFirstRawValue(metadata, "TYER", "TYE")
+	day := getFirstRawValue(metadata, "TDAT", "TDA")
+	if year == nil || day == nil || len(*day) != 4 {
+		return nil
+	}
+	_, date := parseDate(fmt.Sprintf("%s-%s-%s", *year, (*day)[2:], (*day)[:2]))
+	return date
+}
+
+// getOriginalRelease reads the original release: TDOR in ID3v2.4, TORY in ID3v2.3, ORIGINALDATE or
+// ORIGINALYEAR in Vorbis comments and MP4. The year is returned even if no tag has the full date
+func getOriginalRelease(metadata tag.Metadata) (year *int, date *time.Time) {
+	for _, name := range []string{"TDOR", "TORY", "TOR", "ORIGINALDATE", "ORIGINALYEAR"} {
+		value := getFirstRawValue(metadata, name)
+		if value == nil {
+			continue
+		}
+		valueYear, valueDate := parseDate(*value)
+		if year == nil {
+			year = valueYear
+		}
+		if valueDate != nil {
+			return valueYear, valueDate
+		}
+	}
+	return year, nil
+}
+
+// parseDate reads dates like 2001, 2001-05 or 2001-05-03, possibly followed by a time. The date is only
+// returned when the value has the day
+func parseDate(value string) (year *int, date *time.Time) {
+	value = strings.NewReplacer("/", "-", ".", "-").Replace(strings.TrimSpace(value))
+	if len(value) < 4 {
+		return nil, nil
+	}
+	parsedYear, err := strconv.Atoi(value[:4])
+	if err != nil || parsedYear == 0 {
+		return nil, nil
+	}
+	if len(value) >= 10 {
+		if parsedDate, err := time.Parse(time.DateOnly, value[:10]); err == nil {
+			return &parsedYear, &parsedDate
+		}
+	}
+	return &parsedYear, nil
+}
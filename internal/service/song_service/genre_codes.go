@@ -0,0 +1,83 @@
+package song_service
+
+import (
+	"strconv"
+	"strings"
+)
+
+// id3Genres are the ID3v1 genres with the Winamp extensions, indexed by their numbers
+var id3Genres = [...]string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop", "Jazz", "Metal",
+	"New Age", "Oldies", "Other", "Pop", "R&B", "Rap", "Reggae", "Rock", "Techno", "Industrial",
+	"Alternative", "Ska", "Death Metal", "Pranks", "Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk",
+	"Fusion", "Trance", "Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"Alternative Rock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock", "Ethnic", "Gothic",
+	"Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream", "Southern Rock", "Comedy", "Cult", "Gangsta",
+	"Top 40", "Christian Rap", "Pop/Funk", "Jungle", "Native American", "Cabaret", "New Wave", "Psychedelic", "Rave", "Showtunes",
+	"Trailer", "Lo-Fi", "Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+	"Folk", "Folk-Rock", "National Folk", "Swing", "Fast Fusion", "Bebop", "Latin", "Revival", "Celtic", "Bluegrass",
+	"Avantgarde", "Gothic Rock", "Progressive Rock", "Psychedelic Rock", "Symphonic Rock", "Slow Rock", "Big Band", "Chorus", "Easy Listening", "Acoustic",
+	"Humour", "Speech", "Chanson", "Opera", "Chamber Music", "Sonata", "Symphony", "Booty Bass", "Primus", "Porn Groove",
+	"Satire", "Slow Jam", "Club", "Tango", "Samba", "Folklore", "Ballad", "Power Ballad", "Rhythmic Soul", "Freestyle",
+	"Duet", "Punk Rock", "Drum Solo", "A Cappella", "Euro-House", "Dance Hall", "Goa", "Drum & Bass", "Club-House", "Hardcore",
+	"Terror", "Indie", "Britpop", "Negerpunk", "Polsk Punk", "Beat", "Christian Gangsta Rap", "Heavy Metal", "Black Metal", "Crossover",
+	"Contemporary Christian", "Christian Rock", "Merengue", "Salsa", "Thrash Metal", "Anime", "J-Pop", "Synthpop", "Christmas", "Art Rock",
+	"Baroque", "Bhangra", "Big Beat", "Breakbeat", "Chillout", "Downtempo", "Dub", "EBM", "Eclectic", "Electro",
+	"Electroclash", "Emo", "Experimental", "Garage", "Global", "IDM", "Illbient", "Industro-Goth", "Jam Band", "Krautrock",
+	"Leftfield", "Lounge", "Math Rock", "New Romantic", "Nu-Breakz", "Post-Punk", "Post-Rock", "Psytrance", "Shoegaze", "Space Rock",
+	"Trop Rock", "World Music", "Neoclassical", "Audiobook", "Audio Theatre", "Neue Deutsche Welle", "Podcast", "Indie Rock", "G-Funk", "Dubstep",
+	"Garage Rock", "Psybient",
+}
+
+// id3GenreReferences are the non-numeric references ID3v2.3 allows in a genre
+var id3GenreReferences = map[string]string{
+	"RX": "Remix",
+	"CR": "Cover",
+}
+
+// normalizeGenre translates legacy genre codes into genre names: "17" and "(17)" become "Rock",
+// "(17)Rock" stays a single "Rock", and "(4)(9)Eurodisco" lists every referenced genre followed by
+// the refinement. Other values are returned trimmed
+func normalizeGenre(value string) (names []string) {
+	value = strings.TrimSpace(value)
+	if name, ok := genreByCode(value); ok {
+		return []string{name}
+	}
+
+	names = make([]string, 0)
+	for strings.HasPrefix(value, "(") && !strings.HasPrefix(value, "((") {
+		end := strings.Index(value, ")")
+		if end < 0 {
+			break
+		}
+		name, ok := genreByCode(value[1:end])
+		if !ok {
+			name, ok = id3GenreReferences[strings.ToUpper(value[1:end])]
+		}
+		if !ok {
+			break
+		}
+		names = append(names, name)
+		value = strings.TrimSpace(value[end+1:])
+	}
+
+	// "((" escapes a refinement that starts with a parenthesis
+	refinement := strings.TrimSpace(strings.Replace(value, "((", "(", 1))
+	if len(refinement) == 0 {
+		return names
+	}
+	for _, name := range names {
+		if strings.EqualFold(name, refinement) {
+			return names
+		}
+	}
+	return append(names, refinement)
+}
+
+func genreByCode(code string) (name string, ok bool) {
+	number, err := strconv.Atoi(code)
+	if err != nil || number < 0 || number >= len(id3Genres) || strings.TrimSpace(code) != code {
+		return "", false
+	}
+	return id3Genres[number], true
+}
@@ -0,0 +1,41 @@
+package song_service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeGenre(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "Rock", want: []string{"Rock"}},
+		{value: " Rock ", want: []string{"Rock"}},
+		{value: "", want: []string{}},
+		{value: "17", want: []string{"Rock"}},
+		{value: "(17)", want: []string{"Rock"}},
+		{value: " (17) ", want: []string{"Rock"}},
+		{value: "(17)Rock", want: []string{"Rock"}},
+		{value: "(17)rock", want: []string{"Rock"}},
+		{value: "(17)Indie", want: []string{"Rock", "Indie"}},
+		{value: "(4)(9)Eurodisco", want: []string{"Disco", "Metal", "Eurodisco"}},
+		{value: "((Live)", want: []string{"(Live)"}},
+		{value: "(17)((Live)", want: []string{"Rock", "(Live)"}},
+		{value: "(RX)", want: []string{"Remix"}},
+		{value: "(cr)(17)", want: []string{"Cover", "Rock"}},
+		{value: "RX", want: []string{"RX"}},
+		{value: "CR", want: []string{"CR"}},
+		{value: "(999)", want: []string{"(999)"}},
+		{value: "-1", want: []string{"-1"}},
+		{value: "(17", want: []string{"(17"}},
+		{value: "(Live)", want: []string{"(Live)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := normalizeGenre(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeGenre(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -28,6 +28,24 @@ func (s Service) Get(tx *sqlx.Tx, songId int) (song model.Song, err error) {
 		return model.Song{}, err
 	}
 
+	song.Artists, err = s.SongArtistRepo.ReadAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song artists")
+		return model.Song{}, err
+	}
+
+	song.Genres, err = s.SongGenreRepo.ReadAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song genres")
+		return model.Song{}, err
+	}
+
+	song.Overrides, err = s.SongOverrideRepo.ReadAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song overrides")
+		return model.Song{}, err
+	}
+
 	log.Debug().Interface("song", song).Msg("Songs got successfully")
 	return song, nil
 }
@@ -0,0 +1,33 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAllByComposerId(tx *sqlx.Tx, composerId int) (songs []model.Song, err error) {
+	log.Debug().Int("composerId", composerId).Msg("Getting songs by composer")
+
+	exists, err := s.ArtistService.IsExists(tx, composerId)
+	if err != nil {
+		log.Error().Err(err).Int("composerId", composerId).Msg("Failed to check artist existence")
+		return nil, err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("artist with id=%d", composerId)}
+		log.Error().Err(err).Int("composerId", composerId).Msg("Artist not found")
+		return make([]model.Song, 0), err
+	}
+
+	songs, err = s.SongRepo.ReadAllByComposerId(tx, composerId)
+	if err != nil {
+		log.Error().Err(err).Int("composerId", composerId).Msg("Failed to get songs by composer")
+		return make([]model.Song, 0), err
+	}
+
+	log.Debug().Int("composerId", composerId).Int("countOfSongs", len(songs)).Msg("Songs by composer got successfully")
+	return songs, nil
+}
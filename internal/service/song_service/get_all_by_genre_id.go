@@ -8,8 +8,9 @@ import (
 	"music-metadata/internal/model"
 )
 
-func (s Service) GetAllByGenreId(tx *sqlx.Tx, genreId int) (songs []model.Song, err error) {
-	log.Debug().Int("genreId", genreId).Msg("Getting songs by genre")
+// GetAllByGenreId returns the songs of the genre and, if includeSubgenres is set, of all genres below it in the hierarchy
+func (s Service) GetAllByGenreId(tx *sqlx.Tx, genreId int, includeSubgenres bool) (songs []model.Song, err error) {
+	log.Debug().Int("genreId", genreId).Bool("includeSubgenres", includeSubgenres).Msg("Getting songs by genre")
 
 	exists, err := s.GenreService.IsExists(tx, genreId)
 	if err != nil {
@@ -22,7 +23,11 @@ func (s Service) GetAllByGenreId(tx *sqlx.Tx, genreId int) (songs []model.Song,
 		return make([]model.Song, 0), err
 	}
 
-	songs, err = s.SongRepo.ReadAllByGenreId(tx, genreId)
+	if includeSubgenres {
+		songs, err = s.SongRepo.ReadAllByGenreIdWithSubgenres(tx, genreId)
+	} else {
+		songs, err = s.SongRepo.ReadAllByGenreId(tx, genreId)
+	}
 	if err != nil {
 		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to get songs by genre")
 		return make([]model.Song, 0), err
@@ -0,0 +1,26 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAllByWorkId(tx *sqlx.Tx, workId int) (songs []model.Song, err error) {
+	log.Debug().Int("workId", workId).Msg("Getting songs by work")
+
+	_, err = s.WorkService.Get(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to get work")
+		return make([]model.Song, 0), err
+	}
+
+	songs, err = s.SongRepo.ReadAllByWorkId(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to get songs by work")
+		return make([]model.Song, 0), err
+	}
+
+	log.Debug().Int("workId", workId).Int("countOfSongs", len(songs)).Msg("Songs by work got successfully")
+	return songs, nil
+}
@@ -0,0 +1,27 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"slices"
+)
+
+func (s Service) GetAllSorted(tx *sqlx.Tx, order model.SortOrder) (songs []model.Song, err error) {
+	log.Debug().Str("sort", order.Field).Bool("descending", order.Descending).Msg("Getting all songs sorted")
+
+	if !slices.Contains(model.SongSortFields, order.Field) {
+		return make([]model.Song, 0), errors.Invalid{Message: fmt.Sprintf("songs cannot be sorted by %q", order.Field)}
+	}
+
+	songs, err = s.SongRepo.ReadAllSorted(tx, order)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get sorted songs")
+		return make([]model.Song, 0), err
+	}
+
+	log.Debug().Int("count", len(songs)).Msg("Sorted songs got successfully")
+	return songs, nil
+}
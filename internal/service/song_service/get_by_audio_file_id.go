@@ -0,0 +1,33 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetByAudioFileId(tx *sqlx.Tx, audioFileId int) (song model.Song, err error) {
+	log.Debug().Int("audioFileId", audioFileId).Msg("Getting song by audio file")
+
+	exists, err := s.SongRepo.IsExistsByAudioFileId(tx, audioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to check existence")
+		return model.Song{}, err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("song with audioFileId=%d", audioFileId)}
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Song not found")
+		return model.Song{}, err
+	}
+
+	song, err = s.SongRepo.ReadByAudioFileId(tx, audioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to get song")
+		return model.Song{}, err
+	}
+
+	log.Debug().Interface("song", song).Msg("Song by audio file got successfully")
+	return song, nil
+}
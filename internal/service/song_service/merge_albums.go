@@ -0,0 +1,113 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// MergeAlbums merges the source albums into the target album. Their songs move to the target album and
+// their identities become aliases of it, so later scans keep the merge
+func (s *Service) MergeAlbums(tx *sqlx.Tx, targetAlbumId int, sourceAlbumIds []int) (album model.Album, err error) {
+	log.Debug().Int("targetAlbumId", targetAlbumId).Ints("sourceAlbumIds", sourceAlbumIds).Msg("Merging albums")
+
+	targetAlbum, err := s.AlbumService.Get(tx, targetAlbumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", targetAlbumId).Msg("Failed to get target album")
+		return model.Album{}, err
+	}
+	sourceAlbums := make([]model.Album, 0, len(sourceAlbumIds))
+	for _, sourceAlbumId := range sourceAlbumIds {
+		if sourceAlbumId == targetAlbumId {
+			err = errors.Conflict{Message: fmt.Sprintf("album with id=%d cannot be merged into itself", sourceAlbumId)}
+			log.Error().Err(err).Int("albumId", sourceAlbumId).Msg("Album merged into itself")
+			return model.Album{}, err
+		}
+		sourceAlbum, err := s.AlbumService.Get(tx, sourceAlbumId)
+		if err != nil {
+			log.Error().Err(err).Int("albumId", sourceAlbumId).Msg("Failed to get source album")
+			return model.Album{}, err
+		}
+		sourceAlbums = append(sourceAlbums, sourceAlbum)
+	}
+
+	for _, sourceAlbum := range sourceAlbums {
+		err = s.mergeAlbum(tx, sourceAlbum, targetAlbum)
+		if err != nil {
+			log.Error().Err(err).Int("albumId", sourceAlbum.AlbumId).Msg("Failed to merge album")
+			return model.Album{}, err
+		}
+	}
+
+	album, err = s.AlbumService.Get(tx, targetAlbumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", targetAlbumId).Msg("Failed to get merged album")
+		return model.Album{}, err
+	}
+
+	log.Info().Int("albumId", targetAlbumId).Int("countOfMergedAlbums", len(sourceAlbums)).Msg("Albums merged successfully")
+	return album, nil
+}
+
+func (s *Service) mergeAlbum(tx *sqlx.Tx, sourceAlbum model.Album, targetAlbum model.Album) (err error) {
+	songs, err := s.SongRepo.ReadAllByAlbumId(tx, sourceAlbum.AlbumId)
+	if err != nil {
+		return err
+	}
+	for _, song := range songs {
+		albumTitle := targetAlbum.Title
+		if song.AlbumTitle != nil {
+			albumTitle = spelling(*song.AlbumTitle, targetAlbum.TitleKey, targetAlbum.Title)
+		}
+		song.AlbumId = &targetAlbum.AlbumId
+		song.AlbumTitle = &albumTitle
+		err = s.SongRepo.Update(tx, song.SongId, song)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.SongSplitRepo.ReplaceId(tx, model.SongSplitKindAlbum, sourceAlbum.AlbumId, targetAlbum.AlbumId)
+	if err != nil {
+		return err
+	}
+	err = s.AlbumService.MoveAliases(tx, sourceAlbum, targetAlbum)
+	if err != nil {
+		return err
+	}
+	err = s.AlbumService.Delete(tx, sourceAlbum.AlbumId)
+	if err != nil {
+		return err
+	}
+	return s.updateAlbumFromSongs(tx, targetAlbum.AlbumId)
+}
+
+// updateAlbumFromSongs lets the title, sort title, release date, label and compilation flag of the album and
+// the sort name of its album artist follow the ones its songs carry
+func (s *Service) updateAlbumFromSongs(tx *sqlx.Tx, albumId int) (err error) {
+	err = s.AlbumService.UpdateTitleToMostCommon(tx, albumId)
+	if err != nil {
+		return err
+	}
+	err = s.updateAlbumSort(tx, albumId)
+	if err != nil {
+		return err
+	}
+	album, err := s.AlbumService.Get(tx, albumId)
+	if err != nil {
+		return err
+	}
+	if album.AlbumArtistId != nil {
+		err = s.updateArtistSort(tx, *album.AlbumArtistId)
+		if err != nil {
+			return err
+		}
+	}
+	err = s.AlbumService.UpdateReleaseToMostCommon(tx, albumId)
+	if err != nil {
+		return err
+	}
+	return s.AlbumService.UpdateCompilationFromSongs(tx, albumId)
+}
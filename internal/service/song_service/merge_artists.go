@@ -0,0 +1,195 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// MergeArtists merges the source artists into the target artist. Their songs credit the target
+// artist, their albums get it as album artist, merging into albums of the target artist of the same
+// identity, and their names become aliases of the target artist, so later scans keep the merge
+func (s *Service) MergeArtists(tx *sqlx.Tx, targetArtistId int, sourceArtistIds []int) (artist model.Artist, err error) {
+	log.Debug().Int("targetArtistId", targetArtistId).Ints("sourceArtistIds", sourceArtistIds).Msg("Merging artists")
+
+	targetArtist, err := s.ArtistService.Get(tx, targetArtistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", targetArtistId).Msg("Failed to get target artist")
+		return model.Artist{}, err
+	}
+	sourceArtists := make([]model.Artist, 0, len(sourceArtistIds))
+	for _, sourceArtistId := range sourceArtistIds {
+		if sourceArtistId == targetArtistId {
+			err = errors.Conflict{Message: fmt.Sprintf("artist with id=%d cannot be merged into itself", sourceArtistId)}
+			log.Error().Err(err).Int("artistId", sourceArtistId).Msg("Artist merged into itself")
+			return model.Artist{}, err
+		}
+		sourceArtist, err := s.ArtistService.Get(tx, sourceArtistId)
+		if err != nil {
+			log.Error().Err(err).Int("artistId", sourceArtistId).Msg("Failed to get source artist")
+			return model.Artist{}, err
+		}
+		sourceArtists = append(sourceArtists, sourceArtist)
+	}
+
+	for _, sourceArtist := range sourceArtists {
+		err = s.mergeArtist(tx, sourceArtist, targetArtist)
+		if err != nil {
+			log.Error().Err(err).Int("artistId", sourceArtist.ArtistId).Msg("Failed to merge artist")
+			return model.Artist{}, err
+		}
+	}
+
+	artist, err = s.ArtistService.Get(tx, targetArtistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", targetArtistId).Msg("Failed to get merged artist")
+		return model.Artist{}, err
+	}
+
+	log.Info().Int("artistId", targetArtistId).Int("countOfMergedArtists", len(sourceArtists)).Msg("Artists merged successfully")
+	return artist, nil
+}
+
+func (s *Service) mergeArtist(tx *sqlx.Tx, sourceArtist model.Artist, targetArtist model.Artist) (err error) {
+	songs, err := s.SongRepo.ReadAllByArtistId(tx, sourceArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+	for _, song := range songs {
+		err = s.replaceSongArtist(tx, song, sourceArtist.ArtistId, targetArtist)
+		if err != nil {
+			return err
+		}
+	}
+
+	albums, _, err := s.AlbumService.GetAllByArtistId(tx, sourceArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+	for _, album := range albums {
+		identity := album
+		identity.AlbumArtistId = &targetArtist.ArtistId
+		exists, err := s.AlbumService.IsExistsByIdentity(tx, identity)
+		if err != nil {
+			return err
+		}
+		if exists {
+			targetAlbum, err := s.AlbumService.GetByIdentity(tx, identity)
+			if err != nil {
+				return err
+			}
+			if targetAlbum.AlbumId != album.AlbumId {
+				err = s.mergeAlbum(tx, album, targetAlbum)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		err = s.AlbumService.SetAlbumArtist(tx, album.AlbumId, &targetArtist.ArtistId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.mergeComposerWorks(tx, sourceArtist.ArtistId, targetArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+
+	err = s.AlbumService.ReplaceAliasAlbumArtist(tx, sourceArtist.ArtistId, targetArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+	err = s.SongSplitRepo.ReplaceId(tx, model.SongSplitKindArtist, sourceArtist.ArtistId, targetArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+	err = s.ArtistService.MoveAliases(tx, sourceArtist, targetArtist)
+	if err != nil {
+		return err
+	}
+	err = s.ArtistService.Delete(tx, sourceArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+	err = s.ArtistService.UpdateNameToMostCommon(tx, targetArtist.ArtistId)
+	if err != nil {
+		return err
+	}
+	return s.updateArtistSort(tx, targetArtist.ArtistId)
+}
+
+// mergeComposerWorks gives the works of the source composer to the target composer. A work the target
+// composer already has under the same title takes the movements of the source work instead
+func (s *Service) mergeComposerWorks(tx *sqlx.Tx, sourceArtistId int, targetArtistId int) (err error) {
+	works, err := s.WorkService.GetAllByComposerId(tx, sourceArtistId)
+	if err != nil {
+		return err
+	}
+	for _, work := range works {
+		identity := work
+		identity.ComposerId = &targetArtistId
+		exists, err := s.WorkService.IsExistsByIdentity(tx, identity)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			err = s.WorkService.SetComposer(tx, work.WorkId, &targetArtistId)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		targetWork, err := s.WorkService.GetByIdentity(tx, identity)
+		if err != nil {
+			return err
+		}
+		err = s.SongRepo.ReplaceWorkId(tx, work.WorkId, targetWork.WorkId)
+		if err != nil {
+			return err
+		}
+		err = s.WorkService.Delete(tx, work.WorkId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceSongArtist credits the song to toArtist instead of fromArtistId, keeping the role and position
+// of the credit. A song crediting both keeps the first of the two credits
+func (s *Service) replaceSongArtist(tx *sqlx.Tx, song model.Song, fromArtistId int, toArtist model.Artist) (err error) {
+	songArtists, err := s.SongArtistRepo.ReadAllBySongId(tx, song.SongId)
+	if err != nil {
+		return err
+	}
+
+	artists := make([]model.SongArtist, 0, len(songArtists))
+	seen := make(map[artistCreditKey]bool)
+	for _, songArtist := range songArtists {
+		if songArtist.ArtistId == fromArtistId {
+			songArtist.ArtistId = toArtist.ArtistId
+			songArtist.CreditedName = toArtist.Name
+		}
+		key := artistCreditKey{artistId: songArtist.ArtistId, group: creditGroup(songArtist.Role)}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		songArtist.Position = len(artists)
+		artists = append(artists, songArtist)
+	}
+	if song.ArtistId != nil && *song.ArtistId == fromArtistId {
+		song.ArtistId = &toArtist.ArtistId
+	}
+
+	err = s.SongRepo.Update(tx, song.SongId, song)
+	if err != nil {
+		return err
+	}
+	return s.saveSongArtists(tx, song.SongId, artists)
+}
@@ -0,0 +1,53 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// MergeGenres merges the source genres into the target genre. Their songs list the target genre, their
+// subgenres move under it and their names become aliases of it, so later scans keep the merge
+func (s *Service) MergeGenres(tx *sqlx.Tx, targetGenreId int, sourceGenreIds []int) (genre model.Genre, err error) {
+	log.Debug().Int("targetGenreId", targetGenreId).Ints("sourceGenreIds", sourceGenreIds).Msg("Merging genres")
+
+	_, err = s.GenreService.Get(tx, targetGenreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", targetGenreId).Msg("Failed to get target genre")
+		return model.Genre{}, err
+	}
+	for _, sourceGenreId := range sourceGenreIds {
+		if sourceGenreId == targetGenreId {
+			err = errors.Conflict{Message: fmt.Sprintf("genre with id=%d cannot be merged into itself", sourceGenreId)}
+			log.Error().Err(err).Int("genreId", sourceGenreId).Msg("Genre merged into itself")
+			return model.Genre{}, err
+		}
+		_, err = s.GenreService.Get(tx, sourceGenreId)
+		if err != nil {
+			log.Error().Err(err).Int("genreId", sourceGenreId).Msg("Failed to get source genre")
+			return model.Genre{}, err
+		}
+	}
+
+	for _, sourceGenreId := range sourceGenreIds {
+		err = s.mergeGenre(tx, model.GenreNormalization{
+			GenreId:           sourceGenreId,
+			CanonicalGenreIds: []int{targetGenreId},
+		})
+		if err != nil {
+			log.Error().Err(err).Int("genreId", sourceGenreId).Msg("Failed to merge genre")
+			return model.Genre{}, err
+		}
+	}
+
+	genre, err = s.GenreService.Get(tx, targetGenreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", targetGenreId).Msg("Failed to get merged genre")
+		return model.Genre{}, err
+	}
+
+	log.Info().Int("genreId", targetGenreId).Int("countOfMergedGenres", len(sourceGenreIds)).Msg("Genres merged successfully")
+	return genre, nil
+}
@@ -0,0 +1,144 @@
+package song_service
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/dhowden/tag"
+	"io"
+	"strconv"
+)
+
+// mp4Metadata adds the iTunes items the tag package skips, like the work and movement of classical
+// music, to the raw tags of an MP4 file. The tempo is read again too, as the tag package keeps only its
+// first byte
+type mp4Metadata struct {
+	tag.Metadata
+	items map[string]interface{}
+}
+
+// mp4ExtraItems are the items read besides the tag package, with whether their value is a number
+var mp4ExtraItems = map[string]bool{
+	"\xa9wrk": false,
+	"\xa9mvn": false,
+	"\xa9mvi": true,
+	"\xa9mvc": true,
+	"soar":    false,
+	"soaa":    false,
+	"soal":    false,
+	"sonm":    false,
+	"tmpo":    true,
+}
+
+func (m mp4Metadata) Raw() map[string]interface{} {
+	raw := make(map[string]interface{})
+	for key, value := range m.Metadata.Raw() {
+		raw[key] = value
+	}
+	for key, value := range m.items {
+		raw[key] = value
+	}
+	return raw
+}
+
+// readMP4Items reads the extra items of the ilst atom. Only the headers of the atoms on the way and of the
+// other items are read, so neither the media data nor the cover art is fetched
+func readMP4Items(r io.ReadSeeker) (items map[string]interface{}, err error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	start := int64(0)
+	for _, kind := range []string{"moov", "udta", "meta", "ilst"} {
+		start, end, err = findMP4Atom(r, start, end, kind)
+		if err != nil {
+			return nil, err
+		}
+		if kind == "meta" {
+			// meta atoms of QuickTime files have no version and flags
+			header := make([]byte, 8)
+			if _, err = r.Seek(start, io.SeekStart); err != nil {
+				return nil, err
+			}
+			if _, err = io.ReadFull(r, header); err != nil {
+				return nil, err
+			}
+			if string(header[4:8]) != "hdlr" {
+				start += 4
+			}
+		}
+	}
+
+	// Items are read one by one, so cover art is skipped without being fetched
+	items = make(map[string]interface{})
+	for position := start; position+mp4HeaderSize <= end; {
+		kind, headerSize, size, err := readMP4AtomHeader(r, position, end)
+		if err != nil {
+			return nil, err
+		}
+		number, ok := mp4ExtraItems[kind]
+		position += size
+		if !ok {
+			continue
+		}
+
+		payload := make([]byte, size-headerSize)
+		if _, err = io.ReadFull(r, payload); err != nil {
+			return nil, err
+		}
+		data := mp4ItemData(&mp4Atom{kind: kind, payload: payload})
+		if !number {
+			items[kind] = string(data)
+		} else if len(data) > 0 && len(data) <= 8 {
+			value := uint64(0)
+			for _, b := range data {
+				value = value<<8 | uint64(b)
+			}
+			items[kind] = strconv.FormatUint(value, 10)
+		}
+	}
+	return items, nil
+}
+
+// findMP4Atom looks for the atom of the kind among the atoms between start and end, and returns where its
+// payload starts and ends
+func findMP4Atom(r io.ReadSeeker, start int64, end int64, kind string) (payloadStart int64, payloadEnd int64, err error) {
+	for position := start; position+mp4HeaderSize <= end; {
+		atomKind, headerSize, size, err := readMP4AtomHeader(r, position, end)
+		if err != nil {
+			return 0, 0, err
+		}
+		if atomKind == kind {
+			return position + headerSize, position + size, nil
+		}
+		position += size
+	}
+	return 0, 0, errors.New("no " + kind + " atom")
+}
+
+// readMP4AtomHeader reads the header of the atom at the position, leaving the reader at its payload. Atoms
+// may not reach past end
+func readMP4AtomHeader(r io.ReadSeeker, position int64, end int64) (kind string, headerSize int64, size int64, err error) {
+	header := make([]byte, 16)
+	if _, err = r.Seek(position, io.SeekStart); err != nil {
+		return "", 0, 0, err
+	}
+	if _, err = io.ReadFull(r, header[:mp4HeaderSize]); err != nil {
+		return "", 0, 0, err
+	}
+	size = int64(binary.BigEndian.Uint32(header[:4]))
+	headerSize = int64(mp4HeaderSize)
+	switch size {
+	case 0:
+		size = end - position
+	case 1:
+		if _, err = io.ReadFull(r, header[mp4HeaderSize:]); err != nil {
+			return "", 0, 0, err
+		}
+		size = int64(binary.BigEndian.Uint64(header[8:16]))
+		headerSize = 16
+	}
+	if size < headerSize || position+size > end {
+		return "", 0, 0, errors.New("MP4 atom is longer than its parent")
+	}
+	return string(header[4:8]), headerSize, size, nil
+}
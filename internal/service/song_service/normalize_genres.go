@@ -0,0 +1,100 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// NormalizeGenres merges genres stored with legacy names, like "(17)", "(17)Rock" or "17", into their
+// canonical genres. Songs keep their genre positions and the legacy genres are removed. New scans
+// normalize genre names on their own, so this is only needed once for data scanned before
+func (s *Service) NormalizeGenres(tx *sqlx.Tx) (normalizations []model.GenreNormalization, err error) {
+	log.Debug().Msg("Normalizing genres")
+
+	genres, err := s.GenreService.GetAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get genres")
+		return make([]model.GenreNormalization, 0), err
+	}
+
+	normalizations = make([]model.GenreNormalization, 0)
+	for _, genre := range genres {
+		names := normalizeGenre(genre.Name)
+		if len(names) == 0 || (len(names) == 1 && model.MatchingKey(names[0]) == genre.NameKey) {
+			continue
+		}
+
+		_, canonicalGenres, err := s.getOrCreateGenres(tx, names, nil)
+		if err != nil {
+			log.Error().Err(err).Int("genreId", genre.GenreId).Msg("Failed to get canonical genres")
+			return make([]model.GenreNormalization, 0), err
+		}
+		normalization := model.GenreNormalization{
+			GenreId: genre.GenreId,
+			Name:    genre.Name,
+		}
+		for _, canonicalGenre := range canonicalGenres {
+			normalization.CanonicalGenreIds = append(normalization.CanonicalGenreIds, canonicalGenre.GenreId)
+		}
+
+		err = s.mergeGenre(tx, normalization)
+		if err != nil {
+			log.Error().Err(err).Int("genreId", genre.GenreId).Msg("Failed to merge genre")
+			return make([]model.GenreNormalization, 0), err
+		}
+		normalizations = append(normalizations, normalization)
+	}
+
+	log.Info().Int("countOfNormalizedGenres", len(normalizations)).Msg("Genres normalized successfully")
+	return normalizations, nil
+}
+
+// mergeGenre moves the songs of the genre to its canonical genres. Aliases, splits and subgenres go to
+// the first canonical genre, whose name then follows the spelling its songs use most often
+func (s *Service) mergeGenre(tx *sqlx.Tx, normalization model.GenreNormalization) (err error) {
+	genre, err := s.GenreService.Get(tx, normalization.GenreId)
+	if err != nil {
+		return err
+	}
+	canonicalGenre, err := s.GenreService.Get(tx, normalization.CanonicalGenreIds[0])
+	if err != nil {
+		return err
+	}
+
+	for _, canonicalGenreId := range normalization.CanonicalGenreIds {
+		err = s.SongGenreRepo.CopyGenre(tx, normalization.GenreId, canonicalGenreId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.SongRepo.ReplaceGenreId(tx, normalization.GenreId, normalization.CanonicalGenreIds[0])
+	if err != nil {
+		return err
+	}
+
+	err = s.SongGenreRepo.DeleteAllByGenreId(tx, normalization.GenreId)
+	if err != nil {
+		return err
+	}
+
+	err = s.SongSplitRepo.ReplaceId(tx, model.SongSplitKindGenre, genre.GenreId, canonicalGenre.GenreId)
+	if err != nil {
+		return err
+	}
+	err = s.GenreService.MoveAliases(tx, genre, canonicalGenre)
+	if err != nil {
+		return err
+	}
+	err = s.GenreService.ReplaceParent(tx, genre.GenreId, canonicalGenre.GenreId)
+	if err != nil {
+		return err
+	}
+
+	err = s.GenreService.Delete(tx, normalization.GenreId)
+	if err != nil {
+		return err
+	}
+	return s.GenreService.UpdateNameToMostCommon(tx, canonicalGenre.GenreId)
+}
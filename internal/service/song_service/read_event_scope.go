@@ -0,0 +1,70 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/client/music_files_client/audio_file_client"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// readEventScope fetches the given audio files that still exist and the songs linked to them. Songs with
+// the hash of one of the files are included together with their own audio files, so the plan can tell
+// a moved file from a copy without fetching the whole library
+func (s *Service) readEventScope(tx *sqlx.Tx, audioFileIds []int) (audioFiles []audio_file_client.GetAllResponseItem,
+	songs []model.Song, err error) {
+	allSongs, err := s.SongRepo.ReadAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get songs")
+		return nil, nil, err
+	}
+
+	fetched := make(map[int]bool)
+	audioFiles = make([]audio_file_client.GetAllResponseItem, 0, len(audioFileIds))
+	fetch := func(audioFileId int) (err error) {
+		if fetched[audioFileId] {
+			return nil
+		}
+		fetched[audioFileId] = true
+
+		audioFile, err := s.AudioFileClient.Get(audioFileId)
+		if _, ok := err.(errors.NotFound); ok {
+			log.Debug().Int("audioFileId", audioFileId).Msg("Audio file no longer exists")
+			return nil
+		}
+		if err != nil {
+			log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to fetch audio file")
+			return err
+		}
+		audioFiles = append(audioFiles, audio_file_client.GetAllResponseItem(audioFile))
+		return nil
+	}
+
+	requested := make(map[int]bool, len(audioFileIds))
+	for _, audioFileId := range audioFileIds {
+		requested[audioFileId] = true
+		if err = fetch(audioFileId); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	sha256s := make(map[string]bool, len(audioFiles))
+	for _, audioFile := range audioFiles {
+		sha256s[audioFile.Sha256] = true
+	}
+
+	songs = make([]model.Song, 0)
+	for _, song := range allSongs {
+		switch {
+		case requested[song.AudioFileId]:
+			songs = append(songs, song)
+		case sha256s[song.Sha256]:
+			if err = fetch(song.AudioFileId); err != nil {
+				return nil, nil, err
+			}
+			songs = append(songs, song)
+		}
+	}
+
+	return audioFiles, songs, nil
+}
@@ -0,0 +1,82 @@
+package song_service
+
+import (
+	"github.com/dhowden/tag"
+	"github.com/rs/zerolog/log"
+	"sync"
+)
+
+type readMetadataResult struct {
+	audioFileId int
+	metadata    tag.Metadata
+	err         error
+}
+
+// readMetadataInParallel downloads and parses audio files with a bounded pool of workers and hands every
+// result to write in the calling goroutine, so all database writes stay in one transaction and one goroutine.
+// Files that cannot be downloaded or parsed are handed to fail instead.
+// At most ScannerConfig.MaxFilesInMemory files are downloaded but not yet written at any moment.
+// The first error returned by write or fail stops the pool and is returned
+func (s *Service) readMetadataInParallel(audioFileIds []int,
+	write func(audioFileId int, metadata tag.Metadata) (err error),
+	fail func(audioFileId int, failure error) (err error)) (err error) {
+	workers := max(s.ScannerConfig.Workers, 1)
+	inMemory := make(chan struct{}, max(s.ScannerConfig.MaxFilesInMemory, 1))
+	jobs := make(chan int)
+	results := make(chan readMetadataResult)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(jobs)
+		for _, audioFileId := range audioFileIds {
+			select {
+			case inMemory <- struct{}{}:
+			case <-done:
+				return
+			}
+			select {
+			case jobs <- audioFileId:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for audioFileId := range jobs {
+				metadata, err := s.readMetadata(audioFileId)
+				select {
+				case results <- readMetadataResult{audioFileId: audioFileId, metadata: metadata, err: err}:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		if err == nil {
+			if result.err != nil {
+				err = fail(result.audioFileId, result.err)
+			} else {
+				err = write(result.audioFileId, result.metadata)
+			}
+			if err != nil {
+				log.Error().Err(err).Int("audioFileId", result.audioFileId).Msg("Stopping metadata reading")
+				close(done)
+			}
+		}
+		<-inMemory
+	}
+
+	return err
+}
@@ -0,0 +1,74 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"time"
+)
+
+// Rescan downloads and parses the audio files of the given songs again, even if their content did not change.
+// Files that fail are skipped and reported in the result like in Scan
+func (s *Service) Rescan(tx *sqlx.Tx, songs []model.Song, progress ScanProgress) (result ScanResult, err error) {
+	log.Debug().Int("countOfSongs", len(songs)).Msg("Rescanning songs")
+
+	progress.PhaseStarted(model.ScanPhasePreparing)
+	progress.FilesPlanned(len(songs))
+
+	progress.PhaseStarted(model.ScanPhaseChanging)
+	outcomes := newFileOutcomes(progress)
+	for _, song := range songs {
+		err = s.rescanSong(tx, song)
+		if err != nil {
+			err = outcomes.fail(song.AudioFileId, err)
+			if err != nil {
+				log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to rescan song")
+				return ScanResult{}, err
+			}
+			continue
+		}
+		outcomes.succeed(song.AudioFileId)
+	}
+	result.Failures = outcomes.failures
+	result.ResolvedAudioFileIds = outcomes.resolved
+
+	progress.PhaseStarted(model.ScanPhaseCleaning)
+	err = s.removeUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary items")
+		return ScanResult{}, err
+	}
+
+	progress.PhaseStarted(model.ScanPhaseFinished)
+	log.Debug().Msg("Songs rescanned successfully")
+	return result, nil
+}
+
+func (s *Service) rescanSong(tx *sqlx.Tx, song model.Song) (err error) {
+	audioFile, err := s.AudioFileClient.Get(song.AudioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", song.AudioFileId).Msg("Failed to fetch audio file")
+		return fileFailure{phase: model.ScanFailurePhaseDownload, err: err}
+	}
+
+	return s.reparseSong(tx, song, audioFile.Sha256, audioFile.LastContentUpdate)
+}
+
+// reparseSong reads the song again from its audio file, whose content has the given hash
+func (s *Service) reparseSong(tx *sqlx.Tx, song model.Song, sha256 string, lastContentUpdate time.Time) (err error) {
+	return persistFile(tx, func() (err error) {
+		newSong, err := s.SongByAudioFileWithoutSha(tx, song.AudioFileId)
+		if err != nil {
+			log.Error().Err(err).Int("audioFileId", song.AudioFileId).Msg("Failed to prepare newSong")
+			return err
+		}
+		newSong.Sha256 = sha256
+		newSong.LastContentUpdate = &lastContentUpdate
+		err = s.SongRepo.Update(tx, song.SongId, newSong)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to update newSong")
+			return err
+		}
+		return s.saveSongRelations(tx, song.SongId, newSong)
+	})
+}
@@ -1,199 +1,314 @@
 package song_service
 
 import (
+	"github.com/dhowden/tag"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 	"music-metadata/internal/client/music_files_client/audio_file_client"
 	"music-metadata/internal/model"
+	"time"
 )
 
-func (s *Service) Scan(tx *sqlx.Tx) (err error) {
-	log.Debug().Msg("Scanning songs")
+func (s *Service) Scan(tx *sqlx.Tx, options ScanOptions, progress ScanProgress) (result ScanResult, err error) {
+	log.Debug().Str("mode", string(options.Mode)).Bool("dryRun", options.DryRun).Msg("Scanning songs")
 
-	audioFiles, err := s.AudioFileClient.GetAll()
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to fetch audio files")
-		return err
-	}
-	audioFiles = removeDuplicateSha256(audioFiles)
-
-	songs, err := s.SongRepo.ReadAll(tx)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to get songs")
-		return err
-	}
+	progress.PhaseStarted(model.ScanPhasePreparing)
 
-	onlyAudioFileExists := make([]audio_file_client.GetAllResponseItem, 0)
-	onlySongExists := make([]model.Song, 0)
-	audioFilesWithChangedId := make([]audio_file_client.GetAllResponseItem, 0)
-	songsWithChangedContent := make([]model.Song, 0)
-
-	for _, audioFile := range audioFiles {
-		processed := false
-		for _, song := range songs {
-			if (audioFile.AudioFileId == song.AudioFileId) && (audioFile.Sha256 == song.Sha256) {
-				processed = true
-				break
-			} else if (audioFile.AudioFileId == song.AudioFileId) && (audioFile.Sha256 != song.Sha256) {
-				songsWithChangedContent = append(songsWithChangedContent, song)
-				processed = true
-				break
-			} else if (audioFile.AudioFileId != song.AudioFileId) && (audioFile.Sha256 == song.Sha256) {
-				audioFilesWithChangedId = append(audioFilesWithChangedId, audioFile)
-				processed = true
-				break
-			}
+	var audioFiles []audio_file_client.GetAllResponseItem
+	var songs []model.Song
+	if options.Mode == model.ScanModeEvent {
+		audioFiles, songs, err = s.readEventScope(tx, options.AudioFileIds)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to read audio files and songs affected by events")
+			return ScanResult{}, err
 		}
-		if processed {
-			continue
+	} else {
+		audioFiles, err = s.AudioFileClient.GetAll()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to fetch audio files")
+			return ScanResult{}, err
+		}
+		songs, err = s.SongRepo.ReadAll(tx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get songs")
+			return ScanResult{}, err
 		}
-
-		onlyAudioFileExists = append(onlyAudioFileExists, audioFile)
 	}
-	for _, song := range songs {
-		processed := false
-		for _, audioFile := range audioFiles {
-			if (audioFile.AudioFileId == song.AudioFileId) || (audioFile.Sha256 == song.Sha256) {
-				processed = true
-				break
-			}
+	isUnchanged := func(audioFile audio_file_client.GetAllResponseItem, song model.Song) bool {
+		return options.Mode == model.ScanModeIncremental && isUnchangedSince(audioFile, song, options.ContentUpdatedSince)
+	}
+	previousSha256s, err := s.SongRepo.ReadAllPreviousSha256s(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get previous song hashes")
+		return ScanResult{}, err
+	}
+	reparse := false
+	if options.Mode == model.ScanModeFull {
+		storedTagSchemaVersion, err := s.SettingRepo.Read(tx, tagSchemaVersionSetting)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get the tag schema version of the songs")
+			return ScanResult{}, err
 		}
-		if !processed {
-			onlySongExists = append(onlySongExists, song)
+		reparse = storedTagSchemaVersion == nil || *storedTagSchemaVersion != tagSchemaVersion
+	}
+	plan := buildScanPlan(audioFiles, songs, isUnchanged, previousSha256s, reparse)
+	outcomes := newFileOutcomes(progress)
+	if options.Mode == model.ScanModeRetry || options.Mode == model.ScanModeEvent {
+		var goneAudioFileIds []int
+		plan, goneAudioFileIds = plan.restrictTo(options.AudioFileIds, audioFiles)
+		outcomes.resolved = append(outcomes.resolved, goneAudioFileIds...)
+	}
+	result.Ambiguities = plan.ambiguities
+	log.Debug().Bool("reparse", reparse).Int("created", len(plan.created)).Int("removed", len(plan.removed)).Int("moved", len(plan.moved)).
+		Int("changed", len(plan.changed)).Int("ambiguities", len(plan.ambiguities)).Msg("Scan planned")
+
+	progress.FilesPlanned(plan.size())
+
+	var catalogBefore catalogSnapshot
+	if options.DryRun {
+		catalogBefore, err = s.snapshotCatalog(tx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to snapshot catalog before scan")
+			return ScanResult{}, err
 		}
 	}
 
-	err = s.createMissedSongs(tx, onlyAudioFileExists)
+	progress.PhaseStarted(model.ScanPhaseCreating)
+	createdSongs, err := s.createMissedSongs(tx, plan.created, outcomes)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to created missed songs")
-		return err
+		return ScanResult{}, err
 	}
 
-	err = s.removeObsoleteSongs(tx, onlySongExists)
+	progress.PhaseStarted(model.ScanPhaseRemoving)
+	err = s.removeObsoleteSongs(tx, plan.removed, progress)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to remove obsolete songs")
-		return err
+		return ScanResult{}, err
 	}
 
-	err = s.updateSongsWithChangedAudioFileId(tx, audioFilesWithChangedId)
+	progress.PhaseStarted(model.ScanPhaseMoving)
+	relinkedSongs, err := s.updateSongsWithChangedAudioFileId(tx, plan.moved, outcomes)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update songs with changed id")
-		return err
+		return ScanResult{}, err
 	}
 
-	err = s.updateSongsWithChangedContent(tx, songsWithChangedContent)
+	progress.PhaseStarted(model.ScanPhaseChanging)
+	reparsedSongs, err := s.updateSongsWithChangedContent(tx, plan.changed, outcomes)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to update songs with changed content")
-		return err
+		return ScanResult{}, err
+	}
+	result.Failures = outcomes.failures
+	result.ResolvedAudioFileIds = outcomes.resolved
+	if options.Mode == model.ScanModeFull || options.Mode == model.ScanModeIncremental {
+		result.ContentHighWaterMark = contentHighWaterMark(audioFiles, outcomes.failures)
 	}
 
-	err = s.AlbumService.RemoveUnnecessaryItems(tx)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to remove unnecessary albums")
-		return err
+	// The version is saved only once every file was read, so the next full scan retries the failed ones
+	if reparse && len(outcomes.failures) == 0 {
+		err = s.SettingRepo.Save(tx, tagSchemaVersionSetting, tagSchemaVersion)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to save the tag schema version of the songs")
+			return ScanResult{}, err
+		}
 	}
 
-	err = s.ArtistService.RemoveUnnecessaryItems(tx)
+	progress.PhaseStarted(model.ScanPhaseCleaning)
+	err = s.removeUnnecessaryItems(tx)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to remove unnecessary artists")
-		return err
+		log.Error().Err(err).Msg("Failed to remove unnecessary items")
+		return ScanResult{}, err
 	}
 
-	err = s.GenreService.RemoveUnnecessaryItems(tx)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to remove unnecessary genres")
-		return err
+	if options.DryRun {
+		catalogAfter, err := s.snapshotCatalog(tx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to snapshot catalog after scan")
+			return ScanResult{}, err
+		}
+		diff := buildScanDiff(plan, createdSongs, relinkedSongs, reparsedSongs, catalogBefore, catalogAfter)
+		result.Diff = &diff
 	}
 
+	progress.PhaseStarted(model.ScanPhaseFinished)
 	log.Debug().Msg("Songs scanned successfully")
-	return nil
+	return result, nil
 }
 
-func (s *Service) createMissedSongs(tx *sqlx.Tx, audioFiles []audio_file_client.GetAllResponseItem) (err error) {
-	for _, audioFile := range audioFiles {
-		song, err := s.SongByAudioFileWithoutSha(tx, audioFile.AudioFileId)
-		if err != nil {
-			log.Error().Err(err).Int("audioFileId", audioFile.AudioFileId).Msg("Failed to prepare song")
-			return err
-		}
-		song.Sha256 = audioFile.Sha256
-		_, err = s.SongRepo.Create(tx, song)
+func (s *Service) createMissedSongs(tx *sqlx.Tx, audioFiles []audio_file_client.GetAllResponseItem, outcomes *fileOutcomes) (createdSongs []model.Song, err error) {
+	audioFileIds := make([]int, len(audioFiles))
+	audioFilesById := make(map[int]audio_file_client.GetAllResponseItem, len(audioFiles))
+	for i, audioFile := range audioFiles {
+		audioFileIds[i] = audioFile.AudioFileId
+		audioFilesById[audioFile.AudioFileId] = audioFile
+	}
+
+	createdSongs = make([]model.Song, 0, len(audioFiles))
+	err = s.readMetadataInParallel(audioFileIds, func(audioFileId int, metadata tag.Metadata) (err error) {
+		audioFile := audioFilesById[audioFileId]
+		var song model.Song
+		err = persistFile(tx, func() (err error) {
+			song, err = s.songByMetadata(tx, audioFileId, metadata)
+			if err != nil {
+				log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to prepare song")
+				return err
+			}
+			song.Sha256 = audioFile.Sha256
+			song.LastContentUpdate = &audioFile.LastContentUpdate
+			song.SongId, err = s.SongRepo.Create(tx, song)
+			if err != nil {
+				log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to create song")
+				return err
+			}
+			return s.saveSongRelations(tx, song.SongId, song)
+		})
 		if err != nil {
-			log.Error().Err(err).Int("audioFileId", audioFile.AudioFileId).Msg("Failed to create song")
-			return err
+			return outcomes.fail(audioFileId, err)
 		}
-	}
-	return nil
+		createdSongs = append(createdSongs, song)
+		outcomes.succeed(audioFileId)
+		return nil
+	}, outcomes.fail)
+	return createdSongs, err
 }
 
-func (s *Service) removeObsoleteSongs(tx *sqlx.Tx, songs []model.Song) (err error) {
+func (s *Service) removeObsoleteSongs(tx *sqlx.Tx, songs []model.Song, progress ScanProgress) (err error) {
 	for _, song := range songs {
 		err = s.SongRepo.Delete(tx, song.SongId)
 		if err != nil {
 			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to delete song")
 			return err
 		}
+		progress.FileProcessed()
 	}
 	return nil
 }
 
-func (s *Service) updateSongsWithChangedAudioFileId(tx *sqlx.Tx, audioFiles []audio_file_client.GetAllResponseItem) (err error) {
-	songs, err := s.SongRepo.ReadAll(tx)
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to get all songs")
-		return err
-	}
-
-	for _, audioFile := range audioFiles {
-		songId := -1
-		for _, song := range songs {
-			if song.Sha256 == audioFile.Sha256 {
-				songId = song.SongId
-				break
+func (s *Service) updateSongsWithChangedAudioFileId(tx *sqlx.Tx, moved []songWithAudioFile, outcomes *fileOutcomes) (relinked []songWithAudioFile, err error) {
+	relinked = make([]songWithAudioFile, 0, len(moved))
+	for _, pair := range moved {
+		err = persistFile(tx, func() (err error) {
+			err = s.SongRepo.UpdateAudioFileId(tx, pair.song.SongId, pair.audioFile.AudioFileId)
+			if err != nil {
+				log.Error().Err(err).Int("songId", pair.song.SongId).Msg("Failed to update audio file id")
+				return err
 			}
-		}
-		err := s.SongRepo.UpdateAudioFileId(tx, songId, audioFile.AudioFileId)
+			return nil
+		})
 		if err != nil {
-			log.Error().Err(err).Int("songId", songId).Msg("Failed to update audio file id")
-			return err
+			err = outcomes.fail(pair.audioFile.AudioFileId, err)
+			if err != nil {
+				return relinked, err
+			}
+			continue
 		}
+		relinked = append(relinked, pair)
+		outcomes.succeed(pair.audioFile.AudioFileId)
 	}
-	return nil
+	return relinked, nil
 }
 
-func (s *Service) updateSongsWithChangedContent(tx *sqlx.Tx, songs []model.Song) (err error) {
-	for _, song := range songs {
-		audioFile, err := s.AudioFileClient.Get(song.AudioFileId)
-		if err != nil {
-			log.Error().Err(err).Int("songId", song.SongId).Int("audioFileId", song.AudioFileId).Msg("Failed to fetch audio file")
-			return err
-		}
+func (s *Service) updateSongsWithChangedContent(tx *sqlx.Tx, changed []songWithAudioFile, outcomes *fileOutcomes) (updatedSongs []model.Song, err error) {
+	audioFileIds := make([]int, len(changed))
+	changedByAudioFileId := make(map[int]songWithAudioFile, len(changed))
+	for i, pair := range changed {
+		audioFileIds[i] = pair.audioFile.AudioFileId
+		changedByAudioFileId[pair.audioFile.AudioFileId] = pair
+	}
 
-		newSong, err := s.SongByAudioFileWithoutSha(tx, audioFile.AudioFileId)
-		if err != nil {
-			log.Error().Err(err).Int("audioFileId", audioFile.AudioFileId).Msg("Failed to prepare newSong")
-			return err
-		}
-		newSong.Sha256 = audioFile.Sha256
-		err = s.SongRepo.Update(tx, song.SongId, newSong)
+	updatedSongs = make([]model.Song, 0, len(changed))
+	err = s.readMetadataInParallel(audioFileIds, func(audioFileId int, metadata tag.Metadata) (err error) {
+		pair := changedByAudioFileId[audioFileId]
+		var newSong model.Song
+		err = persistFile(tx, func() (err error) {
+			newSong, err = s.songByMetadata(tx, audioFileId, metadata)
+			if err != nil {
+				log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to prepare newSong")
+				return err
+			}
+			newSong.Sha256 = pair.audioFile.Sha256
+			newSong.LastContentUpdate = &pair.audioFile.LastContentUpdate
+			err = s.SongRepo.Update(tx, pair.song.SongId, newSong)
+			if err != nil {
+				log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to update newSong")
+				return err
+			}
+			return s.saveSongRelations(tx, pair.song.SongId, newSong)
+		})
 		if err != nil {
-			log.Error().Err(err).Int("audioFileId", audioFile.AudioFileId).Msg("Failed to create newSong")
-			return err
+			return outcomes.fail(audioFileId, err)
 		}
+		newSong.SongId = pair.song.SongId
+		updatedSongs = append(updatedSongs, newSong)
+		outcomes.succeed(audioFileId)
+		return nil
+	}, outcomes.fail)
+	return updatedSongs, err
+}
+
+// removeUnnecessaryItems removes albums, works, artists and genres that no song refers to anymore
+func (s *Service) removeUnnecessaryItems(tx *sqlx.Tx) (err error) {
+	err = s.AlbumService.RemoveUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary albums")
+		return err
+	}
+
+	// Works go before artists, as they keep their composers
+	err = s.WorkService.RemoveUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary works")
+		return err
 	}
+
+	err = s.ArtistService.RemoveUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary artists")
+		return err
+	}
+
+	err = s.GenreService.RemoveUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary genres")
+		return err
+	}
+
 	return nil
 }
 
-func removeDuplicateSha256(audioFiles []audio_file_client.GetAllResponseItem) []audio_file_client.GetAllResponseItem {
-	uniqueMap := make(map[string]bool)
-	var uniqueAudioFiles []audio_file_client.GetAllResponseItem
+// contentHighWaterMark is the latest content update the scan has taken in. It stays below the updates of
+// the files that failed, so the next incremental scan reads them again
+func contentHighWaterMark(audioFiles []audio_file_client.GetAllResponseItem, failures []model.ScanFailure) *time.Time {
+	failed := make(map[int]bool, len(failures))
+	for _, failure := range failures {
+		failed[failure.AudioFileId] = true
+	}
 
-	for _, file := range audioFiles {
-		if _, exists := uniqueMap[file.Sha256]; !exists {
-			uniqueMap[file.Sha256] = true
-			uniqueAudioFiles = append(uniqueAudioFiles, file)
+	var highWaterMark *time.Time
+	for i := range audioFiles {
+		if highWaterMark == nil || audioFiles[i].LastContentUpdate.After(*highWaterMark) {
+			highWaterMark = &audioFiles[i].LastContentUpdate
 		}
 	}
+	for _, audioFile := range audioFiles {
+		if failed[audioFile.AudioFileId] && !audioFile.LastContentUpdate.After(*highWaterMark) {
+			beforeFailure := audioFile.LastContentUpdate.Add(-time.Nanosecond)
+			highWaterMark = &beforeFailure
+		}
+	}
+	return highWaterMark
+}
 
-	return uniqueAudioFiles
+// isUnchangedSince reports whether the content of the audio file was not updated after both
+// the previous scan and the last content update stored with the song
+func isUnchangedSince(audioFile audio_file_client.GetAllResponseItem, song model.Song, since *time.Time) bool {
+	if since != nil && !audioFile.LastContentUpdate.After(*since) {
+		return true
+	}
+	if song.LastContentUpdate != nil && !audioFile.LastContentUpdate.After(*song.LastContentUpdate) {
+		return true
+	}
+	return false
 }
@@ -0,0 +1,119 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"sort"
+)
+
+// catalogSnapshot holds albums, artists and genres by id, so the catalog before and after a scan can be compared
+type catalogSnapshot struct {
+	albums  map[int]string
+	artists map[int]string
+	genres  map[int]string
+}
+
+func (s *Service) snapshotCatalog(tx *sqlx.Tx) (snapshot catalogSnapshot, err error) {
+	albums, err := s.AlbumService.GetAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get albums")
+		return catalogSnapshot{}, err
+	}
+	artists, err := s.ArtistService.GetAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get artists")
+		return catalogSnapshot{}, err
+	}
+	genres, err := s.GenreService.GetAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get genres")
+		return catalogSnapshot{}, err
+	}
+
+	snapshot = catalogSnapshot{
+		albums:  make(map[int]string, len(albums)),
+		artists: make(map[int]string, len(artists)),
+		genres:  make(map[int]string, len(genres)),
+	}
+	for _, album := range albums {
+		snapshot.albums[album.AlbumId] = album.Title
+	}
+	for _, artist := range artists {
+		snapshot.artists[artist.ArtistId] = artist.Name
+	}
+	for _, genre := range genres {
+		snapshot.genres[genre.GenreId] = genre.Name
+	}
+	return snapshot, nil
+}
+
+// buildScanDiff describes the applied plan. Ids of created songs are omitted and ids of created albums,
+// artists and genres are provisional, because a dry run is rolled back
+func buildScanDiff(plan scanPlan, createdSongs []model.Song, relinkedSongs []songWithAudioFile, reparsedSongs []model.Song,
+	before catalogSnapshot, after catalogSnapshot) (diff model.ScanDiff) {
+	diff = model.ScanDiff{
+		CreatedSongs:  make([]model.ScanDiffSong, len(createdSongs)),
+		RemovedSongs:  make([]model.ScanDiffSong, len(plan.removed)),
+		RelinkedSongs: make([]model.ScanDiffSong, len(relinkedSongs)),
+		ReparsedSongs: make([]model.ScanDiffSong, len(reparsedSongs)),
+	}
+
+	for i, song := range createdSongs {
+		diff.CreatedSongs[i] = newScanDiffSong(song)
+		diff.CreatedSongs[i].SongId = nil
+	}
+	for i, song := range plan.removed {
+		diff.RemovedSongs[i] = newScanDiffSong(song)
+	}
+	for i, pair := range relinkedSongs {
+		previousAudioFileId := pair.song.AudioFileId
+		diff.RelinkedSongs[i] = newScanDiffSong(pair.song)
+		diff.RelinkedSongs[i].AudioFileId = pair.audioFile.AudioFileId
+		diff.RelinkedSongs[i].PreviousAudioFileId = &previousAudioFileId
+	}
+	for i, song := range reparsedSongs {
+		diff.ReparsedSongs[i] = newScanDiffSong(song)
+	}
+
+	diff.CreatedAlbums, diff.RemovedAlbums = compareCatalogItems(before.albums, after.albums)
+	diff.CreatedArtists, diff.RemovedArtists = compareCatalogItems(before.artists, after.artists)
+	diff.CreatedGenres, diff.RemovedGenres = compareCatalogItems(before.genres, after.genres)
+
+	return diff
+}
+
+func newScanDiffSong(song model.Song) model.ScanDiffSong {
+	songId := song.SongId
+	return model.ScanDiffSong{
+		SongId:      &songId,
+		AudioFileId: song.AudioFileId,
+		Title:       song.Title,
+		AlbumId:     song.AlbumId,
+		ArtistId:    song.ArtistId,
+		GenreId:     song.GenreId,
+		Year:        song.Year,
+		SongNumber:  song.SongNumber,
+		DiscNumber:  song.DiscNumber,
+		Lyrics:      song.Lyrics,
+		Sha256:      song.Sha256,
+	}
+}
+
+func compareCatalogItems(before map[int]string, after map[int]string) (created []model.ScanDiffItem, removed []model.ScanDiffItem) {
+	created = make([]model.ScanDiffItem, 0)
+	removed = make([]model.ScanDiffItem, 0)
+	for id, name := range after {
+		if _, exists := before[id]; !exists {
+			created = append(created, model.ScanDiffItem{Id: id, Name: name})
+		}
+	}
+	for id, name := range before {
+		if _, exists := after[id]; !exists {
+			removed = append(removed, model.ScanDiffItem{Id: id, Name: name})
+		}
+	}
+	sort.Slice(created, func(i, j int) bool { return created[i].Id < created[j].Id })
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Id < removed[j].Id })
+	return created, removed
+}
@@ -0,0 +1,77 @@
+package song_service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"music-metadata/internal/service"
+	"time"
+)
+
+// fileFailure is an error that concerns a single audio file, so the scan records it and goes on with other files
+type fileFailure struct {
+	phase model.ScanFailurePhase
+	err   error
+}
+
+func (f fileFailure) Error() string {
+	return fmt.Sprintf("%s failed: %v", f.phase, f.err)
+}
+
+func (f fileFailure) Unwrap() error {
+	return f.err
+}
+
+// fileOutcomes collects the audio files a scan processed successfully and the ones that failed
+type fileOutcomes struct {
+	progress ScanProgress
+	resolved []int
+	failures []model.ScanFailure
+}
+
+func newFileOutcomes(progress ScanProgress) *fileOutcomes {
+	return &fileOutcomes{
+		progress: progress,
+		resolved: make([]int, 0),
+		failures: make([]model.ScanFailure, 0),
+	}
+}
+
+func (o *fileOutcomes) succeed(audioFileId int) {
+	o.resolved = append(o.resolved, audioFileId)
+	o.progress.FileProcessed()
+}
+
+// fail records the failure of an audio file. Errors that are not failures of the file itself are returned
+// to abort the scan
+func (o *fileOutcomes) fail(audioFileId int, err error) error {
+	var failure fileFailure
+	if !errors.As(err, &failure) {
+		return err
+	}
+
+	log.Warn().Err(failure.err).Int("audioFileId", audioFileId).Str("phase", string(failure.phase)).Msg("Audio file skipped")
+	o.failures = append(o.failures, model.ScanFailure{
+		AudioFileId: audioFileId,
+		Phase:       failure.phase,
+		Error:       failure.err.Error(),
+		FailedAt:    time.Now().UTC(),
+	})
+	o.progress.FileFailed()
+	return nil
+}
+
+// persistFile runs the writes of a single audio file in a savepoint, so their failure rolls back
+// only this file. Errors that are not already a fileFailure are reported as a persist failure
+func persistFile(tx *sqlx.Tx, persist func() (err error)) (err error) {
+	return service.WithSavepoint(tx, func() (err error) {
+		err = persist()
+		var failure fileFailure
+		if err != nil && !errors.As(err, &failure) {
+			return fileFailure{phase: model.ScanFailurePhasePersist, err: err}
+		}
+		return err
+	})
+}
@@ -0,0 +1,32 @@
+package song_service
+
+import (
+	"music-metadata/internal/model"
+	"time"
+)
+
+type ScanOptions struct {
+	Mode model.ScanMode
+	// ContentUpdatedSince is the high-water mark of the previous successful scan. In incremental
+	// mode files that already have a song with the same id and were not updated after it are skipped
+	ContentUpdatedSince *time.Time
+	// DryRun makes the scan describe its changes in ScanResult.Diff. Rolling them back is up to the caller
+	DryRun bool
+	// AudioFileIds are the audio files a scan in retry or event mode is limited to
+	AudioFileIds []int
+}
+
+type ScanResult struct {
+	// ContentHighWaterMark is the latest content update among all audio files seen by the scan.
+	// Scans in retry and event modes do not look at all files, so they leave the mark empty
+	ContentHighWaterMark *time.Time
+	// Ambiguities are the audio files and songs the scan could not match unambiguously
+	Ambiguities []model.ScanAmbiguity
+	// Diff describes the applied changes, it is only filled in dry runs
+	Diff *model.ScanDiff
+	// Failures are the audio files the scan skipped because they could not be downloaded, parsed or saved
+	Failures []model.ScanFailure
+	// ResolvedAudioFileIds are the audio files that were processed successfully or no longer exist,
+	// so they can leave the quarantine
+	ResolvedAudioFileIds []int
+}
@@ -0,0 +1,236 @@
+package song_service
+
+import (
+	"fmt"
+	"music-metadata/internal/client/music_files_client/audio_file_client"
+	"music-metadata/internal/model"
+)
+
+// songWithAudioFile pairs a stored song with the audio file it has to be reconciled with
+type songWithAudioFile struct {
+	song      model.Song
+	audioFile audio_file_client.GetAllResponseItem
+}
+
+// scanPlan is the set of actions a scan has to take to bring songs in line with audio files
+type scanPlan struct {
+	created     []audio_file_client.GetAllResponseItem
+	removed     []model.Song
+	moved       []songWithAudioFile
+	changed     []songWithAudioFile
+	ambiguities []model.ScanAmbiguity
+}
+
+func (p scanPlan) size() int {
+	return len(p.created) + len(p.removed) + len(p.moved) + len(p.changed)
+}
+
+// buildScanPlan matches audio files with songs by id and by hash using indexes, so the plan is built in
+// linear time. Files that cannot be matched unambiguously are left out of the plan and reported.
+// isUnchanged lets the caller treat a file as unchanged without comparing hashes. previousSha256s are the
+// hashes songs had before their content changed, by song id. reparse puts the files that match their songs
+// by id and hash among the changed ones, so their tags are read again
+func buildScanPlan(audioFiles []audio_file_client.GetAllResponseItem, songs []model.Song,
+	isUnchanged func(audioFile audio_file_client.GetAllResponseItem, song model.Song) bool,
+	previousSha256s map[int][]string, reparse bool) (plan scanPlan) {
+	plan = scanPlan{
+		created:     make([]audio_file_client.GetAllResponseItem, 0),
+		removed:     make([]model.Song, 0),
+		moved:       make([]songWithAudioFile, 0),
+		changed:     make([]songWithAudioFile, 0),
+		ambiguities: make([]model.ScanAmbiguity, 0),
+	}
+
+	songsByAudioFileId := make(map[int]model.Song, len(songs))
+	songsBySha256 := make(map[string]model.Song, len(songs))
+	for _, song := range songs {
+		songsByAudioFileId[song.AudioFileId] = song
+		songsBySha256[song.Sha256] = song
+	}
+
+	audioFiles = plan.keepOneAudioFilePerSha256(audioFiles, songsByAudioFileId)
+	audioFilesById := make(map[int]audio_file_client.GetAllResponseItem, len(audioFiles))
+	for _, audioFile := range audioFiles {
+		audioFilesById[audioFile.AudioFileId] = audioFile
+	}
+
+	claimed := make(map[int]bool, len(songs))
+	for _, audioFile := range audioFiles {
+		songById, existsById := songsByAudioFileId[audioFile.AudioFileId]
+		songBySha256, existsBySha256 := songsBySha256[audioFile.Sha256]
+
+		switch {
+		case existsById && (songById.Sha256 == audioFile.Sha256 || isUnchanged(audioFile, songById)):
+			claimed[songById.SongId] = true
+			if reparse && songById.Sha256 == audioFile.Sha256 {
+				plan.changed = append(plan.changed, songWithAudioFile{song: songById, audioFile: audioFile})
+			}
+		case existsById && existsBySha256:
+			claimed[songById.SongId] = true
+			_, stillExists := audioFilesById[songBySha256.AudioFileId]
+			if stillExists {
+				plan.ambiguities = append(plan.ambiguities, newAmbiguity(model.ScanAmbiguityConflictingMatch, audioFile, &songBySha256,
+					fmt.Sprintf("content of audio file %d now equals song %d whose own audio file %d still exists; skipped",
+						audioFile.AudioFileId, songBySha256.SongId, songBySha256.AudioFileId)))
+				continue
+			}
+			plan.ambiguities = append(plan.ambiguities, newAmbiguity(model.ScanAmbiguityConflictingMatch, audioFile, &songBySha256,
+				fmt.Sprintf("audio file %d matches song %d by id and song %d by hash; treated as changed content of song %d",
+					audioFile.AudioFileId, songById.SongId, songBySha256.SongId, songById.SongId)))
+			plan.changed = append(plan.changed, songWithAudioFile{song: songById, audioFile: audioFile})
+		case existsById:
+			claimed[songById.SongId] = true
+			plan.changed = append(plan.changed, songWithAudioFile{song: songById, audioFile: audioFile})
+		case existsBySha256:
+			_, stillExists := audioFilesById[songBySha256.AudioFileId]
+			if stillExists {
+				plan.ambiguities = append(plan.ambiguities, newAmbiguity(model.ScanAmbiguityConflictingMatch, audioFile, &songBySha256,
+					fmt.Sprintf("audio file %d has the former content of song %d whose audio file %d still exists; skipped",
+						audioFile.AudioFileId, songBySha256.SongId, songBySha256.AudioFileId)))
+				continue
+			}
+			claimed[songBySha256.SongId] = true
+			plan.moved = append(plan.moved, songWithAudioFile{song: songBySha256, audioFile: audioFile})
+		default:
+			plan.created = append(plan.created, audioFile)
+		}
+	}
+
+	// A removed song whose earlier content appeared in a new audio file may have moved and changed at once
+	createdBySha256 := make(map[string]audio_file_client.GetAllResponseItem, len(plan.created))
+	for _, audioFile := range plan.created {
+		createdBySha256[audioFile.Sha256] = audioFile
+	}
+	for _, song := range songs {
+		if claimed[song.SongId] {
+			continue
+		}
+		plan.removed = append(plan.removed, song)
+		for _, previousSha256 := range previousSha256s[song.SongId] {
+			audioFile, exists := createdBySha256[previousSha256]
+			if !exists {
+				continue
+			}
+			song := song
+			plan.ambiguities = append(plan.ambiguities, newAmbiguity(model.ScanAmbiguityIdAndHashChanged, audioFile, &song,
+				fmt.Sprintf("song %d lost both its audio file %d and its hash while new audio file %d has its previous content; treated as removed",
+					song.SongId, song.AudioFileId, audioFile.AudioFileId)))
+			break
+		}
+	}
+
+	return plan
+}
+
+// keepOneAudioFilePerSha256 leaves a single audio file for every hash. The file already linked to
+// a song wins, then the file whose id belongs to some song, then the file with the lowest id
+func (p *scanPlan) keepOneAudioFilePerSha256(audioFiles []audio_file_client.GetAllResponseItem,
+	songsByAudioFileId map[int]model.Song) []audio_file_client.GetAllResponseItem {
+	rank := func(audioFile audio_file_client.GetAllResponseItem) int {
+		song, exists := songsByAudioFileId[audioFile.AudioFileId]
+		switch {
+		case exists && song.Sha256 == audioFile.Sha256:
+			return 0
+		case exists:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	keptIndexBySha256 := make(map[string]int, len(audioFiles))
+	kept := make([]audio_file_client.GetAllResponseItem, 0, len(audioFiles))
+	skipped := make([]audio_file_client.GetAllResponseItem, 0)
+	for _, audioFile := range audioFiles {
+		i, exists := keptIndexBySha256[audioFile.Sha256]
+		if !exists {
+			keptIndexBySha256[audioFile.Sha256] = len(kept)
+			kept = append(kept, audioFile)
+			continue
+		}
+
+		current := kept[i]
+		if rank(audioFile) < rank(current) ||
+			(rank(audioFile) == rank(current) && audioFile.AudioFileId < current.AudioFileId) {
+			kept[i] = audioFile
+			skipped = append(skipped, current)
+		} else {
+			skipped = append(skipped, audioFile)
+		}
+	}
+
+	for _, audioFile := range skipped {
+		keptAudioFile := kept[keptIndexBySha256[audioFile.Sha256]]
+		p.ambiguities = append(p.ambiguities, newAmbiguity(model.ScanAmbiguityDuplicateSha256, audioFile, nil,
+			fmt.Sprintf("audio file %d has the same content as audio file %d; skipped",
+				audioFile.AudioFileId, keptAudioFile.AudioFileId)))
+	}
+
+	return kept
+}
+
+// restrictTo leaves in the plan only the actions on the given audio files, including removal of songs
+// linked to them, and the ambiguities about them. It also returns the given audio files that no longer exist
+func (p scanPlan) restrictTo(audioFileIds []int, audioFiles []audio_file_client.GetAllResponseItem) (restricted scanPlan, goneAudioFileIds []int) {
+	included := make(map[int]bool, len(audioFileIds))
+	for _, audioFileId := range audioFileIds {
+		included[audioFileId] = true
+	}
+
+	restricted = scanPlan{
+		created:     make([]audio_file_client.GetAllResponseItem, 0),
+		removed:     make([]model.Song, 0),
+		moved:       make([]songWithAudioFile, 0),
+		changed:     make([]songWithAudioFile, 0),
+		ambiguities: make([]model.ScanAmbiguity, 0),
+	}
+	for _, audioFile := range p.created {
+		if included[audioFile.AudioFileId] {
+			restricted.created = append(restricted.created, audioFile)
+		}
+	}
+	for _, song := range p.removed {
+		if included[song.AudioFileId] {
+			restricted.removed = append(restricted.removed, song)
+		}
+	}
+	for _, pair := range p.moved {
+		if included[pair.audioFile.AudioFileId] {
+			restricted.moved = append(restricted.moved, pair)
+		}
+	}
+	for _, pair := range p.changed {
+		if included[pair.audioFile.AudioFileId] {
+			restricted.changed = append(restricted.changed, pair)
+		}
+	}
+	for _, ambiguity := range p.ambiguities {
+		if ambiguity.AudioFileId != nil && included[*ambiguity.AudioFileId] {
+			restricted.ambiguities = append(restricted.ambiguities, ambiguity)
+		}
+	}
+
+	for _, audioFile := range audioFiles {
+		delete(included, audioFile.AudioFileId)
+	}
+	goneAudioFileIds = make([]int, 0, len(included))
+	for audioFileId := range included {
+		goneAudioFileIds = append(goneAudioFileIds, audioFileId)
+	}
+
+	return restricted, goneAudioFileIds
+}
+
+func newAmbiguity(kind model.ScanAmbiguityKind, audioFile audio_file_client.GetAllResponseItem,
+	song *model.Song, description string) model.ScanAmbiguity {
+	ambiguity := model.ScanAmbiguity{
+		Kind:        kind,
+		AudioFileId: &audioFile.AudioFileId,
+		Sha256:      &audioFile.Sha256,
+		Description: description,
+	}
+	if song != nil {
+		ambiguity.SongId = &song.SongId
+	}
+	return ambiguity
+}
@@ -0,0 +1,291 @@
+package song_service
+
+import (
+	"fmt"
+	"music-metadata/internal/client/music_files_client/audio_file_client"
+	"music-metadata/internal/model"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// planSummary describes a scan plan by ids, so expected plans are short to write
+type planSummary struct {
+	created     []int
+	removed     []int
+	moved       []string
+	changed     []string
+	ambiguities []string
+}
+
+func summarizePlan(plan scanPlan) planSummary {
+	summary := planSummary{}
+	for _, audioFile := range plan.created {
+		summary.created = append(summary.created, audioFile.AudioFileId)
+	}
+	for _, song := range plan.removed {
+		summary.removed = append(summary.removed, song.SongId)
+	}
+	for _, pair := range plan.moved {
+		summary.moved = append(summary.moved, fmt.Sprintf("song %d -> file %d", pair.song.SongId, pair.audioFile.AudioFileId))
+	}
+	for _, pair := range plan.changed {
+		summary.changed = append(summary.changed, fmt.Sprintf("song %d -> file %d", pair.song.SongId, pair.audioFile.AudioFileId))
+	}
+	summary.ambiguities = summarizeAmbiguities(plan.ambiguities)
+	return summary
+}
+
+func summarizeAmbiguities(ambiguities []model.ScanAmbiguity) []string {
+	var summary []string
+	for _, ambiguity := range ambiguities {
+		description := fmt.Sprintf("%s file %d", ambiguity.Kind, *ambiguity.AudioFileId)
+		if ambiguity.SongId != nil {
+			description += fmt.Sprintf(" song %d", *ambiguity.SongId)
+		}
+		summary = append(summary, description)
+	}
+	return summary
+}
+
+func audioFileOf(audioFileId int, sha256 string) audio_file_client.GetAllResponseItem {
+	return audio_file_client.GetAllResponseItem{AudioFileId: audioFileId, Sha256: sha256}
+}
+
+func songOf(songId int, audioFileId int, sha256 string) model.Song {
+	return model.Song{SongId: songId, AudioFileId: audioFileId, Sha256: sha256}
+}
+
+func neverUnchanged(audio_file_client.GetAllResponseItem, model.Song) bool {
+	return false
+}
+
+func TestBuildScanPlan(t *testing.T) {
+	tests := []struct {
+		name            string
+		audioFiles      []audio_file_client.GetAllResponseItem
+		songs           []model.Song
+		isUnchanged     func(audio_file_client.GetAllResponseItem, model.Song) bool
+		previousSha256s map[int][]string
+		reparse         bool
+		want            planSummary
+	}{
+		{
+			name:       "unchanged file",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "a")},
+			songs:      []model.Song{songOf(10, 1, "a")},
+			want:       planSummary{},
+		},
+		{
+			name:       "created file",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "a"), audioFileOf(2, "b")},
+			songs:      []model.Song{songOf(10, 1, "a")},
+			want:       planSummary{created: []int{2}},
+		},
+		{
+			name:       "removed file",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "a")},
+			songs:      []model.Song{songOf(10, 1, "a"), songOf(20, 2, "b")},
+			want:       planSummary{removed: []int{20}},
+		},
+		{
+			name:       "moved file",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(2, "a")},
+			songs:      []model.Song{songOf(10, 1, "a")},
+			want:       planSummary{moved: []string{"song 10 -> file 2"}},
+		},
+		{
+			name:       "changed file",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "b")},
+			songs:      []model.Song{songOf(10, 1, "a")},
+			want:       planSummary{changed: []string{"song 10 -> file 1"}},
+		},
+		{
+			name:        "changed file treated as unchanged",
+			audioFiles:  []audio_file_client.GetAllResponseItem{audioFileOf(1, "b")},
+			songs:       []model.Song{songOf(10, 1, "a")},
+			isUnchanged: func(audio_file_client.GetAllResponseItem, model.Song) bool { return true },
+			want:        planSummary{},
+		},
+		{
+			name:       "unchanged file reparsed",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "a"), audioFileOf(2, "b")},
+			songs:      []model.Song{songOf(10, 1, "a")},
+			reparse:    true,
+			want:       planSummary{created: []int{2}, changed: []string{"song 10 -> file 1"}},
+		},
+		{
+			name:       "duplicate sha256 keeps the linked file",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(2, "a"), audioFileOf(1, "a")},
+			songs:      []model.Song{songOf(10, 2, "a")},
+			want:       planSummary{ambiguities: []string{"duplicate_sha256 file 1"}},
+		},
+		{
+			name:       "duplicate sha256 keeps the lowest id of new files",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(3, "a"), audioFileOf(2, "a")},
+			want:       planSummary{created: []int{2}, ambiguities: []string{"duplicate_sha256 file 3"}},
+		},
+		{
+			name:       "conflicting match with an existing file is skipped",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "b"), audioFileOf(2, "c")},
+			songs:      []model.Song{songOf(10, 1, "a"), songOf(20, 2, "b")},
+			want: planSummary{
+				changed:     []string{"song 20 -> file 2"},
+				ambiguities: []string{"conflicting_match file 1 song 20"},
+			},
+		},
+		{
+			name:       "conflicting match with a gone file is treated as changed",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "b")},
+			songs:      []model.Song{songOf(10, 1, "a"), songOf(20, 2, "b")},
+			want: planSummary{
+				removed:     []int{20},
+				changed:     []string{"song 10 -> file 1"},
+				ambiguities: []string{"conflicting_match file 1 song 20"},
+			},
+		},
+		{
+			name:       "former content in a new file while the song file exists is skipped",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "b"), audioFileOf(2, "a")},
+			songs:      []model.Song{songOf(10, 1, "a")},
+			want: planSummary{
+				changed:     []string{"song 10 -> file 1"},
+				ambiguities: []string{"conflicting_match file 2 song 10"},
+			},
+		},
+		{
+			name:            "id and hash both changed",
+			audioFiles:      []audio_file_client.GetAllResponseItem{audioFileOf(3, "z")},
+			songs:           []model.Song{songOf(10, 1, "a")},
+			previousSha256s: map[int][]string{10: {"y", "z"}},
+			want: planSummary{
+				created:     []int{3},
+				removed:     []int{10},
+				ambiguities: []string{"id_and_hash_changed file 3 song 10"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isUnchanged := tt.isUnchanged
+			if isUnchanged == nil {
+				isUnchanged = neverUnchanged
+			}
+			got := summarizePlan(buildScanPlan(tt.audioFiles, tt.songs, isUnchanged, tt.previousSha256s, tt.reparse))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildScanPlan() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeepOneAudioFilePerSha256(t *testing.T) {
+	tests := []struct {
+		name            string
+		audioFiles      []audio_file_client.GetAllResponseItem
+		songs           []model.Song
+		wantKept        []int
+		wantAmbiguities []string
+	}{
+		{
+			name:       "distinct hashes",
+			audioFiles: []audio_file_client.GetAllResponseItem{audioFileOf(1, "a"), audioFileOf(2, "b")},
+			wantKept:   []int{1, 2},
+		},
+		{
+			name:            "file linked to a song with the same hash wins",
+			audioFiles:      []audio_file_client.GetAllResponseItem{audioFileOf(1, "a"), audioFileOf(2, "a"), audioFileOf(3, "a")},
+			songs:           []model.Song{songOf(10, 2, "b"), songOf(20, 3, "a")},
+			wantKept:        []int{3},
+			wantAmbiguities: []string{"duplicate_sha256 file 1", "duplicate_sha256 file 2"},
+		},
+		{
+			name:            "file whose id belongs to a song wins",
+			audioFiles:      []audio_file_client.GetAllResponseItem{audioFileOf(1, "a"), audioFileOf(2, "a")},
+			songs:           []model.Song{songOf(10, 2, "b")},
+			wantKept:        []int{2},
+			wantAmbiguities: []string{"duplicate_sha256 file 1"},
+		},
+		{
+			name:            "file with the lowest id wins",
+			audioFiles:      []audio_file_client.GetAllResponseItem{audioFileOf(5, "a"), audioFileOf(4, "a"), audioFileOf(6, "a")},
+			wantKept:        []int{4},
+			wantAmbiguities: []string{"duplicate_sha256 file 5", "duplicate_sha256 file 6"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			songsByAudioFileId := make(map[int]model.Song, len(tt.songs))
+			for _, song := range tt.songs {
+				songsByAudioFileId[song.AudioFileId] = song
+			}
+			plan := scanPlan{}
+			kept := plan.keepOneAudioFilePerSha256(tt.audioFiles, songsByAudioFileId)
+
+			var gotKept []int
+			for _, audioFile := range kept {
+				gotKept = append(gotKept, audioFile.AudioFileId)
+			}
+			if !reflect.DeepEqual(gotKept, tt.wantKept) {
+				t.Errorf("keepOneAudioFilePerSha256() kept %v, want %v", gotKept, tt.wantKept)
+			}
+			gotAmbiguities := summarizeAmbiguities(plan.ambiguities)
+			sort.Strings(gotAmbiguities)
+			if !reflect.DeepEqual(gotAmbiguities, tt.wantAmbiguities) {
+				t.Errorf("keepOneAudioFilePerSha256() ambiguities = %v, want %v", gotAmbiguities, tt.wantAmbiguities)
+			}
+		})
+	}
+}
+
+func TestScanPlanRestrictTo(t *testing.T) {
+	audioFiles := []audio_file_client.GetAllResponseItem{
+		audioFileOf(1, "b"), audioFileOf(2, "c"), audioFileOf(4, "d"), audioFileOf(5, "d"), audioFileOf(6, "f"),
+	}
+	songs := []model.Song{songOf(10, 1, "a"), songOf(30, 3, "e"), songOf(60, 7, "f")}
+	plan := buildScanPlan(audioFiles, songs, neverUnchanged, nil, false)
+
+	tests := []struct {
+		name         string
+		audioFileIds []int
+		want         planSummary
+		wantGone     []int
+	}{
+		{
+			name:         "no files",
+			audioFileIds: []int{},
+			want:         planSummary{},
+			wantGone:     []int{},
+		},
+		{
+			name:         "created and changed files",
+			audioFileIds: []int{1, 2},
+			want:         planSummary{created: []int{2}, changed: []string{"song 10 -> file 1"}},
+			wantGone:     []int{},
+		},
+		{
+			name:         "removed and moved files",
+			audioFileIds: []int{3, 6},
+			want:         planSummary{removed: []int{30}, moved: []string{"song 60 -> file 6"}},
+			wantGone:     []int{3},
+		},
+		{
+			name:         "ambiguous and unknown files",
+			audioFileIds: []int{5, 9},
+			want:         planSummary{ambiguities: []string{"duplicate_sha256 file 5"}},
+			wantGone:     []int{9},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restricted, gone := plan.restrictTo(tt.audioFileIds, audioFiles)
+			if got := summarizePlan(restricted); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("restrictTo() = %+v, want %+v", got, tt.want)
+			}
+			sort.Ints(gone)
+			if !reflect.DeepEqual(gone, tt.wantGone) {
+				t.Errorf("restrictTo() gone = %v, want %v", gone, tt.wantGone)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package song_service
+
+import "music-metadata/internal/model"
+
+// ScanProgress receives notifications about the course of a scan
+type ScanProgress interface {
+	// PhaseStarted is called when the scan moves on to the next phase
+	PhaseStarted(phase model.ScanPhase)
+	// FilesPlanned is called once it is known how many files need processing
+	FilesPlanned(total int)
+	// FileProcessed is called after each file of the current phase is handled
+	FileProcessed()
+	// FileFailed is called instead of FileProcessed when a file of the current phase is skipped because of a failure
+	FileFailed()
+}
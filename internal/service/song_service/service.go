@@ -2,34 +2,70 @@ package song_service
 
 import (
 	"music-metadata/internal/client/music_files_client/audio_file_client"
+	"music-metadata/internal/config"
+	"music-metadata/internal/database/repository/setting_repo"
+	"music-metadata/internal/database/repository/song_artist_repo"
+	"music-metadata/internal/database/repository/song_genre_repo"
+	"music-metadata/internal/database/repository/song_override_repo"
 	"music-metadata/internal/database/repository/song_repo"
+	"music-metadata/internal/database/repository/song_split_repo"
 	"music-metadata/internal/service/album_service"
 	"music-metadata/internal/service/artist_service"
 	"music-metadata/internal/service/genre_service"
+	"music-metadata/internal/service/work_service"
+	"regexp"
 )
 
 type Service struct {
-	SongRepo song_repo.Repo
+	SongRepo         song_repo.Repo
+	SongArtistRepo   song_artist_repo.Repo
+	SongGenreRepo    song_genre_repo.Repo
+	SongSplitRepo    song_split_repo.Repo
+	SongOverrideRepo song_override_repo.Repo
+	SettingRepo      setting_repo.Repo
 
 	AlbumService  album_service.Service
 	ArtistService artist_service.Service
 	GenreService  genre_service.Service
+	WorkService   work_service.Service
 
 	AudioFileClient audio_file_client.Client
+
+	ScannerConfig config.Scanner
+
+	artistParser    artistParser
+	genreSeparators *regexp.Regexp
 }
 
 func NewService(songRepo song_repo.Repo,
+	songArtistRepo song_artist_repo.Repo,
+	songGenreRepo song_genre_repo.Repo,
+	songSplitRepo song_split_repo.Repo,
+	songOverrideRepo song_override_repo.Repo,
+	settingRepo setting_repo.Repo,
 	albumService album_service.Service,
 	artistService artist_service.Service,
 	genreService genre_service.Service,
-	audioFileClient audio_file_client.Client) (s *Service) {
+	workService work_service.Service,
+	audioFileClient audio_file_client.Client,
+	scannerConfig config.Scanner) (s *Service) {
 
 	s = &Service{
-		SongRepo:        songRepo,
-		AlbumService:    albumService,
-		ArtistService:   artistService,
-		GenreService:    genreService,
-		AudioFileClient: audioFileClient,
+		SongRepo:         songRepo,
+		SongArtistRepo:   songArtistRepo,
+		SongGenreRepo:    songGenreRepo,
+		SongSplitRepo:    songSplitRepo,
+		SongOverrideRepo: songOverrideRepo,
+		SettingRepo:      settingRepo,
+		AlbumService:     albumService,
+		ArtistService:    artistService,
+		GenreService:     genreService,
+		WorkService:      workService,
+		AudioFileClient:  audioFileClient,
+		ScannerConfig:    scannerConfig,
+		artistParser: newArtistParser(scannerConfig.ArtistSeparators, scannerConfig.FeaturingMarkers,
+			scannerConfig.ArtistSeparatorExceptions),
+		genreSeparators: newGenreSeparators(scannerConfig.GenreSeparators),
 	}
 
 	return s
@@ -1,95 +1,293 @@
 package song_service
 
 import (
-	"bytes"
+	"fmt"
 	"github.com/dhowden/tag"
+	"github.com/dhowden/tag/mbz"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
+	"io"
+	"music-metadata/internal/client/music_files_client/audio_file_client"
 	"music-metadata/internal/model"
 	"strings"
 )
 
 func (s *Service) SongByAudioFileWithoutSha(tx *sqlx.Tx, audioFileId int) (song model.Song, err error) {
-	file, err := s.AudioFileClient.Download(audioFileId)
+	metadata, err := s.readMetadata(audioFileId)
 	if err != nil {
-		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to download audio file")
 		return model.Song{}, err
 	}
 
-	metadata, err := extractMetadata(file)
+	return s.songByMetadata(tx, audioFileId, metadata)
+}
+
+// readMetadata reads the tags of the audio file, fetching only the parts of the file the parser needs.
+// It does not touch the database, so it is safe to call from several goroutines. Errors are fileFailure
+func (s *Service) readMetadata(audioFileId int) (metadata tag.Metadata, err error) {
+	file, size, err := s.AudioFileClient.Open(audioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to open audio file")
+		return nil, fileFailure{phase: model.ScanFailurePhaseDownload, err: err}
+	}
+
+	metadata, err = parseMetadata(audioFileId, file, size)
+	if err != nil {
+		if ranged, ok := file.(*audio_file_client.RangedFile); ok && ranged.FetchErr() != nil {
+			return nil, fileFailure{phase: model.ScanFailurePhaseDownload, err: ranged.FetchErr()}
+		}
+		return nil, fileFailure{phase: model.ScanFailurePhaseParse, err: err}
+	}
+	return metadata, nil
+}
+
+// parseMetadata reads the tags of the file together with the repeated Vorbis comments and the MP4 items
+// the tag package leaves out
+func parseMetadata(audioFileId int, file io.ReaderAt, size int64) (metadata tag.Metadata, err error) {
+	metadata, err = extractMetadata(io.NewSectionReader(file, 0, size))
 	if err != nil {
 		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to extract file's metadata")
+		return nil, err
+	}
+
+	if metadata.Format() == tag.VORBIS {
+		comments, err := readVorbisComments(io.NewSectionReader(file, 0, size))
+		if err != nil {
+			log.Warn().Err(err).Int("audioFileId", audioFileId).Msg("Failed to read repeated vorbis comments")
+		} else {
+			metadata = vorbisMetadata{Metadata: metadata, comments: comments}
+		}
+	}
+	if metadata.Format() == tag.MP4 {
+		items, err := readMP4Items(io.NewSectionReader(file, 0, size))
+		if err != nil {
+			log.Warn().Err(err).Int("audioFileId", audioFileId).Msg("Failed to read work, movement and sort items")
+		} else {
+			metadata = mp4Metadata{Metadata: metadata, items: items}
+		}
+	}
+
+	return metadata, nil
+}
+
+func (s *Service) songByMetadata(tx *sqlx.Tx, audioFileId int, metadata tag.Metadata) (song model.Song, err error) {
+	songId, err := s.getSongIdByAudioFileId(tx, audioFileId)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get song")
+		return model.Song{}, err
+	}
+	splits, err := s.getSongSplits(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get song splits")
+		return model.Song{}, err
+	}
+	overrides, err := s.getSongOverrides(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get song overrides")
+		return model.Song{}, err
+	}
+	metadata, err = s.applySongOverrides(tx, metadata, overrides)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to apply song overrides")
 		return model.Song{}, err
 	}
 
-	albumId, err := s.getOrCreateAlbum(tx, metadata)
+	credits := s.getArtistCredits(metadata)
+	album, err := s.getOrCreateAlbum(tx, metadata, credits, splits)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get album")
 		return model.Song{}, err
 	}
-	artistId, err := s.getOrCreateArtist(tx, metadata)
+	var albumId *int
+	var albumTitle *string
+	if album != nil {
+		title := spelling(*getAlbumTitle(metadata), album.TitleKey, album.Title)
+		albumId = &album.AlbumId
+		albumTitle = &title
+	}
+	artistId, artists, err := s.getOrCreateArtists(tx, credits, splits)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get artists")
+		return model.Song{}, err
+	}
+	genreId, genres, err := s.getOrCreateGenres(tx, s.getGenreNames(metadata), splits)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get artist")
+		log.Error().Err(err).Msg("Failed to get genres")
 		return model.Song{}, err
 	}
-	genreId, err := s.getOrCreateGenre(tx, metadata)
+	work, err := s.getOrCreateWork(tx, metadata, artists)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get genre")
+		log.Error().Err(err).Msg("Failed to get work")
 		return model.Song{}, err
 	}
+	var workId *int
+	if work != nil {
+		workId = &work.WorkId
+	}
 
+	title := getTitle(metadata)
+	sortTitle := getSortTitle(metadata)
+	originalYear, originalReleaseDate := getOriginalRelease(metadata)
 	song = model.Song{
-		AudioFileId: audioFileId,
-		Title:       getTitle(metadata),
-		AlbumId:     albumId,
-		ArtistId:    artistId,
-		GenreId:     genreId,
-		Year:        getYear(metadata),
-		SongNumber:  getSongNumber(metadata),
-		DiscNumber:  getDiscNumber(metadata),
-		Lyrics:      getLyrics(metadata),
+		AudioFileId:         audioFileId,
+		Title:               title,
+		SortTitle:           sortTitle,
+		SortKey:             s.songSortKey(title, sortTitle),
+		ArtistSortName:      mainArtistSortName(artists, getArtistSortName(metadata)),
+		AlbumArtistSortName: getAlbumArtistSortName(metadata),
+		AlbumSortTitle:      getAlbumSortTitle(metadata),
+		AlbumId:             albumId,
+		AlbumTitle:          albumTitle,
+		ArtistId:            artistId,
+		GenreId:             genreId,
+		Year:                getYear(metadata),
+		SongNumber:          getSongNumber(metadata),
+		DiscNumber:          getDiscNumber(metadata),
+		Lyrics:              getLyrics(metadata),
+		WorkId:              workId,
+		Movement:            getMovement(metadata),
+		MovementNumber:      getMovementNumber(metadata),
+		Compilation:         isCompilation(metadata),
+		Bpm:                 getBpm(metadata),
+		Isrc:                getIsrc(metadata),
+		Label:               getLabel(metadata),
+		CatalogNumber:       getCatalogNumber(metadata),
+		Comment:             getComment(metadata),
+		ReleaseDate:         getReleaseDate(metadata),
+		OriginalReleaseDate: originalReleaseDate,
+		OriginalYear:        originalYear,
+		Artists:             artists,
+		Genres:              genres,
 	}
 
 	return song, nil
 }
 
-func (s *Service) getOrCreateAlbum(tx *sqlx.Tx, metadata tag.Metadata) (albumId *int, err error) {
-	title := strings.TrimSpace(metadata.Album())
-	if len(title) == 0 {
+// getSongIdByAudioFileId returns the id of the song stored for the audio file, or nil if the file is new
+func (s *Service) getSongIdByAudioFileId(tx *sqlx.Tx, audioFileId int) (songId *int, err error) {
+	exists, err := s.SongRepo.IsExistsByAudioFileId(tx, audioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to check song existence")
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	song, err := s.SongRepo.ReadByAudioFileId(tx, audioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to get song")
+		return nil, err
+	}
+	return &song.SongId, nil
+}
+
+const variousArtists = "Various Artists"
+
+// isVariousArtists tells whether the album artist is one of the usual placeholders for compilations
+func isVariousArtists(albumArtist string) bool {
+	switch strings.ToLower(albumArtist) {
+	case "various artists", "various", "va":
+		return true
+	default:
+		return false
+	}
+}
+
+// getOrCreateAlbum resolves the album of the song through album aliases, albums of the same identity and
+// the splits of the song, creating the album if none of them has it
+func (s *Service) getOrCreateAlbum(tx *sqlx.Tx, metadata tag.Metadata, credits []artistCredit, splits songSplits) (album *model.Album, err error) {
+	albumTitle := getAlbumTitle(metadata)
+	if albumTitle == nil {
 		return nil, nil
 	}
+	title := *albumTitle
+
+	// Files without an album artist tag are credited to the first main artist of the song, like most
+	// players do, unless they belong to a compilation, which is credited to Various Artists as a whole
+	albumArtist := strings.TrimSpace(metadata.AlbumArtist())
+	compilation := isCompilation(metadata)
+	if isVariousArtists(albumArtist) {
+		albumArtist = variousArtists
+	} else if len(albumArtist) == 0 && compilation {
+		albumArtist = variousArtists
+	} else if len(albumArtist) == 0 && len(credits) > 0 && credits[0].role == model.SongArtistRoleMain {
+		albumArtist = credits[0].name
+	}
+	artist, err := s.getOrCreateArtistByName(tx, albumArtist)
+	if err != nil {
+		log.Error().Err(err).Str("albumArtist", albumArtist).Msg("Failed to get album artist")
+		return nil, err
+	}
+	var albumArtistId *int
+	if artist != nil {
+		albumArtistId = &artist.ArtistId
+	}
+
+	identity := model.Album{
+		Title:                title,
+		SortKey:              s.sortKey(title),
+		AlbumArtistId:        albumArtistId,
+		Year:                 getYear(metadata),
+		MusicBrainzReleaseId: getMusicBrainzReleaseId(metadata),
+		Compilation:          compilation,
+	}
 
-	exists, err := s.AlbumService.IsExistsByTitle(tx, title)
+	var resolvedAlbum model.Album
+	aliased, err := s.AlbumService.IsExistsByAlias(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to check album alias existence")
+		return nil, err
+	}
+	exists, err := s.AlbumService.IsExistsByIdentity(tx, identity)
 	if err != nil {
 		log.Error().Err(err).Str("title", title).Msg("Failed to check album existence")
 		return nil, err
 	}
 
-	if exists {
-		album, err := s.AlbumService.GetByTitle(tx, title)
-		if err != nil {
-			log.Error().Err(err).Str("title", title).Msg("Failed to get album")
-			return nil, err
-		}
-		return &album.AlbumId, nil
+	if aliased {
+		resolvedAlbum, err = s.AlbumService.GetByAlias(tx, identity)
+	} else if exists {
+		resolvedAlbum, err = s.AlbumService.GetByIdentity(tx, identity)
 	} else {
-		album, err := s.AlbumService.Create(tx, model.Album{
-			Title: title,
-		})
+		resolvedAlbum, err = s.AlbumService.Create(tx, identity)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to get album")
+		return nil, err
+	}
+
+	if splitAlbumId := splits.resolve(model.SongSplitKindAlbum, resolvedAlbum.AlbumId); splitAlbumId != resolvedAlbum.AlbumId {
+		resolvedAlbum, err = s.AlbumService.Get(tx, splitAlbumId)
 		if err != nil {
-			log.Error().Err(err).Str("title", title).Msg("Failed to create album")
+			log.Error().Err(err).Int("albumId", splitAlbumId).Msg("Failed to get album the song was split off to")
 			return nil, err
 		}
-		return &album.AlbumId, nil
 	}
+	return &resolvedAlbum, nil
 }
 
-func (s *Service) getOrCreateArtist(tx *sqlx.Tx, metadata tag.Metadata) (artistId *int, err error) {
-	name := strings.TrimSpace(metadata.Artist())
+// getOrCreateArtistByName resolves the name through artist aliases and artist names, creating the
+// artist if neither has it
+func (s *Service) getOrCreateArtistByName(tx *sqlx.Tx, name string) (artist *model.Artist, err error) {
+	name = strings.TrimSpace(name)
 	if len(name) == 0 {
 		return nil, nil
 	}
 
+	aliased, err := s.ArtistService.IsExistsByAlias(tx, name)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to check artist alias existence")
+		return nil, err
+	}
+	if aliased {
+		artist, err := s.ArtistService.GetByAlias(tx, name)
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to get artist by alias")
+			return nil, err
+		}
+		return &artist, nil
+	}
+
 	exists, err := s.ArtistService.IsExistsByName(tx, name)
 	if err != nil {
 		log.Error().Err(err).Str("name", name).Msg("Failed to check artist existence")
@@ -102,25 +300,41 @@ func (s *Service) getOrCreateArtist(tx *sqlx.Tx, metadata tag.Metadata) (artistI
 			log.Error().Err(err).Str("name", name).Msg("Failed to get artist")
 			return nil, err
 		}
-		return &artist.ArtistId, nil
+		return &artist, nil
 	} else {
 		artist, err := s.ArtistService.Create(tx, model.Artist{
-			Name: name,
+			Name:    name,
+			SortKey: s.sortKey(name),
 		})
 		if err != nil {
 			log.Error().Err(err).Str("name", name).Msg("Failed to create artist")
 			return nil, err
 		}
-		return &artist.ArtistId, nil
+		return &artist, nil
 	}
 }
 
-func (s *Service) getOrCreateGenre(tx *sqlx.Tx, metadata tag.Metadata) (genreId *int, err error) {
-	name := strings.TrimSpace(metadata.Genre())
+func (s *Service) getOrCreateGenreByName(tx *sqlx.Tx, name string) (genre *model.Genre, err error) {
+	name = strings.TrimSpace(name)
 	if len(name) == 0 {
 		return nil, nil
 	}
 
+	// Aliases take precedence, so spellings the admin mapped to a genre never create a genre of their own
+	aliased, err := s.GenreService.IsExistsByAlias(tx, name)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to check genre alias existence")
+		return nil, err
+	}
+	if aliased {
+		genre, err := s.GenreService.GetByAlias(tx, name)
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to get genre by alias")
+			return nil, err
+		}
+		return &genre, nil
+	}
+
 	exists, err := s.GenreService.IsExistsByName(tx, name)
 	if err != nil {
 		log.Error().Err(err).Str("name", name).Msg("Failed to check genre existence")
@@ -133,7 +347,7 @@ func (s *Service) getOrCreateGenre(tx *sqlx.Tx, metadata tag.Metadata) (genreId
 			log.Error().Err(err).Str("name", name).Msg("Failed to get genre")
 			return nil, err
 		}
-		return &genre.GenreId, nil
+		return &genre, nil
 	} else {
 		genre, err := s.GenreService.Create(tx, model.Genre{
 			Name: name,
@@ -142,12 +356,35 @@ func (s *Service) getOrCreateGenre(tx *sqlx.Tx, metadata tag.Metadata) (genreId
 			log.Error().Err(err).Str("name", name).Msg("Failed to create genre")
 			return nil, err
 		}
-		return &genre.GenreId, nil
+		return &genre, nil
+	}
+}
+
+// saveSongRelations replaces the artists and genres of the song with the ones read from its tags and
+// lets the album title, sort title, release date, label and compilation flag follow the ones its songs carry
+func (s *Service) saveSongRelations(tx *sqlx.Tx, songId int, song model.Song) (err error) {
+	err = s.saveSongArtists(tx, songId, song.Artists)
+	if err != nil {
+		return err
+	}
+	err = s.saveSongGenres(tx, songId, song.Genres)
+	if err != nil {
+		return err
 	}
+	if song.AlbumId != nil {
+		return s.updateAlbumFromSongs(tx, *song.AlbumId)
+	}
+	return nil
 }
 
-func extractMetadata(trackData []byte) (metadata tag.Metadata, err error) {
-	r := bytes.NewReader(trackData)
+// extractMetadata parses the tags. Corrupt files can make the parser panic, which is turned into an error
+func extractMetadata(r io.ReadSeeker) (metadata tag.Metadata, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("parser panicked: %v", p)
+		}
+	}()
+
 	return tag.ReadFrom(r)
 }
 
@@ -159,12 +396,58 @@ func getTitle(metadata tag.Metadata) *string {
 	return &title
 }
 
+func getAlbumTitle(metadata tag.Metadata) *string {
+	albumTitle := strings.TrimSpace(metadata.Album())
+	if len(albumTitle) == 0 {
+		return nil
+	}
+	return &albumTitle
+}
+
+// getYear reads the year of the song. The tag package reads only plain years from TDRC, so the year of a
+// full ID3v2.4 timestamp like 2001-03-15 is parsed here
 func getYear(metadata tag.Metadata) *int {
 	year := metadata.Year()
-	if year == 0 {
+	if year != 0 {
+		return &year
+	}
+	if value := getFirstRawValue(metadata, "TDRC"); value != nil {
+		parsedYear, _ := parseDate(*value)
+		return parsedYear
+	}
+	return nil
+}
+
+func getMusicBrainzReleaseId(metadata tag.Metadata) *string {
+	releaseId := strings.TrimSpace(mbz.Extract(metadata).Get(mbz.Album))
+	if len(releaseId) == 0 {
 		return nil
 	}
-	return &year
+	return &releaseId
+}
+
+// isCompilation tells whether the song belongs to a compilation: by the compilation flag or by the
+// album artist being one of the placeholders for various artists
+func isCompilation(metadata tag.Metadata) bool {
+	return getCompilation(metadata) || isVariousArtists(strings.TrimSpace(metadata.AlbumArtist()))
+}
+
+// getCompilation reads the compilation flag: TCMP in ID3v2, COMPILATION in Vorbis comments and cpil in MP4
+func getCompilation(metadata tag.Metadata) bool {
+	for _, name := range []string{"TCMP", "TCP", "compilation", "cpil"} {
+		switch value := metadata.Raw()[name].(type) {
+		case string:
+			value = strings.TrimSpace(value)
+			if value == "1" || strings.EqualFold(value, "true") {
+				return true
+			}
+		case int:
+			if value != 0 {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func getSongNumber(metadata tag.Metadata) *int {
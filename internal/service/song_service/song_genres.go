@@ -0,0 +1,148 @@
+package song_service
+
+import (
+	"github.com/dhowden/tag"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"regexp"
+	"strings"
+)
+
+func newGenreSeparators(separators []string) *regexp.Regexp {
+	// Multi-value tags keep their values separated by NUL characters
+	quotedSeparators := []string{"\x00"}
+	for _, separator := range separators {
+		quotedSeparators = append(quotedSeparators, regexp.QuoteMeta(separator))
+	}
+	return regexp.MustCompile(strings.Join(quotedSeparators, "|"))
+}
+
+// getGenreNames reads the genres of the song from every genre field, splitting each on the genre
+// separators and translating legacy genre codes. ID3v2 genres are read from the raw frame, as the
+// tag package expands "(17)Rock" into "Rock Rock"
+func (s *Service) getGenreNames(metadata tag.Metadata) (names []string) {
+	values := getVorbisValues(metadata, "genre")
+	if len(values) == 0 {
+		switch metadata.Format() {
+		case tag.ID3v2_2, tag.ID3v2_3, tag.ID3v2_4:
+			values = getRawValues(metadata, "TCON", "TCO")
+		}
+	}
+	if len(values) == 0 {
+		values = []string{metadata.Genre()}
+	}
+
+	names = make([]string, 0)
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, part := range s.genreSeparators.Split(value, -1) {
+			for _, name := range normalizeGenre(part) {
+				key := model.MatchingKey(name)
+				if len(key) == 0 || seen[key] {
+					continue
+				}
+				seen[key] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// getOrCreateGenres resolves the names into genres, following the splits of the song. The first genre
+// is returned separately as the primary genre of the song. Names resolving to the same genre, like
+// aliases, list it once
+func (s *Service) getOrCreateGenres(tx *sqlx.Tx, names []string, splits songSplits) (genreId *int, genres []model.SongGenre, err error) {
+	genres = make([]model.SongGenre, 0, len(names))
+	seen := make(map[int]bool)
+	for _, name := range names {
+		genre, err := s.getOrCreateGenreByName(tx, name)
+		if err != nil {
+			log.Error().Err(err).Str("name", name).Msg("Failed to get genre")
+			return nil, nil, err
+		}
+		if splitGenreId := splits.resolve(model.SongSplitKindGenre, genre.GenreId); splitGenreId != genre.GenreId {
+			splitGenre, err := s.GenreService.Get(tx, splitGenreId)
+			if err != nil {
+				log.Error().Err(err).Int("genreId", splitGenreId).Msg("Failed to get genre the song was split off to")
+				return nil, nil, err
+			}
+			genre = &splitGenre
+		}
+		if seen[genre.GenreId] {
+			continue
+		}
+		seen[genre.GenreId] = true
+		genres = append(genres, model.SongGenre{
+			GenreId:    genre.GenreId,
+			Position:   len(genres),
+			ListedName: spelling(name, genre.NameKey, genre.Name),
+		})
+		if genreId == nil {
+			genreId = &genre.GenreId
+		}
+	}
+	return genreId, genres, nil
+}
+
+// saveSongGenres replaces the genres of the song. The names of the genres listed before and after
+// follow the spellings they are now listed with most often
+func (s *Service) saveSongGenres(tx *sqlx.Tx, songId int, genres []model.SongGenre) (err error) {
+	previousGenres, err := s.SongGenreRepo.ReadAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song genres")
+		return err
+	}
+	if isSameSongGenres(previousGenres, songId, genres) {
+		return nil
+	}
+
+	err = s.SongGenreRepo.DeleteAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to delete song genres")
+		return err
+	}
+
+	for _, genre := range genres {
+		genre.SongId = songId
+		err = s.SongGenreRepo.Create(tx, genre)
+		if err != nil {
+			log.Error().Err(err).Int("songId", songId).Int("genreId", genre.GenreId).Msg("Failed to create song genre")
+			return err
+		}
+	}
+
+	updated := make(map[int]bool)
+	for _, genre := range append(previousGenres, genres...) {
+		if updated[genre.GenreId] {
+			continue
+		}
+		updated[genre.GenreId] = true
+		err = s.GenreService.UpdateNameToMostCommon(tx, genre.GenreId)
+		if err != nil {
+			log.Error().Err(err).Int("genreId", genre.GenreId).Msg("Failed to update genre name")
+			return err
+		}
+	}
+	return nil
+}
+
+// isSameSongGenres tells whether the song already has exactly these genres. Rescans of unchanged files
+// then leave the genres, and the audit log, alone
+func isSameSongGenres(previousGenres []model.SongGenre, songId int, genres []model.SongGenre) bool {
+	if len(previousGenres) != len(genres) {
+		return false
+	}
+	previous := make(map[model.SongGenre]bool, len(previousGenres))
+	for _, genre := range previousGenres {
+		previous[genre] = true
+	}
+	for _, genre := range genres {
+		genre.SongId = songId
+		if !previous[genre] {
+			return false
+		}
+	}
+	return true
+}
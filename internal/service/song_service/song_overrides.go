@@ -0,0 +1,187 @@
+package song_service
+
+import (
+	"github.com/dhowden/tag"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+	"strconv"
+	"strings"
+)
+
+// overriddenMetadata reads the tags of a file with the overrides of its song applied, so overridden
+// values are resolved into artists, albums and genres like the tags they replace
+type overriddenMetadata struct {
+	tag.Metadata
+	values map[model.SongOverrideField]*string
+}
+
+// overriddenRawNames are the raw tags read besides tag.Metadata that an overridden field replaces as well
+var overriddenRawNames = map[model.SongOverrideField][]string{
+	model.SongOverrideFieldArtist: {"ARTISTS", "artist"},
+	model.SongOverrideFieldGenre:  {"TCON", "TCO", "genre"},
+}
+
+func (m overriddenMetadata) text(field model.SongOverrideField, tagValue func() string) string {
+	value, ok := m.values[field]
+	if !ok {
+		return tagValue()
+	}
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func (m overriddenMetadata) number(field model.SongOverrideField, tagValue int) int {
+	value, ok := m.values[field]
+	if !ok {
+		return tagValue
+	}
+	if value == nil {
+		return 0
+	}
+	number, _ := strconv.Atoi(*value)
+	return number
+}
+
+func (m overriddenMetadata) Title() string {
+	return m.text(model.SongOverrideFieldTitle, m.Metadata.Title)
+}
+
+func (m overriddenMetadata) Artist() string {
+	return m.text(model.SongOverrideFieldArtist, m.Metadata.Artist)
+}
+
+func (m overriddenMetadata) Album() string {
+	return m.text(model.SongOverrideFieldAlbum, m.Metadata.Album)
+}
+
+func (m overriddenMetadata) AlbumArtist() string {
+	return m.text(model.SongOverrideFieldAlbumArtist, m.Metadata.AlbumArtist)
+}
+
+func (m overriddenMetadata) Genre() string {
+	return m.text(model.SongOverrideFieldGenre, m.Metadata.Genre)
+}
+
+func (m overriddenMetadata) Lyrics() string {
+	return m.text(model.SongOverrideFieldLyrics, m.Metadata.Lyrics)
+}
+
+func (m overriddenMetadata) Year() int {
+	return m.number(model.SongOverrideFieldYear, m.Metadata.Year())
+}
+
+func (m overriddenMetadata) Track() (int, int) {
+	track, total := m.Metadata.Track()
+	return m.number(model.SongOverrideFieldSongNumber, track), total
+}
+
+func (m overriddenMetadata) Disc() (int, int) {
+	disc, total := m.Metadata.Disc()
+	return m.number(model.SongOverrideFieldDiscNumber, disc), total
+}
+
+func (m overriddenMetadata) Raw() map[string]interface{} {
+	raw := make(map[string]interface{})
+	for key, value := range m.Metadata.Raw() {
+		if !m.isOverriddenRaw(key, value) {
+			raw[key] = value
+		}
+	}
+	return raw
+}
+
+// isOverriddenRaw tells whether the raw tag, a frame or a TXXX description, is replaced by an override
+func (m overriddenMetadata) isOverriddenRaw(key string, value interface{}) bool {
+	if comm, ok := value.(*tag.Comm); ok && strings.HasPrefix(key, "TXX") {
+		key = comm.Description
+	}
+	for field, names := range overriddenRawNames {
+		if _, ok := m.values[field]; !ok {
+			continue
+		}
+		for _, name := range names {
+			if strings.EqualFold(key, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getSongOverrides reads the overrides of the song. Files without a song yet have none
+func (s *Service) getSongOverrides(tx *sqlx.Tx, songId *int) (overrides []model.SongOverride, err error) {
+	if songId == nil {
+		return make([]model.SongOverride, 0), nil
+	}
+
+	overrides, err = s.SongOverrideRepo.ReadAllBySongId(tx, *songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", *songId).Msg("Failed to get song overrides")
+		return make([]model.SongOverride, 0), err
+	}
+	return overrides, nil
+}
+
+// applySongOverrides records what the file carries for every overridden field and returns the tags with
+// the overrides applied
+func (s *Service) applySongOverrides(tx *sqlx.Tx, metadata tag.Metadata, overrides []model.SongOverride) (overridden tag.Metadata, err error) {
+	if len(overrides) == 0 {
+		return metadata, nil
+	}
+
+	values := make(map[model.SongOverrideField]*string)
+	for _, override := range overrides {
+		err = s.SongOverrideRepo.UpdateTagValue(tx, override.SongId, override.Field, s.getTagValue(metadata, override.Field))
+		if err != nil {
+			log.Error().Err(err).Int("songId", override.SongId).Str("field", string(override.Field)).Msg("Failed to update tag value of song override")
+			return nil, err
+		}
+		values[override.Field] = override.Value
+	}
+	return overriddenMetadata{Metadata: metadata, values: values}, nil
+}
+
+// getTagValue renders the field as the file carries it, in the form an override of the field takes
+func (s *Service) getTagValue(metadata tag.Metadata, field model.SongOverrideField) *string {
+	var value string
+	switch field {
+	case model.SongOverrideFieldTitle:
+		value = metadata.Title()
+	case model.SongOverrideFieldArtist:
+		artistValues := getVorbisValues(metadata, "artist")
+		if len(artistValues) == 0 {
+			artistValues = []string{metadata.Artist()}
+		}
+		value = strings.Join(artistValues, "; ")
+	case model.SongOverrideFieldAlbum:
+		value = metadata.Album()
+	case model.SongOverrideFieldAlbumArtist:
+		value = metadata.AlbumArtist()
+	case model.SongOverrideFieldGenre:
+		value = strings.Join(s.getGenreNames(metadata), "; ")
+	case model.SongOverrideFieldYear:
+		value = formatNumber(getYear(metadata))
+	case model.SongOverrideFieldSongNumber:
+		value = formatNumber(getSongNumber(metadata))
+	case model.SongOverrideFieldDiscNumber:
+		value = formatNumber(getDiscNumber(metadata))
+	case model.SongOverrideFieldLyrics:
+		value = metadata.Lyrics()
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return nil
+	}
+	return &value
+}
+
+func formatNumber(number *int) string {
+	if number == nil {
+		return ""
+	}
+	return strconv.Itoa(*number)
+}
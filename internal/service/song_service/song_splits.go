@@ -0,0 +1,78 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// songSplits maps, for every kind, the ids the tags of a song resolve to onto the ids the song was
+// split off to
+type songSplits map[model.SongSplitKind]map[int]int
+
+func (splits songSplits) resolve(kind model.SongSplitKind, id int) int {
+	if toId, ok := splits[kind][id]; ok {
+		return toId
+	}
+	return id
+}
+
+// getSongSplits reads the splits of the song. Files without a song yet have none
+func (s *Service) getSongSplits(tx *sqlx.Tx, songId *int) (splits songSplits, err error) {
+	splits = make(songSplits)
+	if songId == nil {
+		return splits, nil
+	}
+
+	songSplitList, err := s.SongSplitRepo.ReadAllBySongId(tx, *songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", *songId).Msg("Failed to get song splits")
+		return nil, err
+	}
+
+	for _, songSplit := range songSplitList {
+		if splits[songSplit.Kind] == nil {
+			splits[songSplit.Kind] = make(map[int]int)
+		}
+		splits[songSplit.Kind][songSplit.FromId] = songSplit.ToId
+	}
+	return splits, nil
+}
+
+// splitSong keeps the song on toId instead of fromId through later scans. A song already split onto
+// fromId is split onto toId instead, as its tags still resolve to the id it was first split off
+func (s *Service) splitSong(tx *sqlx.Tx, songId int, kind model.SongSplitKind, fromId int, toId int) (err error) {
+	songSplitList, err := s.SongSplitRepo.ReadAllBySongId(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song splits")
+		return err
+	}
+
+	songSplit := model.SongSplit{
+		SongId: songId,
+		Kind:   kind,
+		FromId: fromId,
+		ToId:   toId,
+	}
+	for _, existingSplit := range songSplitList {
+		if existingSplit.Kind == kind && existingSplit.ToId == fromId {
+			songSplit.FromId = existingSplit.FromId
+		}
+	}
+
+	err = s.SongSplitRepo.Create(tx, songSplit)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to create song split")
+		return err
+	}
+	return nil
+}
+
+// spelling returns the name as the tags spell it if it names the row it resolved to, otherwise the
+// name of the row, as the tags then spell an alias or the row the song was split off
+func spelling(taggedName string, key string, name string) string {
+	if model.MatchingKey(taggedName) == key {
+		return taggedName
+	}
+	return name
+}
@@ -0,0 +1,116 @@
+package song_service
+
+import (
+	"github.com/dhowden/tag"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// sortKey generates the sort key of a name or title, dropping the leading articles of the configured languages
+func (s *Service) sortKey(value string) string {
+	return model.SortKey(value, s.ScannerConfig.SortArticles)
+}
+
+// songSortKey is the key the song is sorted by: the sort title if the file has one, the title otherwise
+func (s *Service) songSortKey(title *string, sortTitle *string) *string {
+	if sortTitle != nil {
+		title = sortTitle
+	}
+	if title == nil {
+		return nil
+	}
+	sortKey := s.sortKey(*title)
+	return &sortKey
+}
+
+// updateArtistSort lets the sort name of the artist follow the one its songs carry most often, so that it
+// is dropped once no file tags it anymore, and regenerates its sort key
+func (s *Service) updateArtistSort(tx *sqlx.Tx, artistId int) (err error) {
+	artist, err := s.ArtistService.Get(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get artist")
+		return err
+	}
+	sortName, err := s.SongRepo.ReadMostCommonArtistSortName(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get artist sort name")
+		return err
+	}
+	sortKey := s.sortKey(artist.Name)
+	if sortName != nil {
+		sortKey = s.sortKey(*sortName)
+	}
+	if isSameString(sortName, artist.SortName) && sortKey == artist.SortKey {
+		return nil
+	}
+	return s.ArtistService.UpdateSort(tx, artist.ArtistId, sortName, sortKey)
+}
+
+// mainArtistSortName is the artist sort tag of the song if it has a single main artist. A song of several
+// main artists has one sort name for all of them, which fits none of them alone, so it is then left out
+func mainArtistSortName(artists []model.SongArtist, sortName *string) *string {
+	mainArtists := 0
+	for _, artist := range artists {
+		if artist.Role == model.SongArtistRoleMain {
+			mainArtists++
+		}
+	}
+	if mainArtists != 1 {
+		return nil
+	}
+	return sortName
+}
+
+// updateAlbumSort lets the sort title of the album follow the one its songs carry most often, the same way
+// updateArtistSort does for artists
+func (s *Service) updateAlbumSort(tx *sqlx.Tx, albumId int) (err error) {
+	album, err := s.AlbumService.Get(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to get album")
+		return err
+	}
+	sortTitle, err := s.SongRepo.ReadMostCommonAlbumSortTitle(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to get album sort title")
+		return err
+	}
+	sortKey := s.sortKey(album.Title)
+	if sortTitle != nil {
+		sortKey = s.sortKey(*sortTitle)
+	}
+	if isSameString(sortTitle, album.SortTitle) && sortKey == album.SortKey {
+		return nil
+	}
+	return s.AlbumService.UpdateSort(tx, album.AlbumId, sortTitle, sortKey)
+}
+
+func isSameString(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// getSortTitle reads the title sort order: TSOT in ID3v2, TITLESORT in Vorbis comments and sonm in MP4
+func getSortTitle(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "TSOT", "TST", "TITLESORT", "sonm")
+}
+
+// getArtistSortName reads the artist sort order: TSOP in ID3v2, ARTISTSORT in Vorbis comments, soar in MP4
+// and sortartist as written by some taggers
+func getArtistSortName(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "TSOP", "TSP", "ARTISTSORT", "soar", "sortartist")
+}
+
+// getAlbumArtistSortName reads the album artist sort order: TSO2 in ID3v2, ALBUMARTISTSORT in Vorbis
+// comments and soaa in MP4
+func getAlbumArtistSortName(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "TSO2", "TS2", "ALBUMARTISTSORT", "soaa", "sortalbumartist")
+}
+
+// getAlbumSortTitle reads the album sort order: TSOA in ID3v2, ALBUMSORT in Vorbis comments, soal in MP4
+// and sortalbum as written by some taggers
+func getAlbumSortTitle(metadata tag.Metadata) *string {
+	return getFirstRawValue(metadata, "TSOA", "TSA", "ALBUMSORT", "soal", "sortalbum")
+}
@@ -0,0 +1,85 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+// SplitAlbum moves the songs off the album to a new album with the title and the album artist and year
+// of the album, as when two releases share an identity. The songs stay on the new album through later
+// scans
+func (s *Service) SplitAlbum(tx *sqlx.Tx, albumId int, songIds []int, title string) (album model.Album, err error) {
+	log.Debug().Int("albumId", albumId).Ints("songIds", songIds).Str("title", title).Msg("Splitting album")
+
+	sourceAlbum, err := s.AlbumService.Get(tx, albumId)
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to get album")
+		return model.Album{}, err
+	}
+	identity := model.Album{
+		Title:         strings.TrimSpace(title),
+		SortKey:       s.sortKey(title),
+		AlbumArtistId: sourceAlbum.AlbumArtistId,
+		Year:          sourceAlbum.Year,
+		Compilation:   sourceAlbum.Compilation,
+	}
+	exists, err := s.AlbumService.IsExistsByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to check album existence")
+		return model.Album{}, err
+	}
+	aliased, err := s.AlbumService.IsExistsByAlias(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to check album alias existence")
+		return model.Album{}, err
+	}
+	if exists || aliased {
+		err = errors.Conflict{Message: fmt.Sprintf("album %q of the same album artist and year already exists", identity.Title)}
+		log.Error().Err(err).Str("title", title).Msg("Album already exists")
+		return model.Album{}, err
+	}
+
+	songs, err := s.getSongsToSplit(tx, songIds, func(song model.Song) bool {
+		return song.AlbumId != nil && *song.AlbumId == albumId
+	}, fmt.Sprintf("album with id=%d", albumId))
+	if err != nil {
+		log.Error().Err(err).Int("albumId", albumId).Msg("Failed to get songs to split")
+		return model.Album{}, err
+	}
+
+	album, err = s.AlbumService.Create(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Str("title", title).Msg("Failed to create album")
+		return model.Album{}, err
+	}
+
+	for _, song := range songs {
+		song.AlbumId = &album.AlbumId
+		song.AlbumTitle = &album.Title
+		err = s.SongRepo.Update(tx, song.SongId, song)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to update song")
+			return model.Album{}, err
+		}
+		err = s.splitSong(tx, song.SongId, model.SongSplitKindAlbum, albumId, album.AlbumId)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to split song")
+			return model.Album{}, err
+		}
+	}
+
+	for _, id := range []int{albumId, album.AlbumId} {
+		err = s.updateAlbumFromSongs(tx, id)
+		if err != nil {
+			log.Error().Err(err).Int("albumId", id).Msg("Failed to update album from its songs")
+			return model.Album{}, err
+		}
+	}
+
+	log.Info().Int("albumId", albumId).Int("splitAlbumId", album.AlbumId).Int("countOfSongs", len(songs)).Msg("Album split successfully")
+	return album, nil
+}
@@ -0,0 +1,99 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+// SplitArtist moves the songs off the artist to a new artist with the name, as when two artists share
+// a name. The songs keep crediting the new artist through later scans
+func (s *Service) SplitArtist(tx *sqlx.Tx, artistId int, songIds []int, name string) (artist model.Artist, err error) {
+	log.Debug().Int("artistId", artistId).Ints("songIds", songIds).Str("name", name).Msg("Splitting artist")
+
+	name = strings.TrimSpace(name)
+	_, err = s.ArtistService.Get(tx, artistId)
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get artist")
+		return model.Artist{}, err
+	}
+	exists, err := s.ArtistService.IsExistsByName(tx, name)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to check artist existence")
+		return model.Artist{}, err
+	}
+	aliased, err := s.ArtistService.IsExistsByAlias(tx, name)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to check artist alias existence")
+		return model.Artist{}, err
+	}
+	if exists || aliased {
+		err = errors.Conflict{Message: fmt.Sprintf("artist %q already exists", name)}
+		log.Error().Err(err).Str("name", name).Msg("Artist already exists")
+		return model.Artist{}, err
+	}
+
+	songs, err := s.getSongsToSplit(tx, songIds, func(song model.Song) bool {
+		for _, songArtist := range song.Artists {
+			if songArtist.ArtistId == artistId {
+				return true
+			}
+		}
+		return false
+	}, fmt.Sprintf("artist with id=%d", artistId))
+	if err != nil {
+		log.Error().Err(err).Int("artistId", artistId).Msg("Failed to get songs to split")
+		return model.Artist{}, err
+	}
+
+	artist, err = s.ArtistService.Create(tx, model.Artist{
+		Name:    name,
+		SortKey: s.sortKey(name),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to create artist")
+		return model.Artist{}, err
+	}
+
+	for _, song := range songs {
+		err = s.replaceSongArtist(tx, song, artistId, artist)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to replace song artist")
+			return model.Artist{}, err
+		}
+		err = s.splitSong(tx, song.SongId, model.SongSplitKindArtist, artistId, artist.ArtistId)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to split song")
+			return model.Artist{}, err
+		}
+	}
+
+	log.Info().Int("artistId", artistId).Int("splitArtistId", artist.ArtistId).Int("countOfSongs", len(songs)).Msg("Artist split successfully")
+	return artist, nil
+}
+
+// getSongsToSplit reads the songs and checks each of them belongs to the item being split
+func (s *Service) getSongsToSplit(tx *sqlx.Tx, songIds []int, belongs func(song model.Song) bool, item string) (songs []model.Song, err error) {
+	songs = make([]model.Song, 0, len(songIds))
+	seen := make(map[int]bool)
+	for _, songId := range songIds {
+		if seen[songId] {
+			continue
+		}
+		seen[songId] = true
+
+		song, err := s.Get(tx, songId)
+		if err != nil {
+			return make([]model.Song, 0), err
+		}
+		if !belongs(song) {
+			err = errors.Conflict{Message: fmt.Sprintf("song with id=%d does not belong to %s", songId, item)}
+			return make([]model.Song, 0), err
+		}
+		songs = append(songs, song)
+	}
+	return songs, nil
+}
@@ -0,0 +1,89 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+// SplitGenre moves the songs off the genre to a new genre with the name. The songs keep listing the new
+// genre through later scans
+func (s *Service) SplitGenre(tx *sqlx.Tx, genreId int, songIds []int, name string) (genre model.Genre, err error) {
+	log.Debug().Int("genreId", genreId).Ints("songIds", songIds).Str("name", name).Msg("Splitting genre")
+
+	name = strings.TrimSpace(name)
+	_, err = s.GenreService.Get(tx, genreId)
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to get genre")
+		return model.Genre{}, err
+	}
+	exists, err := s.GenreService.IsExistsByName(tx, name)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to check genre existence")
+		return model.Genre{}, err
+	}
+	aliased, err := s.GenreService.IsExistsByAlias(tx, name)
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to check genre alias existence")
+		return model.Genre{}, err
+	}
+	if exists || aliased {
+		err = errors.Conflict{Message: fmt.Sprintf("genre %q already exists", name)}
+		log.Error().Err(err).Str("name", name).Msg("Genre already exists")
+		return model.Genre{}, err
+	}
+
+	songs, err := s.getSongsToSplit(tx, songIds, func(song model.Song) bool {
+		for _, songGenre := range song.Genres {
+			if songGenre.GenreId == genreId {
+				return true
+			}
+		}
+		return false
+	}, fmt.Sprintf("genre with id=%d", genreId))
+	if err != nil {
+		log.Error().Err(err).Int("genreId", genreId).Msg("Failed to get songs to split")
+		return model.Genre{}, err
+	}
+
+	genre, err = s.GenreService.Create(tx, model.Genre{
+		Name: name,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("name", name).Msg("Failed to create genre")
+		return model.Genre{}, err
+	}
+
+	for _, song := range songs {
+		for i, songGenre := range song.Genres {
+			if songGenre.GenreId == genreId {
+				song.Genres[i].GenreId = genre.GenreId
+				song.Genres[i].ListedName = genre.Name
+			}
+		}
+		if song.GenreId != nil && *song.GenreId == genreId {
+			song.GenreId = &genre.GenreId
+		}
+		err = s.SongRepo.Update(tx, song.SongId, song)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to update song")
+			return model.Genre{}, err
+		}
+		err = s.saveSongGenres(tx, song.SongId, song.Genres)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to save song genres")
+			return model.Genre{}, err
+		}
+		err = s.splitSong(tx, song.SongId, model.SongSplitKindGenre, genreId, genre.GenreId)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to split song")
+			return model.Genre{}, err
+		}
+	}
+
+	log.Info().Int("genreId", genreId).Int("splitGenreId", genre.GenreId).Int("countOfSongs", len(songs)).Msg("Genre split successfully")
+	return genre, nil
+}
@@ -0,0 +1,8 @@
+package song_service
+
+// tagSchemaVersionSetting names the setting holding the tag schema version the stored songs were read with
+const tagSchemaVersionSetting = "tag_schema_version"
+
+// tagSchemaVersion is the version of the set of tags scans read into songs. Raise it whenever scans start
+// reading more tags or reading them differently, so the next full scan reads the tags of unchanged files again
+const tagSchemaVersion = "2"
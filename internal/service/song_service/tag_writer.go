@@ -0,0 +1,49 @@
+package song_service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/dhowden/tag"
+	"music-metadata/internal/model"
+)
+
+// tagWrite describes the tags to write into a file
+type tagWrite struct {
+	// values holds the effective value of every field, nil for fields the song does not have
+	values map[model.SongOverrideField]*string
+	// changed lists the fields to write into the tags the file already has, the other fields are
+	// carried by them already
+	changed []model.SongOverrideField
+}
+
+// unsupportedTagsError reports a file whose tags cannot be written, as opposed to a file that is corrupt
+type unsupportedTagsError struct {
+	reason string
+}
+
+func (e unsupportedTagsError) Error() string {
+	return fmt.Sprintf("tags cannot be written: %s", e.reason)
+}
+
+var errTagsTooLarge = errors.New("tags are too large")
+
+// writeTags returns the content of the file with the tags written: ID3v2.4 for MP3, Vorbis comments
+// for FLAC, Ogg Vorbis and Opus, and ilst atoms for MP4. Everything else in the file is kept as it is
+func writeTags(content []byte, metadata tag.Metadata, write tagWrite) (written []byte, err error) {
+	switch metadata.Format() {
+	case tag.ID3v2_3, tag.ID3v2_4:
+		return writeID3v2Tags(content, write)
+	case tag.ID3v1:
+		return addID3v2Tags(content, write)
+	case tag.VORBIS:
+		switch metadata.FileType() {
+		case tag.FLAC:
+			return writeFlacTags(content, write)
+		case tag.OGG:
+			return writeOggTags(content, write)
+		}
+	case tag.MP4:
+		return writeMP4Tags(content, write)
+	}
+	return nil, unsupportedTagsError{reason: fmt.Sprintf("%s tags of %s files are not supported", metadata.Format(), metadata.FileType())}
+}
@@ -0,0 +1,345 @@
+package song_service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"music-metadata/internal/model"
+	"slices"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	id3v2HeaderSize = 10
+	id3v2Padding    = 1024
+	// id3v2EncodingUTF8 is the text encoding of the frames written, which ID3v2.4 introduced
+	id3v2EncodingUTF8 = 3
+)
+
+// id3v2Frame is a frame in the ID3v2.4 layout. Data is kept as stored, so frames that are not written
+// keep their encoding, compression and unsynchronisation
+type id3v2Frame struct {
+	id    string
+	flags [2]byte
+	data  []byte
+}
+
+// id3v2FrameIds are the frames written for every field. Frames of TXXX are matched by description
+var id3v2FrameIds = map[model.SongOverrideField]string{
+	model.SongOverrideFieldTitle:       "TIT2",
+	model.SongOverrideFieldArtist:      "TPE1",
+	model.SongOverrideFieldAlbum:       "TALB",
+	model.SongOverrideFieldAlbumArtist: "TPE2",
+	model.SongOverrideFieldGenre:       "TCON",
+	model.SongOverrideFieldYear:        "TDRC",
+	model.SongOverrideFieldSongNumber:  "TRCK",
+	model.SongOverrideFieldDiscNumber:  "TPOS",
+	model.SongOverrideFieldLyrics:      "USLT",
+}
+
+// id3v2ReplacedDescriptions are the TXXX frames an overridden field replaces as well, like in overriddenRawNames
+var id3v2ReplacedDescriptions = map[model.SongOverrideField][]string{
+	model.SongOverrideFieldArtist: {"ARTISTS"},
+}
+
+// id3v23RenamedFrames are the ID3v2.3 frames ID3v2.4 replaced with frames of the same content
+var id3v23RenamedFrames = map[string]string{
+	"TYER": "TDRC",
+	"TORY": "TDOR",
+	"IPLS": "TIPL",
+}
+
+// id3v23DroppedFrames are the ID3v2.3 frames ID3v2.4 has no place for. TDAT and TIME go into TDRC,
+// the rest is dropped as ID3v2.4 readers would not expect them
+var id3v23DroppedFrames = []string{"TDAT", "TIME", "TSIZ", "TRDA", "RVAD", "EQUA"}
+
+// writeID3v2Tags rewrites the ID3v2.3 or ID3v2.4 tag at the start of the file as an ID3v2.4 tag with the
+// changed fields written
+func writeID3v2Tags(content []byte, write tagWrite) (written []byte, err error) {
+	frames, tagSize, err := readID3v2Frames(content)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range write.changed {
+		frames = setID3v2Frame(frames, field, write.values[field])
+	}
+
+	tag, err := encodeID3v2Tag(frames)
+	if err != nil {
+		return nil, err
+	}
+	return append(tag, content[tagSize:]...), nil
+}
+
+// addID3v2Tags puts an ID3v2.4 tag with every field in front of a file that only has an ID3v1 tag. Players
+// prefer the new tag, so it carries the fields the ID3v1 tag had as well
+func addID3v2Tags(content []byte, write tagWrite) (written []byte, err error) {
+	frames := make([]id3v2Frame, 0, len(model.SongOverrideFields))
+	for _, field := range model.SongOverrideFields {
+		frames = setID3v2Frame(frames, field, write.values[field])
+	}
+
+	tag, err := encodeID3v2Tag(frames)
+	if err != nil {
+		return nil, err
+	}
+	return append(tag, content...), nil
+}
+
+// readID3v2Frames reads the frames of the tag at the start of the file in the ID3v2.4 layout, and the size
+// of the whole tag
+func readID3v2Frames(content []byte) (frames []id3v2Frame, tagSize int, err error) {
+	if len(content) < id3v2HeaderSize || string(content[:3]) != "ID3" {
+		return nil, 0, errors.New("no ID3v2 tag")
+	}
+	version := content[3]
+	flags := content[5]
+	size := readSyncsafe(content[6:10])
+	tagSize = id3v2HeaderSize + size
+	if version == 4 && flags&0x10 != 0 {
+		tagSize += id3v2HeaderSize
+	}
+	if version != 3 && version != 4 {
+		return nil, 0, unsupportedTagsError{reason: "only ID3v2.3 and ID3v2.4 tags are supported"}
+	}
+	if len(content) < tagSize {
+		return nil, 0, errors.New("ID3v2 tag is longer than the file")
+	}
+
+	body := content[id3v2HeaderSize : id3v2HeaderSize+size]
+	if version == 3 && flags&0x80 != 0 {
+		body = bytes.ReplaceAll(body, []byte{0xff, 0x00}, []byte{0xff})
+	}
+	if flags&0x40 != 0 {
+		if len(body) < 4 {
+			return nil, 0, errors.New("truncated ID3v2 extended header")
+		}
+		extendedSize := readSyncsafe(body[:4])
+		if version == 3 {
+			extendedSize = int(binary.BigEndian.Uint32(body[:4])) + 4
+		}
+		if extendedSize > len(body) {
+			return nil, 0, errors.New("truncated ID3v2 extended header")
+		}
+		body = body[extendedSize:]
+	}
+
+	frames = make([]id3v2Frame, 0)
+	for len(body) >= id3v2HeaderSize && body[0] != 0 {
+		frame := id3v2Frame{id: string(body[:4]), flags: [2]byte{body[8], body[9]}}
+		frameSize := readSyncsafe(body[4:8])
+		if version == 3 {
+			frameSize = int(binary.BigEndian.Uint32(body[4:8]))
+		}
+		if frameSize > len(body)-id3v2HeaderSize {
+			return nil, 0, errors.New("ID3v2 frame is longer than the tag")
+		}
+		frame.data = body[id3v2HeaderSize : id3v2HeaderSize+frameSize]
+		body = body[id3v2HeaderSize+frameSize:]
+
+		if version == 3 {
+			frame, err = convertID3v23Frame(frame)
+			if err != nil {
+				return nil, 0, err
+			}
+		} else if flags&0x80 != 0 {
+			// The tag flag means every frame is unsynchronised, even if the frame does not say so
+			frame.flags[1] |= 0x02
+		}
+		frames = append(frames, frame)
+	}
+	if version == 3 {
+		frames = convertID3v23Dates(frames)
+	}
+	return frames, tagSize, nil
+}
+
+// convertID3v23Dates adds the day and time of TDAT and TIME to the year of TDRC, converted from TYER, and
+// drops the ID3v2.3 frames that have no ID3v2.4 counterpart
+func convertID3v23Dates(frames []id3v2Frame) []id3v2Frame {
+	var year, date, clock string
+	recordingIndex := -1
+	for i, frame := range frames {
+		switch frame.id {
+		case "TDRC":
+			year, recordingIndex = readID3v2Text(frame), i
+		case "TDAT":
+			date = readID3v2Text(frame)
+		case "TIME":
+			clock = readID3v2Text(frame)
+		}
+	}
+
+	// TDAT is DDMM and TIME is HHMM, the time is kept only when the day is known
+	if recordingIndex >= 0 && len(year) == 4 && len(date) == 4 && isDigits(year+date) {
+		timestamp := year + "-" + date[2:] + "-" + date[:2]
+		if len(clock) == 4 && isDigits(clock) {
+			timestamp += "T" + clock[:2] + ":" + clock[2:]
+		}
+		frames[recordingIndex] = newID3v2TextFrame("TDRC", timestamp)
+	}
+
+	converted := make([]id3v2Frame, 0, len(frames))
+	for _, frame := range frames {
+		if !slices.Contains(id3v23DroppedFrames, frame.id) {
+			converted = append(converted, frame)
+		}
+	}
+	return converted
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// convertID3v23Frame moves the frame into the ID3v2.4 layout. Compressed and encrypted frames store
+// extra data differently in ID3v2.4 and are not converted
+func convertID3v23Frame(frame id3v2Frame) (converted id3v2Frame, err error) {
+	if frame.flags[1]&0xc0 != 0 {
+		return id3v2Frame{}, unsupportedTagsError{reason: "compressed and encrypted ID3v2.3 frames are not supported"}
+	}
+	converted = id3v2Frame{
+		id:   frame.id,
+		data: frame.data,
+		// Status flags move one bit to the right, grouping is the only format flag left
+		flags: [2]byte{frame.flags[0] >> 1 & 0x70, frame.flags[1] & 0x20 << 1},
+	}
+	if id, ok := id3v23RenamedFrames[frame.id]; ok {
+		converted.id = id
+	}
+	return converted, nil
+}
+
+// setID3v2Frame replaces the frames of the field with a frame of the value, or drops them if the value is nil
+func setID3v2Frame(frames []id3v2Frame, field model.SongOverrideField, value *string) []id3v2Frame {
+	id := id3v2FrameIds[field]
+	var previous *id3v2Frame
+	kept := make([]id3v2Frame, 0, len(frames)+1)
+	for _, frame := range frames {
+		frame := frame
+		if frame.id == id {
+			if previous == nil {
+				previous = &frame
+			}
+			continue
+		}
+		if frame.id == "TXXX" && isReplacedID3v2Description(field, frame) {
+			continue
+		}
+		kept = append(kept, frame)
+	}
+	if value == nil {
+		return kept
+	}
+
+	switch field {
+	case model.SongOverrideFieldSongNumber, model.SongOverrideFieldDiscNumber:
+		// The number of tracks or discs after the slash is kept
+		text := *value
+		if previous != nil {
+			if _, total, found := strings.Cut(readID3v2Text(*previous), "/"); found {
+				text += "/" + total
+			}
+		}
+		return append(kept, newID3v2TextFrame(id, text))
+	case model.SongOverrideFieldLyrics:
+		language := []byte("XXX")
+		if previous != nil && previous.flags[1] == 0 && len(previous.data) >= 4 {
+			language = previous.data[1:4]
+		}
+		data := []byte{id3v2EncodingUTF8}
+		data = append(data, language...)
+		data = append(data, 0)
+		data = append(data, *value...)
+		return append(kept, id3v2Frame{id: id, data: data})
+	default:
+		return append(kept, newID3v2TextFrame(id, *value))
+	}
+}
+
+func newID3v2TextFrame(id string, text string) id3v2Frame {
+	return id3v2Frame{id: id, data: append([]byte{id3v2EncodingUTF8}, text...)}
+}
+
+func isReplacedID3v2Description(field model.SongOverrideField, frame id3v2Frame) bool {
+	description, _, _ := strings.Cut(readID3v2Text(frame), "\x00")
+	for _, replaced := range id3v2ReplacedDescriptions[field] {
+		if strings.EqualFold(description, replaced) {
+			return true
+		}
+	}
+	return false
+}
+
+// readID3v2Text decodes the text of a text frame. Values of multi-value frames stay separated by NUL
+// characters. Frames with format flags, like compression or unsynchronisation, read as empty
+func readID3v2Text(frame id3v2Frame) string {
+	if frame.flags[1] != 0 || len(frame.data) == 0 {
+		return ""
+	}
+
+	var text string
+	data := frame.data[1:]
+	switch frame.data[0] {
+	case 0:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		text = string(runes)
+	case 1, 2:
+		bigEndian := frame.data[0] == 2
+		if len(data) >= 2 && data[0] == 0xfe && data[1] == 0xff {
+			bigEndian, data = true, data[2:]
+		} else if len(data) >= 2 && data[0] == 0xff && data[1] == 0xfe {
+			bigEndian, data = false, data[2:]
+		}
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			if bigEndian {
+				units[i] = binary.BigEndian.Uint16(data[2*i:])
+			} else {
+				units[i] = binary.LittleEndian.Uint16(data[2*i:])
+			}
+		}
+		text = string(utf16.Decode(units))
+	default:
+		text = string(data)
+	}
+	return strings.TrimRight(text, "\x00")
+}
+
+// encodeID3v2Tag lays the frames out as an ID3v2.4 tag followed by padding, so players can edit the tag
+// in place later
+func encodeID3v2Tag(frames []id3v2Frame) (tag []byte, err error) {
+	body := &bytes.Buffer{}
+	for _, frame := range frames {
+		body.WriteString(frame.id)
+		body.Write(writeSyncsafe(len(frame.data)))
+		body.Write(frame.flags[:])
+		body.Write(frame.data)
+	}
+	body.Write(make([]byte, id3v2Padding))
+	if body.Len() >= 1<<28 {
+		return nil, errTagsTooLarge
+	}
+
+	tag = []byte{'I', 'D', '3', 4, 0, 0}
+	tag = append(tag, writeSyncsafe(body.Len())...)
+	return append(tag, body.Bytes()...), nil
+}
+
+func readSyncsafe(b []byte) int {
+	return int(b[0]&0x7f)<<21 | int(b[1]&0x7f)<<14 | int(b[2]&0x7f)<<7 | int(b[3]&0x7f)
+}
+
+func writeSyncsafe(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7f), byte(n >> 14 & 0x7f), byte(n >> 7 & 0x7f), byte(n & 0x7f)}
+}
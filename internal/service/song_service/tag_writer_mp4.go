@@ -0,0 +1,321 @@
+package song_service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"music-metadata/internal/model"
+	"strconv"
+	"strings"
+)
+
+const (
+	mp4HeaderSize = 8
+	// mp4TypeUTF8 and mp4TypeImplicit are the types of data atoms holding text and binary values
+	mp4TypeUTF8     = 1
+	mp4TypeImplicit = 0
+)
+
+// mp4Atom is an atom of an MP4 file. Atoms on the path to the tags and the sample tables are read as
+// containers, the others keep their payload as it is. Size is the size the atom was read with
+type mp4Atom struct {
+	kind     string
+	size     int
+	payload  []byte
+	children []*mp4Atom
+}
+
+// mp4Containers are the atoms whose children are read. Prefix is the size of the data a container keeps
+// before its children, like the version and flags of meta
+var mp4Containers = map[string]int{
+	"moov": 0,
+	"trak": 0,
+	"mdia": 0,
+	"minf": 0,
+	"stbl": 0,
+	"udta": 0,
+	"meta": 4,
+	"ilst": 0,
+}
+
+// mp4ItemKinds are the ilst items written for every field
+var mp4ItemKinds = map[model.SongOverrideField]string{
+	model.SongOverrideFieldTitle:       "\xa9nam",
+	model.SongOverrideFieldArtist:      "\xa9ART",
+	model.SongOverrideFieldAlbum:       "\xa9alb",
+	model.SongOverrideFieldAlbumArtist: "aART",
+	model.SongOverrideFieldGenre:       "\xa9gen",
+	model.SongOverrideFieldYear:        "\xa9day",
+	model.SongOverrideFieldSongNumber:  "trkn",
+	model.SongOverrideFieldDiscNumber:  "disk",
+	model.SongOverrideFieldLyrics:      "\xa9lyr",
+}
+
+// mp4ReplacedKinds are the ilst items and iTunes freeform names an overridden field replaces as well
+var mp4ReplacedKinds = map[model.SongOverrideField][]string{
+	model.SongOverrideFieldArtist: {"\xa9art", "ARTISTS"},
+	model.SongOverrideFieldGenre:  {"gnre"},
+}
+
+// mp4MetaHandler is the handler of a meta atom holding iTunes tags
+var mp4MetaHandler = []byte("\x00\x00\x00\x00\x00\x00\x00\x00mdirappl\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+
+// writeMP4Tags rewrites the ilst atom of the movie, creating udta, meta and ilst if the file has none.
+// Chunk offsets are moved along when the movie atom grows or shrinks in front of the media data
+func writeMP4Tags(content []byte, write tagWrite) (written []byte, err error) {
+	atoms, err := readMP4Atoms(content, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var moov *mp4Atom
+	moovStart := 0
+	for _, atom := range atoms {
+		if atom.kind == "moov" {
+			moov = atom
+			break
+		}
+		moovStart += atom.size
+	}
+	if moov == nil {
+		return nil, errors.New("no moov atom")
+	}
+	moovEnd := moovStart + moov.size
+
+	ilst := moov.child("udta").child("meta").child("ilst")
+	if meta := moov.child("udta").child("meta"); meta.child("hdlr") == nil {
+		meta.payload = make([]byte, 4)
+		meta.children = append([]*mp4Atom{{kind: "hdlr", payload: mp4MetaHandler}}, meta.children...)
+	}
+	for _, field := range write.changed {
+		setMP4Item(ilst, field, write.values[field])
+	}
+
+	shift := len(moov.encode()) - moov.size
+	if shift != 0 && moovEnd < len(content) {
+		err = moov.shiftChunkOffsets(moovEnd, shift)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(content[:moovStart])
+	buffer.Write(moov.encode())
+	buffer.Write(content[moovEnd:])
+	return buffer.Bytes(), nil
+}
+
+// readMP4Atoms reads the atoms laid out one after another in data. Containers are read only on the path to
+// the tags and sample tables, and the media data is never copied
+func readMP4Atoms(data []byte, topLevel bool) (atoms []*mp4Atom, err error) {
+	atoms = make([]*mp4Atom, 0)
+	for len(data) > 0 {
+		if len(data) < mp4HeaderSize {
+			if topLevel {
+				return nil, errors.New("truncated MP4 atom")
+			}
+			// Some writers end containers with a few zero bytes
+			atoms = append(atoms, &mp4Atom{size: len(data), payload: data})
+			break
+		}
+		size := uint64(binary.BigEndian.Uint32(data[:4]))
+		kind := string(data[4:8])
+		headerSize := uint64(mp4HeaderSize)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, errors.New("truncated MP4 atom")
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			headerSize = 16
+		}
+		if size < headerSize || size > uint64(len(data)) {
+			return nil, errors.New("MP4 atom is longer than its parent")
+		}
+
+		atom := &mp4Atom{kind: kind, size: int(size), payload: data[headerSize:size]}
+		if prefix, ok := mp4Containers[kind]; ok {
+			// meta atoms of QuickTime files have no version and flags
+			if kind == "meta" && len(atom.payload) >= 8 && string(atom.payload[4:8]) == "hdlr" {
+				prefix = 0
+			}
+			if len(atom.payload) < prefix {
+				return nil, errors.New("truncated MP4 atom")
+			}
+			atom.children, err = readMP4Atoms(atom.payload[prefix:], false)
+			if err != nil {
+				return nil, err
+			}
+			atom.payload = atom.payload[:prefix]
+		}
+		atoms = append(atoms, atom)
+		data = data[size:]
+	}
+	return atoms, nil
+}
+
+// child returns the first child of the kind, adding one at the end if the atom has none
+func (a *mp4Atom) child(kind string) *mp4Atom {
+	for _, child := range a.children {
+		if child.kind == kind {
+			return child
+		}
+	}
+	child := &mp4Atom{kind: kind, payload: make([]byte, mp4Containers[kind])}
+	a.children = append(a.children, child)
+	return child
+}
+
+func (a *mp4Atom) encode() []byte {
+	body := &bytes.Buffer{}
+	body.Write(a.payload)
+	for _, child := range a.children {
+		body.Write(child.encode())
+	}
+	if len(a.kind) == 0 {
+		return body.Bytes()
+	}
+
+	atom := make([]byte, 0, mp4HeaderSize+body.Len())
+	if mp4HeaderSize+body.Len() > 1<<32-1 {
+		atom = binary.BigEndian.AppendUint32(atom, 1)
+		atom = append(atom, a.kind...)
+		atom = binary.BigEndian.AppendUint64(atom, uint64(16+body.Len()))
+	} else {
+		atom = binary.BigEndian.AppendUint32(atom, uint32(mp4HeaderSize+body.Len()))
+		atom = append(atom, a.kind...)
+	}
+	return append(atom, body.Bytes()...)
+}
+
+// shiftChunkOffsets moves the chunk offsets of every track that point past the end of the old movie atom
+func (a *mp4Atom) shiftChunkOffsets(moovEnd int, shift int) (err error) {
+	for _, child := range a.children {
+		switch child.kind {
+		case "stco", "co64":
+			entrySize := 4
+			if child.kind == "co64" {
+				entrySize = 8
+			}
+			if len(child.payload) < 8 {
+				return errors.New("truncated chunk offset atom")
+			}
+			count := int(binary.BigEndian.Uint32(child.payload[4:8]))
+			if len(child.payload) < 8+count*entrySize {
+				return errors.New("truncated chunk offset atom")
+			}
+			payload := append([]byte(nil), child.payload...)
+			for i := 0; i < count; i++ {
+				entry := payload[8+i*entrySize:]
+				if entrySize == 4 {
+					offset := int64(binary.BigEndian.Uint32(entry))
+					if offset >= int64(moovEnd) {
+						offset += int64(shift)
+						if offset > 1<<32-1 {
+							return unsupportedTagsError{reason: "chunk offsets would not fit into stco"}
+						}
+						binary.BigEndian.PutUint32(entry, uint32(offset))
+					}
+				} else {
+					offset := int64(binary.BigEndian.Uint64(entry))
+					if offset >= int64(moovEnd) {
+						binary.BigEndian.PutUint64(entry, uint64(offset+int64(shift)))
+					}
+				}
+			}
+			child.payload = payload
+		default:
+			err = child.shiftChunkOffsets(moovEnd, shift)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setMP4Item replaces the items of the field with an item of the value, or drops them if the value is nil
+func setMP4Item(ilst *mp4Atom, field model.SongOverrideField, value *string) {
+	kind := mp4ItemKinds[field]
+	var previous *mp4Atom
+	kept := make([]*mp4Atom, 0, len(ilst.children)+1)
+	for _, item := range ilst.children {
+		if item.kind == kind {
+			if previous == nil {
+				previous = item
+			}
+			continue
+		}
+		if isReplacedMP4Item(field, item) {
+			continue
+		}
+		kept = append(kept, item)
+	}
+	ilst.children = kept
+	if value == nil {
+		return
+	}
+
+	var data []byte
+	switch field {
+	case model.SongOverrideFieldSongNumber, model.SongOverrideFieldDiscNumber:
+		// The number of tracks or discs is kept. Track numbers end with two reserved bytes
+		number, _ := strconv.Atoi(*value)
+		data = []byte{0, 0, byte(number >> 8), byte(number), 0, 0}
+		if previousData := mp4ItemData(previous); len(previousData) >= 6 {
+			copy(data[4:6], previousData[4:6])
+		}
+		if field == model.SongOverrideFieldSongNumber {
+			data = append(data, 0, 0)
+		}
+		data = append([]byte{0, 0, 0, mp4TypeImplicit, 0, 0, 0, 0}, data...)
+	default:
+		data = append([]byte{0, 0, 0, mp4TypeUTF8, 0, 0, 0, 0}, *value...)
+	}
+	ilst.children = append(ilst.children, &mp4Atom{
+		kind:     kind,
+		children: []*mp4Atom{{kind: "data", payload: data}},
+	})
+}
+
+// mp4ItemData returns the value of the first data atom of the item, without its type and locale
+func mp4ItemData(item *mp4Atom) []byte {
+	if item == nil {
+		return nil
+	}
+	children, err := readMP4Atoms(item.payload, false)
+	if err != nil {
+		return nil
+	}
+	for _, child := range children {
+		if child.kind == "data" && len(child.payload) >= 8 {
+			return child.payload[8:]
+		}
+	}
+	return nil
+}
+
+// isReplacedMP4Item tells whether the item is one the field replaces as well, matching freeform items by name
+func isReplacedMP4Item(field model.SongOverrideField, item *mp4Atom) bool {
+	name := item.kind
+	if item.kind == "----" {
+		children, err := readMP4Atoms(item.payload, false)
+		if err != nil {
+			return false
+		}
+		for _, child := range children {
+			if child.kind == "name" && len(child.payload) >= 4 {
+				name = string(child.payload[4:])
+			}
+		}
+	}
+	for _, replaced := range mp4ReplacedKinds[field] {
+		if name == replaced || (item.kind == "----" && strings.EqualFold(name, replaced)) {
+			return true
+		}
+	}
+	return false
+}
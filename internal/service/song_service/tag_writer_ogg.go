@@ -0,0 +1,235 @@
+package song_service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+const (
+	oggPageHeaderSize  = 27
+	oggMaxSegments     = 255
+	oggContinuedPacket = 0x01
+)
+
+var oggCRCTable = newOggCRCTable()
+
+// oggPage is a page of an Ogg file. Segments is the lacing table of the page data
+type oggPage struct {
+	headerType byte
+	granule    uint64
+	serial     uint32
+	sequence   uint32
+	segments   []byte
+	data       []byte
+}
+
+// oggCodecHeaders are the prefixes of the first packet of the codecs whose comments can be written, with
+// the number of header packets the codec has and the prefix of its comment packet
+var oggCodecHeaders = []struct {
+	prefix        string
+	headerCount   int
+	commentPrefix string
+}{
+	{prefix: "\x01vorbis", headerCount: 3, commentPrefix: "\x03vorbis"},
+	{prefix: "OpusHead", headerCount: 2, commentPrefix: "OpusTags"},
+}
+
+// writeOggTags rewrites the comment packet of the first logical stream of an Ogg Vorbis or Opus file. The
+// header packets after the first one are laid out on new pages, and the following pages of the stream are
+// renumbered to match
+func writeOggTags(content []byte, write tagWrite) (written []byte, err error) {
+	pages, rest, err := readOggPages(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) == 0 {
+		return nil, errors.New("no Ogg pages")
+	}
+	serial := pages[0].serial
+
+	// Header packets of the stream and the index of the page the last of them ends on
+	packets := make([][]byte, 0)
+	headerCount := 0
+	lastHeaderPage := -1
+	current := make([]byte, 0)
+	for i, page := range pages {
+		if page.serial != serial {
+			continue
+		}
+		offset := 0
+		for j, size := range page.segments {
+			current = append(current, page.data[offset:offset+int(size)]...)
+			offset += int(size)
+			if size == oggMaxSegments {
+				continue
+			}
+			packets = append(packets, current)
+			current = make([]byte, 0)
+
+			if len(packets) == 1 {
+				for _, codec := range oggCodecHeaders {
+					if strings.HasPrefix(string(packets[0]), codec.prefix) {
+						headerCount = codec.headerCount
+					}
+				}
+				if headerCount == 0 {
+					return nil, unsupportedTagsError{reason: "only Vorbis and Opus streams are supported in Ogg files"}
+				}
+				if i != 0 || j != len(page.segments)-1 {
+					return nil, errors.New("first Ogg page has more than the identification header")
+				}
+			}
+			if len(packets) == headerCount {
+				if j != len(page.segments)-1 {
+					return nil, errors.New("last Ogg header packet shares its page with audio")
+				}
+				lastHeaderPage = i
+				break
+			}
+		}
+		if lastHeaderPage >= 0 {
+			break
+		}
+	}
+	if lastHeaderPage < 0 {
+		return nil, errors.New("truncated Ogg headers")
+	}
+
+	commentPrefix := ""
+	for _, codec := range oggCodecHeaders {
+		if strings.HasPrefix(string(packets[0]), codec.prefix) {
+			commentPrefix = codec.commentPrefix
+		}
+	}
+	if !strings.HasPrefix(string(packets[1]), commentPrefix) {
+		return nil, errors.New("unexpected Ogg comment packet")
+	}
+	comments, err := writeVorbisComments(packets[1][len(commentPrefix):], write)
+	if err != nil {
+		return nil, err
+	}
+	packets[1] = append([]byte(commentPrefix), comments...)
+
+	headerPages := paginateOggPackets(packets[1:], serial, pages[0].sequence+1)
+	oldHeaderPages := 0
+	for _, page := range pages[1 : lastHeaderPage+1] {
+		if page.serial == serial {
+			oldHeaderPages++
+		}
+	}
+	shift := uint32(len(headerPages) - oldHeaderPages)
+
+	buffer := &bytes.Buffer{}
+	buffer.Write(pages[0].encode())
+	for i := 1; i < len(pages); i++ {
+		page := pages[i]
+		switch {
+		case page.serial != serial:
+			buffer.Write(page.encode())
+		case i <= lastHeaderPage:
+			for _, headerPage := range headerPages {
+				buffer.Write(headerPage.encode())
+			}
+			headerPages = nil
+		default:
+			page.sequence += shift
+			buffer.Write(page.encode())
+		}
+	}
+	buffer.Write(rest)
+	return buffer.Bytes(), nil
+}
+
+// readOggPages splits the file into pages. Data after the last page is returned as it is
+func readOggPages(content []byte) (pages []oggPage, rest []byte, err error) {
+	pages = make([]oggPage, 0)
+	for len(content) >= oggPageHeaderSize && string(content[:4]) == "OggS" {
+		segmentCount := int(content[26])
+		if len(content) < oggPageHeaderSize+segmentCount {
+			return nil, nil, errors.New("truncated Ogg page")
+		}
+		page := oggPage{
+			headerType: content[5],
+			granule:    binary.LittleEndian.Uint64(content[6:14]),
+			serial:     binary.LittleEndian.Uint32(content[14:18]),
+			sequence:   binary.LittleEndian.Uint32(content[18:22]),
+			segments:   content[oggPageHeaderSize : oggPageHeaderSize+segmentCount],
+		}
+		size := oggPageHeaderSize + segmentCount
+		dataSize := 0
+		for _, segment := range page.segments {
+			dataSize += int(segment)
+		}
+		if len(content) < size+dataSize {
+			return nil, nil, errors.New("truncated Ogg page")
+		}
+		page.data = content[size : size+dataSize]
+		pages = append(pages, page)
+		content = content[size+dataSize:]
+	}
+	return pages, content, nil
+}
+
+// paginateOggPackets lays the packets out on pages of the stream, numbered from sequence. The last packet
+// ends its page, as the first audio packet has to start on a page of its own
+func paginateOggPackets(packets [][]byte, serial uint32, sequence uint32) (pages []oggPage) {
+	pages = make([]oggPage, 0)
+	page := oggPage{serial: serial, sequence: sequence, granule: ^uint64(0)}
+	for _, packet := range packets {
+		for offset := 0; ; {
+			if len(page.segments) == oggMaxSegments {
+				pages = append(pages, page)
+				page = oggPage{serial: serial, sequence: page.sequence + 1, granule: ^uint64(0)}
+				if offset > 0 {
+					page.headerType = oggContinuedPacket
+				}
+			}
+			size := min(len(packet)-offset, oggMaxSegments)
+			page.segments = append(page.segments, byte(size))
+			page.data = append(page.data, packet[offset:offset+size]...)
+			offset += size
+			if size < oggMaxSegments {
+				// Pages a header packet ends on have a granule position of 0
+				page.granule = 0
+				break
+			}
+		}
+	}
+	return append(pages, page)
+}
+
+func (p oggPage) encode() []byte {
+	page := make([]byte, oggPageHeaderSize, oggPageHeaderSize+len(p.segments)+len(p.data))
+	copy(page, "OggS")
+	page[5] = p.headerType
+	binary.LittleEndian.PutUint64(page[6:14], p.granule)
+	binary.LittleEndian.PutUint32(page[14:18], p.serial)
+	binary.LittleEndian.PutUint32(page[18:22], p.sequence)
+	page[26] = byte(len(p.segments))
+	page = append(page, p.segments...)
+	page = append(page, p.data...)
+
+	var crc uint32
+	for _, b := range page {
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^b]
+	}
+	binary.LittleEndian.PutUint32(page[22:26], crc)
+	return page
+}
+
+func newOggCRCTable() (table [256]uint32) {
+	for i := range table {
+		crc := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}
@@ -0,0 +1,190 @@
+package song_service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/dhowden/tag"
+	"music-metadata/internal/model"
+	"testing"
+)
+
+func TestWriteTagsRoundTrip(t *testing.T) {
+	title := "New Title"
+	year := "1999"
+	write := tagWrite{
+		values: map[model.SongOverrideField]*string{
+			model.SongOverrideFieldTitle: &title,
+			model.SongOverrideFieldYear:  &year,
+		},
+		changed: []model.SongOverrideField{model.SongOverrideFieldTitle, model.SongOverrideFieldYear},
+	}
+	tests := []struct {
+		name    string
+		content []byte
+		format  tag.Format
+	}{
+		{name: "ID3v2.3", content: id3v23File(), format: tag.ID3v2_4},
+		{name: "FLAC", content: flacFile(), format: tag.VORBIS},
+		{name: "Ogg Vorbis", content: oggVorbisFile(), format: tag.VORBIS},
+		{name: "MP4", content: mp4File(), format: tag.MP4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := tag.ReadFrom(bytes.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("ReadFrom() of fixture error = %v", err)
+			}
+			written, err := writeTags(tt.content, metadata, write)
+			if err != nil {
+				t.Fatalf("writeTags() error = %v", err)
+			}
+			got, err := tag.ReadFrom(bytes.NewReader(written))
+			if err != nil {
+				t.Fatalf("ReadFrom() of written file error = %v", err)
+			}
+			if got.FileType() != metadata.FileType() || got.Format() != tt.format {
+				t.Errorf("written file is %s %s, want %s %s", got.FileType(), got.Format(), metadata.FileType(), tt.format)
+			}
+			if got.Title() != title {
+				t.Errorf("Title() = %q, want %q", got.Title(), title)
+			}
+			if got.Year() != 1999 {
+				t.Errorf("Year() = %d, want 1999", got.Year())
+			}
+			if got.Artist() != "Artist" {
+				t.Errorf("Artist() = %q, want the unchanged %q", got.Artist(), "Artist")
+			}
+		})
+	}
+}
+
+func TestWriteID3v2TagsConvertsID3v23Dates(t *testing.T) {
+	artist := "New Artist"
+	write := tagWrite{
+		values:  map[model.SongOverrideField]*string{model.SongOverrideFieldArtist: &artist},
+		changed: []model.SongOverrideField{model.SongOverrideFieldArtist},
+	}
+	written, err := writeID3v2Tags(id3v23File(), write)
+	if err != nil {
+		t.Fatalf("writeID3v2Tags() error = %v", err)
+	}
+	frames, _, err := readID3v2Frames(written)
+	if err != nil {
+		t.Fatalf("readID3v2Frames() error = %v", err)
+	}
+	ids := make(map[string]string)
+	for _, frame := range frames {
+		ids[frame.id] = readID3v2Text(frame)
+	}
+	for _, id := range []string{"TYER", "TDAT", "TIME", "TSIZ"} {
+		if _, ok := ids[id]; ok {
+			t.Errorf("written tag has ID3v2.3 frame %s", id)
+		}
+	}
+	if ids["TDRC"] != "2001-03-15T12:30" {
+		t.Errorf("TDRC = %q, want %q", ids["TDRC"], "2001-03-15T12:30")
+	}
+
+	metadata, err := tag.ReadFrom(bytes.NewReader(written))
+	if err != nil {
+		t.Fatalf("ReadFrom() error = %v", err)
+	}
+	if metadata.Artist() != artist {
+		t.Errorf("Artist() = %q, want %q", metadata.Artist(), artist)
+	}
+	if year := getYear(metadata); year == nil || *year != 2001 {
+		t.Errorf("getYear() = %v, want 2001", year)
+	}
+}
+
+// id3v23File is an ID3v2.3 tag with a title, an artist and a date split over TYER, TDAT and TIME,
+// followed by a few bytes standing in for the audio
+func id3v23File() []byte {
+	body := &bytes.Buffer{}
+	for _, frame := range [][2]string{
+		{"TIT2", "Title"},
+		{"TPE1", "Artist"},
+		{"TYER", "2001"},
+		{"TDAT", "1503"},
+		{"TIME", "1230"},
+		{"TSIZ", "12345"},
+	} {
+		data := append([]byte{0}, frame[1]...)
+		body.WriteString(frame[0])
+		binary.Write(body, binary.BigEndian, uint32(len(data)))
+		body.Write([]byte{0, 0})
+		body.Write(data)
+	}
+	body.Write(make([]byte, 16))
+
+	content := []byte{'I', 'D', '3', 3, 0, 0}
+	content = append(content, writeSyncsafe(body.Len())...)
+	content = append(content, body.Bytes()...)
+	return append(content, 0xff, 0xfb, 0x90, 0x00)
+}
+
+func vorbisCommentData(comments ...string) []byte {
+	data := &bytes.Buffer{}
+	binary.Write(data, binary.LittleEndian, uint32(len("vendor")))
+	data.WriteString("vendor")
+	binary.Write(data, binary.LittleEndian, uint32(len(comments)))
+	for _, comment := range comments {
+		binary.Write(data, binary.LittleEndian, uint32(len(comment)))
+		data.WriteString(comment)
+	}
+	return data.Bytes()
+}
+
+// flacFile is a FLAC file with an empty STREAMINFO block and a Vorbis comment block
+func flacFile() []byte {
+	comments := vorbisCommentData("TITLE=Title", "ARTIST=Artist", "DATE=2001")
+	content := []byte("fLaC")
+	content = append(content, 0, 0, 0, 34)
+	content = append(content, make([]byte, 34)...)
+	content = append(content, 0x80|flacVorbisCommentBlockType, 0, byte(len(comments)>>8), byte(len(comments)))
+	content = append(content, comments...)
+	return append(content, 0xff, 0xf8, 0x00, 0x00)
+}
+
+// oggVorbisFile is an Ogg Vorbis stream with the three header packets and an audio page
+func oggVorbisFile() []byte {
+	identification := append([]byte("\x01vorbis"), make([]byte, 23)...)
+	comments := append([]byte("\x03vorbis"), vorbisCommentData("TITLE=Title", "ARTIST=Artist", "DATE=2001")...)
+	comments = append(comments, 1)
+	setup := []byte("\x05vorbis\x00")
+
+	content := &bytes.Buffer{}
+	first := paginateOggPackets([][]byte{identification}, 1, 0)[0]
+	first.headerType = 0x02
+	content.Write(first.encode())
+	for _, page := range paginateOggPackets([][]byte{comments, setup}, 1, 1) {
+		content.Write(page.encode())
+	}
+	audio := oggPage{headerType: 0x04, granule: 1024, serial: 1, sequence: 2, segments: []byte{4}, data: []byte{0, 1, 2, 3}}
+	content.Write(audio.encode())
+	return content.Bytes()
+}
+
+// mp4File is an M4A file with a title, an artist and a date in its ilst atom and no media data
+func mp4File() []byte {
+	item := func(kind string, value string) *mp4Atom {
+		data := make([]byte, 8, 8+len(value))
+		binary.BigEndian.PutUint32(data, mp4TypeUTF8)
+		return &mp4Atom{kind: kind, children: []*mp4Atom{{kind: "data", payload: append(data, value...)}}}
+	}
+	moov := &mp4Atom{kind: "moov", children: []*mp4Atom{
+		{kind: "udta", children: []*mp4Atom{
+			{kind: "meta", payload: make([]byte, 4), children: []*mp4Atom{
+				{kind: "hdlr", payload: mp4MetaHandler},
+				{kind: "ilst", children: []*mp4Atom{
+					item("\xa9nam", "Title"),
+					item("\xa9ART", "Artist"),
+					item("\xa9day", "2001"),
+				}},
+			}},
+		}},
+	}}
+
+	content := []byte{0, 0, 0, 16, 'f', 't', 'y', 'p', 'M', '4', 'A', ' ', 0, 0, 0, 0}
+	return append(content, moov.encode()...)
+}
@@ -0,0 +1,170 @@
+package song_service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"music-metadata/internal/model"
+	"strings"
+)
+
+const (
+	flacVorbisCommentBlockType = 4
+	flacMaxBlockLength         = 1<<24 - 1
+	vorbisVendor               = "music-metadata"
+)
+
+// vorbisCommentNames are the comments written for every field, followed by the comments it replaces as well
+var vorbisCommentNames = map[model.SongOverrideField][]string{
+	model.SongOverrideFieldTitle:       {"TITLE"},
+	model.SongOverrideFieldArtist:      {"ARTIST", "ARTISTS"},
+	model.SongOverrideFieldAlbum:       {"ALBUM"},
+	model.SongOverrideFieldAlbumArtist: {"ALBUMARTIST"},
+	model.SongOverrideFieldGenre:       {"GENRE"},
+	model.SongOverrideFieldYear:        {"DATE", "YEAR"},
+	model.SongOverrideFieldSongNumber:  {"TRACKNUMBER"},
+	model.SongOverrideFieldDiscNumber:  {"DISCNUMBER"},
+	model.SongOverrideFieldLyrics:      {"LYRICS", "UNSYNCEDLYRICS"},
+}
+
+// writeVorbisComments rewrites a Vorbis comment structure with the changed fields written. Data after the
+// comments, like the framing bit of Ogg Vorbis, is kept
+func writeVorbisComments(packet []byte, write tagWrite) (written []byte, err error) {
+	r := bytes.NewReader(packet)
+	var vendorLength uint32
+	if err = binary.Read(r, binary.LittleEndian, &vendorLength); err != nil {
+		return nil, err
+	}
+	vendor := make([]byte, vendorLength)
+	if _, err = io.ReadFull(r, vendor); err != nil {
+		return nil, err
+	}
+	var count uint32
+	if err = binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, err
+	}
+	comments := make([]string, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var length uint32
+		if err = binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return nil, err
+		}
+		if int64(length) > int64(r.Len()) {
+			return nil, errors.New("vorbis comment is longer than the packet")
+		}
+		comment := make([]byte, length)
+		if _, err = io.ReadFull(r, comment); err != nil {
+			return nil, err
+		}
+		comments = append(comments, string(comment))
+	}
+	rest := packet[len(packet)-r.Len():]
+
+	for _, field := range write.changed {
+		names := vorbisCommentNames[field]
+		kept := make([]string, 0, len(comments)+1)
+		for _, comment := range comments {
+			key, _, _ := strings.Cut(comment, "=")
+			replaced := false
+			for _, name := range names {
+				if strings.EqualFold(key, name) {
+					replaced = true
+				}
+			}
+			if !replaced {
+				kept = append(kept, comment)
+			}
+		}
+		if value := write.values[field]; value != nil {
+			kept = append(kept, names[0]+"="+*value)
+		}
+		comments = kept
+	}
+
+	buffer := &bytes.Buffer{}
+	_ = binary.Write(buffer, binary.LittleEndian, uint32(len(vendor)))
+	buffer.Write(vendor)
+	_ = binary.Write(buffer, binary.LittleEndian, uint32(len(comments)))
+	for _, comment := range comments {
+		_ = binary.Write(buffer, binary.LittleEndian, uint32(len(comment)))
+		buffer.WriteString(comment)
+	}
+	buffer.Write(rest)
+	return buffer.Bytes(), nil
+}
+
+// emptyVorbisComments is the comment structure of a file that has none yet
+func emptyVorbisComments() []byte {
+	buffer := &bytes.Buffer{}
+	_ = binary.Write(buffer, binary.LittleEndian, uint32(len(vorbisVendor)))
+	buffer.WriteString(vorbisVendor)
+	_ = binary.Write(buffer, binary.LittleEndian, uint32(0))
+	return buffer.Bytes()
+}
+
+type flacBlock struct {
+	kind byte
+	data []byte
+}
+
+// writeFlacTags rewrites the Vorbis comment block of a FLAC file, adding one after the stream info if the
+// file has none
+func writeFlacTags(content []byte, write tagWrite) (written []byte, err error) {
+	if len(content) < 4 || string(content[:4]) != "fLaC" {
+		return nil, errors.New("not a FLAC file")
+	}
+
+	blocks := make([]flacBlock, 0)
+	position := 4
+	for {
+		if position+4 > len(content) {
+			return nil, errors.New("truncated FLAC metadata block")
+		}
+		header := content[position : position+4]
+		length := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+		if position+4+length > len(content) {
+			return nil, errors.New("FLAC metadata block is longer than the file")
+		}
+		blocks = append(blocks, flacBlock{kind: header[0] & 0x7f, data: content[position+4 : position+4+length]})
+		position += 4 + length
+		if header[0]&0x80 != 0 {
+			break
+		}
+	}
+
+	found := false
+	for i, block := range blocks {
+		if block.kind != flacVorbisCommentBlockType {
+			continue
+		}
+		found = true
+		blocks[i].data, err = writeVorbisComments(block.data, write)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		data, err := writeVorbisComments(emptyVorbisComments(), write)
+		if err != nil {
+			return nil, err
+		}
+		// Stream info has to stay the first block
+		blocks = append(blocks[:1], append([]flacBlock{{kind: flacVorbisCommentBlockType, data: data}}, blocks[1:]...)...)
+	}
+
+	buffer := bytes.NewBufferString("fLaC")
+	for i, block := range blocks {
+		if len(block.data) > flacMaxBlockLength {
+			return nil, errTagsTooLarge
+		}
+		kind := block.kind
+		if i == len(blocks)-1 {
+			kind |= 0x80
+		}
+		buffer.Write([]byte{kind, byte(len(block.data) >> 16), byte(len(block.data) >> 8), byte(len(block.data))})
+		buffer.Write(block.data)
+	}
+	buffer.Write(content[position:])
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,112 @@
+package song_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+	"strconv"
+)
+
+// UpdateOverrides sets the overrides of the song and drops the overrides of resetFields, so those fields
+// follow the tags again. The song is read again from its file with the overrides applied, like on a rescan
+func (s *Service) UpdateOverrides(tx *sqlx.Tx, songId int, overrides []model.SongOverride, resetFields []model.SongOverrideField) (song model.Song, err error) {
+	log.Debug().Int("songId", songId).Interface("overrides", overrides).Interface("resetFields", resetFields).Msg("Updating song overrides")
+
+	err = validateSongOverrides(overrides, resetFields)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Invalid song overrides")
+		return model.Song{}, err
+	}
+
+	song, err = s.Get(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song")
+		return model.Song{}, err
+	}
+
+	err = s.updateOverrides(tx, song, overrides, resetFields)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to update song overrides")
+		return model.Song{}, err
+	}
+	err = s.removeUnnecessaryItems(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to remove unnecessary items")
+		return model.Song{}, err
+	}
+
+	song, err = s.Get(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get updated song")
+		return model.Song{}, err
+	}
+
+	log.Debug().Int("songId", songId).Msg("Song overrides updated successfully")
+	return song, nil
+}
+
+// updateOverrides saves the overrides of the song and reads the song again from its file with them applied
+func (s *Service) updateOverrides(tx *sqlx.Tx, song model.Song, overrides []model.SongOverride, resetFields []model.SongOverrideField) (err error) {
+	for _, override := range overrides {
+		override.SongId = song.SongId
+		err = s.SongOverrideRepo.Create(tx, override)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Str("field", string(override.Field)).Msg("Failed to save song override")
+			return err
+		}
+	}
+	for _, field := range resetFields {
+		err = s.SongOverrideRepo.Delete(tx, song.SongId, field)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Str("field", string(field)).Msg("Failed to delete song override")
+			return err
+		}
+	}
+
+	err = s.rescanSong(tx, song)
+	if err != nil {
+		log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to read song with overrides")
+		return err
+	}
+	return nil
+}
+
+func validateSongOverrides(overrides []model.SongOverride, resetFields []model.SongOverrideField) (err error) {
+	for _, override := range overrides {
+		err = validateSongOverride(override.Field, override.Value)
+		if err != nil {
+			return err
+		}
+	}
+	for _, field := range resetFields {
+		err = validateSongOverride(field, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSongOverride checks the field is one that can be overridden and that numeric fields get a
+// positive integer
+func validateSongOverride(field model.SongOverrideField, value *string) (err error) {
+	known := false
+	for _, overrideField := range model.SongOverrideFields {
+		if overrideField == field {
+			known = true
+		}
+	}
+	if !known {
+		return errors.Invalid{Message: fmt.Sprintf("field %q cannot be overridden", field)}
+	}
+
+	if field.IsNumeric() && value != nil {
+		number, err := strconv.Atoi(*value)
+		if err != nil || number <= 0 {
+			return errors.Invalid{Message: fmt.Sprintf("field %q must be a positive integer", field)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package song_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+// sortArticlesSetting names the setting holding the sort articles the stored sort keys were generated with
+const sortArticlesSetting = "sort_articles"
+
+// UpdateSortKeys regenerates the sort keys of all artists, albums and songs when the configured sort
+// languages changed since the keys were generated. Keys of single entities follow their names and sort tags
+// as they change, so nothing else calls for regenerating all of them
+func (s *Service) UpdateSortKeys(tx *sqlx.Tx) (err error) {
+	log.Debug().Msg("Updating sort keys")
+
+	sortArticles := strings.Join(s.ScannerConfig.SortArticles, "|")
+	storedSortArticles, err := s.SettingRepo.Read(tx, sortArticlesSetting)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get the sort articles of the sort keys")
+		return err
+	}
+	if storedSortArticles != nil && *storedSortArticles == sortArticles {
+		log.Debug().Msg("Sort languages unchanged, sort keys are up to date")
+		return nil
+	}
+
+	artists, err := s.ArtistService.GetAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get artists")
+		return err
+	}
+	for _, artist := range artists {
+		err = s.updateArtistSort(tx, artist.ArtistId)
+		if err != nil {
+			log.Error().Err(err).Int("artistId", artist.ArtistId).Msg("Failed to update artist sort key")
+			return err
+		}
+	}
+
+	albums, err := s.AlbumService.GetAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get albums")
+		return err
+	}
+	for _, album := range albums {
+		err = s.updateAlbumSort(tx, album.AlbumId)
+		if err != nil {
+			log.Error().Err(err).Int("albumId", album.AlbumId).Msg("Failed to update album sort key")
+			return err
+		}
+	}
+
+	songs, err := s.SongRepo.ReadAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get songs")
+		return err
+	}
+	for _, song := range songs {
+		sortKey := s.songSortKey(song.Title, song.SortTitle)
+		if isSameString(sortKey, song.SortKey) {
+			continue
+		}
+		err = s.SongRepo.UpdateSortKey(tx, song.SongId, sortKey)
+		if err != nil {
+			log.Error().Err(err).Int("songId", song.SongId).Msg("Failed to update song sort key")
+			return err
+		}
+	}
+
+	err = s.SettingRepo.Save(tx, sortArticlesSetting, sortArticles)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save the sort articles of the sort keys")
+		return err
+	}
+
+	log.Debug().Msg("Sort keys updated successfully")
+	return nil
+}
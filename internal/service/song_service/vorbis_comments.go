@@ -0,0 +1,169 @@
+package song_service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/dhowden/tag"
+	"io"
+	"strings"
+)
+
+// vorbisMetadata keeps every value of repeated Vorbis comments, while the tag package only keeps
+// the last one. Keys are lower case
+type vorbisMetadata struct {
+	tag.Metadata
+	comments map[string][]string
+}
+
+// getVorbisValues returns all values of a repeated Vorbis comment, or nothing for other formats
+func getVorbisValues(metadata tag.Metadata, name string) []string {
+	switch m := metadata.(type) {
+	case vorbisMetadata:
+		return m.comments[strings.ToLower(name)]
+	case overriddenMetadata:
+		if m.isOverriddenRaw(name, nil) {
+			return nil
+		}
+		return getVorbisValues(m.Metadata, name)
+	}
+	return nil
+}
+
+// readVorbisComments reads the Vorbis comments of a FLAC, Ogg Vorbis or Opus file
+func readVorbisComments(r io.ReadSeeker) (comments map[string][]string, err error) {
+	magic := make([]byte, 4)
+	if _, err = io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+
+	var packet []byte
+	switch string(magic) {
+	case "fLaC":
+		packet, err = readFlacCommentBlock(r)
+	case "OggS":
+		if _, err = r.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		packet, err = readOggCommentPacket(r)
+	default:
+		return nil, errors.New("not a FLAC or Ogg file")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseVorbisComments(bytes.NewReader(packet))
+}
+
+// readFlacCommentBlock reads the VORBIS_COMMENT block. Other blocks, like pictures, are skipped without
+// being read
+func readFlacCommentBlock(r io.ReadSeeker) (block []byte, err error) {
+	const vorbisCommentBlockType = 4
+
+	for {
+		header := make([]byte, 4)
+		if _, err = io.ReadFull(r, header); err != nil {
+			return nil, err
+		}
+		last := header[0]&0x80 != 0
+		length := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+
+		if header[0]&0x7f == vorbisCommentBlockType {
+			block = make([]byte, length)
+			if _, err = io.ReadFull(r, block); err != nil {
+				return nil, err
+			}
+			return block, nil
+		}
+		if last {
+			return nil, errors.New("no vorbis comment block")
+		}
+		if _, err = r.Seek(int64(length), io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readOggCommentPacket reassembles the second packet of the first logical stream, which holds the comments
+func readOggCommentPacket(r io.Reader) (packet []byte, err error) {
+	var serial *uint32
+	packets := 0
+	current := &bytes.Buffer{}
+	for {
+		header := make([]byte, 27)
+		if _, err = io.ReadFull(r, header); err != nil {
+			return nil, err
+		}
+		if string(header[:4]) != "OggS" {
+			return nil, errors.New("expected 'OggS'")
+		}
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+		segments := make([]byte, header[26])
+		if _, err = io.ReadFull(r, segments); err != nil {
+			return nil, err
+		}
+
+		for _, size := range segments {
+			segment := make([]byte, size)
+			if _, err = io.ReadFull(r, segment); err != nil {
+				return nil, err
+			}
+			if serial == nil {
+				serial = &pageSerial
+			}
+			if pageSerial != *serial {
+				continue
+			}
+
+			current.Write(segment)
+			if size < 255 {
+				packets++
+				if packets == 2 {
+					packet = current.Bytes()
+					for _, prefix := range []string{"\x03vorbis", "OpusTags"} {
+						if strings.HasPrefix(string(packet), prefix) {
+							return packet[len(prefix):], nil
+						}
+					}
+					return nil, errors.New("unexpected comment packet")
+				}
+				current = &bytes.Buffer{}
+			}
+		}
+	}
+}
+
+func parseVorbisComments(r io.Reader) (comments map[string][]string, err error) {
+	var vendorLength uint32
+	if err = binary.Read(r, binary.LittleEndian, &vendorLength); err != nil {
+		return nil, err
+	}
+	if _, err = io.CopyN(io.Discard, r, int64(vendorLength)); err != nil {
+		return nil, err
+	}
+
+	var count uint32
+	if err = binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, err
+	}
+
+	comments = make(map[string][]string)
+	for i := uint32(0); i < count; i++ {
+		var length uint32
+		if err = binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return nil, err
+		}
+		comment := &strings.Builder{}
+		if _, err = io.CopyN(comment, r, int64(length)); err != nil {
+			return nil, err
+		}
+		key, value, found := strings.Cut(comment.String(), "=")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(key)
+		comments[key] = append(comments[key], value)
+	}
+	return comments, nil
+}
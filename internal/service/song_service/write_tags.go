@@ -0,0 +1,108 @@
+package song_service
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+// WriteTags writes the overridden fields of the song into the tags of its audio file, so other players see
+// them too. The new content is read before it is uploaded back to music-files, and the song is saved with
+// the hash of the uploaded content, so the next scan does not take the change for foreign content. The
+// upload cannot be rolled back with the transaction, so it goes last, and the original content is uploaded
+// again if saving the song fails after it. Songs without overrides are left as they are
+func (s *Service) WriteTags(tx *sqlx.Tx, songId int) (song model.Song, err error) {
+	log.Debug().Int("songId", songId).Msg("Writing song tags")
+
+	song, err = s.Get(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get song")
+		return model.Song{}, err
+	}
+	if len(song.Overrides) == 0 {
+		log.Debug().Int("songId", songId).Msg("Song has no overrides to write")
+		return song, nil
+	}
+
+	original, err := s.AudioFileClient.Download(song.AudioFileId)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", song.AudioFileId).Msg("Failed to download audio file")
+		return model.Song{}, err
+	}
+	metadata, err := parseMetadata(song.AudioFileId, bytes.NewReader(original), int64(len(original)))
+	if err != nil {
+		return model.Song{}, errors.Conflict{Message: fmt.Sprintf("tags of audio file with id=%d cannot be read: %s", song.AudioFileId, err)}
+	}
+
+	write := tagWrite{
+		values:  make(map[model.SongOverrideField]*string),
+		changed: make([]model.SongOverrideField, 0, len(song.Overrides)),
+	}
+	for _, field := range model.SongOverrideFields {
+		write.values[field] = s.getTagValue(metadata, field)
+	}
+	for _, override := range song.Overrides {
+		write.values[override.Field] = override.Value
+		write.changed = append(write.changed, override.Field)
+	}
+
+	content, err := writeTags(original, metadata, write)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", song.AudioFileId).Msg("Failed to write tags")
+		if _, ok := err.(unsupportedTagsError); ok {
+			return model.Song{}, errors.Conflict{Message: fmt.Sprintf("audio file with id=%d: %s", song.AudioFileId, err)}
+		}
+		return model.Song{}, err
+	}
+
+	writtenMetadata, err := parseMetadata(song.AudioFileId, bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", song.AudioFileId).Msg("Failed to read written tags")
+		return model.Song{}, err
+	}
+	newSong, err := s.songByMetadata(tx, song.AudioFileId, writtenMetadata)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to read song with written tags")
+		return model.Song{}, err
+	}
+
+	audioFile, err := s.AudioFileClient.Upload(song.AudioFileId, content)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", song.AudioFileId).Msg("Failed to upload audio file")
+		return model.Song{}, err
+	}
+	newSong.Sha256 = audioFile.Sha256
+	newSong.LastContentUpdate = &audioFile.LastContentUpdate
+	err = s.SongRepo.Update(tx, songId, newSong)
+	if err == nil {
+		err = s.saveSongRelations(tx, songId, newSong)
+	}
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to save song with written tags")
+		s.restoreAudioFile(song.AudioFileId, original)
+		return model.Song{}, err
+	}
+
+	song, err = s.Get(tx, songId)
+	if err != nil {
+		log.Error().Err(err).Int("songId", songId).Msg("Failed to get updated song")
+		return model.Song{}, err
+	}
+
+	log.Info().Int("songId", songId).Int("audioFileId", song.AudioFileId).Str("sha256", song.Sha256).Msg("Song tags written successfully")
+	return song, nil
+}
+
+// restoreAudioFile uploads the original content of the file again after the song failed to be saved with the
+// written tags, so the file and the catalog do not drift apart
+func (s *Service) restoreAudioFile(audioFileId int, original []byte) {
+	_, err := s.AudioFileClient.Upload(audioFileId, original)
+	if err != nil {
+		log.Error().Err(err).Int("audioFileId", audioFileId).Msg("Failed to restore audio file after failed tag write")
+		return
+	}
+	log.Warn().Int("audioFileId", audioFileId).Msg("Audio file restored after failed tag write")
+}
@@ -45,3 +45,51 @@ func (tm *TransactionManager) WithTransaction(do func(tx *sqlx.Tx) (err error))
 	err = do(tx)
 	return err
 }
+
+// WithRolledBackTransaction runs do in a transaction that is always rolled back, so do can
+// apply changes to see their effect without persisting them
+func (tm *TransactionManager) WithRolledBackTransaction(do func(tx *sqlx.Tx) (err error)) (err error) {
+	tx, err := tm.begin()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to start a transaction")
+		return err
+	}
+
+	defer func() {
+		log.Debug().Msg("Rolling back transaction")
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && err == nil {
+			err = rollbackErr
+		}
+	}()
+
+	err = do(tx)
+	return err
+}
+
+// WithSavepoint runs do inside a savepoint of tx. When do fails, only its changes are rolled back
+// and tx stays usable. Errors of the savepoint itself are returned instead of the error of do
+func WithSavepoint(tx *sqlx.Tx, do func() (err error)) (err error) {
+	_, err = tx.Exec("SAVEPOINT with_savepoint")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to create a savepoint")
+		return err
+	}
+
+	err = do()
+	if err != nil {
+		log.Debug().Err(err).Msg("Rolling back to savepoint")
+		_, rollbackErr := tx.Exec("ROLLBACK TO SAVEPOINT with_savepoint")
+		if rollbackErr != nil {
+			log.Error().Err(rollbackErr).Msg("Failed to roll back to savepoint")
+			return rollbackErr
+		}
+		return err
+	}
+
+	_, err = tx.Exec("RELEASE SAVEPOINT with_savepoint")
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to release savepoint")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) Create(tx *sqlx.Tx, work model.Work) (createdWork model.Work, err error) {
+	log.Debug().Interface("work", work).Msg("Creating new work")
+
+	workId, err := s.WorkRepo.Create(tx, work)
+	if err != nil {
+		log.Error().Err(err).Interface("work", work).Msg("Failed to create work")
+		return model.Work{}, err
+	}
+
+	createdWork, err = s.WorkRepo.Read(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to get created work")
+		return model.Work{}, err
+	}
+
+	log.Debug().Interface("createdWork", createdWork).Msg("Work created successfully")
+	return createdWork, nil
+}
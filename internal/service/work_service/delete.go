@@ -0,0 +1,19 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) Delete(tx *sqlx.Tx, workId int) (err error) {
+	log.Debug().Int("workId", workId).Msg("Deleting work")
+
+	err = s.WorkRepo.Delete(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to delete work")
+		return err
+	}
+
+	log.Debug().Int("workId", workId).Msg("Work deleted successfully")
+	return nil
+}
@@ -0,0 +1,33 @@
+package work_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) Get(tx *sqlx.Tx, workId int) (work model.Work, err error) {
+	log.Debug().Int("workId", workId).Msg("Getting work")
+
+	exists, err := s.IsExists(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to check existence")
+		return model.Work{}, err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("work with id=%d", workId)}
+		log.Error().Err(err).Int("workId", workId).Msg("Work not found")
+		return model.Work{}, err
+	}
+
+	work, err = s.WorkRepo.Read(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to get work")
+		return model.Work{}, err
+	}
+
+	log.Debug().Interface("work", work).Msg("Work got successfully")
+	return work, nil
+}
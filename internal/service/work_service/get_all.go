@@ -0,0 +1,20 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAll(tx *sqlx.Tx) (works []model.Work, err error) {
+	log.Debug().Msg("Getting all works")
+
+	works, err = s.WorkRepo.ReadAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get works")
+		return make([]model.Work, 0), err
+	}
+
+	log.Debug().Int("countOfWorks", len(works)).Msg("Works got successfully")
+	return works, nil
+}
@@ -0,0 +1,33 @@
+package work_service
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/errors"
+	"music-metadata/internal/model"
+)
+
+func (s Service) GetAllByComposerId(tx *sqlx.Tx, composerId int) (works []model.Work, err error) {
+	log.Debug().Int("composerId", composerId).Msg("Getting works by composer")
+
+	exists, err := s.ArtistService.IsExists(tx, composerId)
+	if err != nil {
+		log.Error().Err(err).Int("composerId", composerId).Msg("Failed to check artist existence")
+		return make([]model.Work, 0), err
+	}
+	if !exists {
+		err = errors.NotFound{Resource: fmt.Sprintf("artist with id=%d", composerId)}
+		log.Error().Err(err).Int("composerId", composerId).Msg("Artist not found")
+		return make([]model.Work, 0), err
+	}
+
+	works, err = s.WorkRepo.ReadAllByComposerId(tx, composerId)
+	if err != nil {
+		log.Error().Err(err).Int("composerId", composerId).Msg("Failed to get works by composer")
+		return make([]model.Work, 0), err
+	}
+
+	log.Debug().Int("composerId", composerId).Int("countOfWorks", len(works)).Msg("Works by composer got successfully")
+	return works, nil
+}
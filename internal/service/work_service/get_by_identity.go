@@ -0,0 +1,21 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+// GetByIdentity returns the work with the same title and composer as identity
+func (s Service) GetByIdentity(tx *sqlx.Tx, identity model.Work) (work model.Work, err error) {
+	log.Debug().Interface("identity", identity).Msg("Getting work by identity")
+
+	work, err = s.WorkRepo.ReadByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to get work")
+		return model.Work{}, err
+	}
+
+	log.Debug().Interface("work", work).Msg("Work got successfully")
+	return work, nil
+}
@@ -0,0 +1,19 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) IsExists(tx *sqlx.Tx, workId int) (exists bool, err error) {
+	log.Debug().Int("workId", workId).Msg("Checking work existence")
+
+	exists, err = s.WorkRepo.IsExists(tx, workId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to check work existence")
+		return false, err
+	}
+
+	log.Debug().Int("workId", workId).Bool("exists", exists).Msg("Work existence checked successfully")
+	return exists, nil
+}
@@ -0,0 +1,20 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"music-metadata/internal/model"
+)
+
+func (s Service) IsExistsByIdentity(tx *sqlx.Tx, identity model.Work) (exists bool, err error) {
+	log.Debug().Interface("identity", identity).Msg("Checking work existence")
+
+	exists, err = s.WorkRepo.IsExistsByIdentity(tx, identity)
+	if err != nil {
+		log.Error().Err(err).Interface("identity", identity).Msg("Failed to check work existence")
+		return false, err
+	}
+
+	log.Debug().Interface("identity", identity).Bool("exists", exists).Msg("Work existence checked successfully")
+	return exists, nil
+}
@@ -0,0 +1,37 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) RemoveUnnecessaryItems(tx *sqlx.Tx) (err error) {
+	log.Debug().Msg("Removing unnecessary items")
+
+	works, err := s.WorkRepo.ReadAll(tx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to read all works")
+		return err
+	}
+
+	for _, work := range works {
+		used, err := s.WorkRepo.IsUsed(tx, work.WorkId)
+		if err != nil {
+			log.Error().Err(err).Int("workId", work.WorkId).Msg("Failed to check usage")
+			return err
+		}
+
+		if used {
+			continue
+		}
+
+		err = s.WorkRepo.Delete(tx, work.WorkId)
+		if err != nil {
+			log.Error().Err(err).Int("workId", work.WorkId).Msg("Failed to delete work")
+			return err
+		}
+	}
+
+	log.Debug().Msg("Unnecessary items removed successfully")
+	return nil
+}
@@ -0,0 +1,22 @@
+package work_service
+
+import (
+	"music-metadata/internal/database/repository/work_repo"
+	"music-metadata/internal/service/artist_service"
+)
+
+type Service struct {
+	WorkRepo work_repo.Repo
+
+	ArtistService artist_service.Service
+}
+
+func NewService(workRepo work_repo.Repo, artistService artist_service.Service) (s *Service) {
+
+	s = &Service{
+		WorkRepo:      workRepo,
+		ArtistService: artistService,
+	}
+
+	return s
+}
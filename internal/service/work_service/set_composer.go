@@ -0,0 +1,19 @@
+package work_service
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+func (s Service) SetComposer(tx *sqlx.Tx, workId int, composerId *int) (err error) {
+	log.Debug().Int("workId", workId).Interface("composerId", composerId).Msg("Setting composer of work")
+
+	err = s.WorkRepo.UpdateComposerId(tx, workId, composerId)
+	if err != nil {
+		log.Error().Err(err).Int("workId", workId).Msg("Failed to set composer of work")
+		return err
+	}
+
+	log.Debug().Int("workId", workId).Interface("composerId", composerId).Msg("Composer of work set successfully")
+	return nil
+}